@@ -21,3 +21,9 @@ else
         rm "./kbclient/kill_bill_dev_client.go"
     fi
 fi
+
+# kbtransport per-call options need the ClientOption of go-swagger 0.27 or later templates.
+if ! grep -q "opts ...ClientOption" ./kbclient/account/account_client.go; then
+    echo "The generated clients don't take ClientOption arguments: rebase the go-swagger fork on 0.27 or later." >&2
+    exit 1
+fi
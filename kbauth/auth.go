@@ -0,0 +1,123 @@
+// Package kbauth implements the authentication schemes of the kill bill client.
+//
+// Providers are runtime.ClientAuthInfoWriter, so they are passed to kbclient.New. They are
+// combined with the tenant api key and secret through WithTenant:
+//
+//	session := kbauth.NewSession("admin", "password")
+//	client := kbclient.New(trp, strfmt.Default, kbauth.WithTenant(session, "bob", "lazar"), kbclient.KillbillDefaults{})
+//	client.SetTransport(kbauth.NewTransport(client.Transport))
+//
+// Providers with credentials obtained from kill bill, like Session, implement Refresher.
+// Transport logs them in before the first request, and refreshes them when a request
+// is rejected with 401.
+package kbauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// Tenant headers.
+const (
+	APIKeyHeader    = "X-KillBill-ApiKey"
+	APISecretHeader = "X-KillBill-ApiSecret"
+)
+
+// Provider adds credentials to kill bill requests.
+type Provider interface {
+	runtime.ClientAuthInfoWriter
+}
+
+// Refresher is implemented by providers whose credentials are obtained from kill bill,
+// and can expire.
+type Refresher interface {
+	// Login obtains credentials through the given transport, unless it holds some already.
+	Login(ctx context.Context, trp runtime.ClientTransport) error
+
+	// Refresh discards the credentials and obtains new ones. It is called when a request
+	// is rejected with 401.
+	Refresh(ctx context.Context, trp runtime.ClientTransport) error
+}
+
+// Basic returns a provider for http basic authentication.
+func Basic(username, password string) Provider {
+	return httptransport.BasicAuth(username, password)
+}
+
+// Bearer returns a provider that sends the given token as bearer token,
+// for deployments behind an authenticating proxy.
+func Bearer(token string) Provider {
+	return httptransport.BearerToken(token)
+}
+
+// BearerFunc returns a provider that sends the token returned by fn as bearer token.
+// fn is called for each request, so that it can rotate tokens.
+func BearerFunc(fn func() (string, error)) Provider {
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		token, err := fn()
+		if err != nil {
+			return fmt.Errorf("kbauth: failed to get bearer token: %w", err)
+		}
+		if token == "" {
+			return errors.New("kbauth: empty bearer token")
+		}
+		return r.SetHeaderParam(runtime.HeaderAuthorization, "Bearer "+token)
+	})
+}
+
+// tenantProvider adds the tenant headers to the requests authenticated by its provider.
+type tenantProvider struct {
+	provider  Provider
+	apiKey    string
+	apiSecret string
+}
+
+// WithTenant returns a provider that authenticates requests with p, and adds the tenant
+// api key and secret headers. Empty values are not sent, for cross tenant operations.
+func WithTenant(p Provider, apiKey, apiSecret string) Provider {
+	return &tenantProvider{provider: p, apiKey: apiKey, apiSecret: apiSecret}
+}
+
+// AuthenticateRequest implements runtime.ClientAuthInfoWriter.
+func (t *tenantProvider) AuthenticateRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	if t.provider != nil {
+		if err := t.provider.AuthenticateRequest(r, reg); err != nil {
+			return err
+		}
+	}
+	if t.apiKey != "" {
+		if err := r.SetHeaderParam(APIKeyHeader, t.apiKey); err != nil {
+			return err
+		}
+	}
+	if t.apiSecret != "" {
+		if err := r.SetHeaderParam(APISecretHeader, t.apiSecret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *tenantProvider) unwrap() Provider {
+	return t.provider
+}
+
+// asRefresher returns the Refresher of the given provider, if any.
+func asRefresher(auth runtime.ClientAuthInfoWriter) Refresher {
+	for auth != nil {
+		if r, ok := auth.(Refresher); ok {
+			return r
+		}
+		u, ok := auth.(interface{ unwrap() Provider })
+		if !ok {
+			return nil
+		}
+		auth = u.unwrap()
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package kbauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+// recorder records the auth headers of the requests.
+type recorder struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+type recordedRequest struct {
+	Path, Authorization, Cookie, APIKey, APISecret string
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.requests = append(r.requests, recordedRequest{
+		Path:          req.URL.Path,
+		Authorization: req.Header.Get("Authorization"),
+		Cookie:        req.Header.Get("Cookie"),
+		APIKey:        req.Header.Get(APIKeyHeader),
+		APISecret:     req.Header.Get(APISecretHeader),
+	})
+	r.mu.Unlock()
+	return r.next.RoundTrip(req)
+}
+
+func (r *recorder) reset() []recordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := r.requests
+	r.requests = nil
+	return res
+}
+
+func newTestClient(srv *kbtest.Server, auth Provider) (*kbclient.KillBill, *recorder) {
+	trp := srv.NewTransport()
+	rec := &recorder{next: http.DefaultTransport}
+	trp.Transport = rec
+	client := kbclient.New(trp, strfmt.Default, WithTenant(auth, "bob", "lazar"), kbclient.KillbillDefaults{})
+	client.SetTransport(NewTransport(client.Transport))
+	return client, rec
+}
+
+const basicAdmin = "Basic YWRtaW46cGFzc3dvcmQ="
+
+func TestProviders(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+
+	scenarios := []struct {
+		Name          string
+		Auth          Provider
+		Authorization string
+		Unauthorized  bool
+	}{
+		{"basic", Basic("admin", "password"), basicAdmin, false},
+		{"wrong password", Basic("admin", "wrong"), "Basic YWRtaW46d3Jvbmc=", true},
+		{"bearer", Bearer("abc"), "Bearer abc", true},
+		{"bearer func", BearerFunc(func() (string, error) { return "def", nil }), "Bearer def", true},
+	}
+
+	for _, s := range scenarios {
+		client, rec := newTestClient(srv, s.Auth)
+		_, err := client.Account.GetAccounts(context.Background(), &account.GetAccountsParams{})
+		if s.Unauthorized != isUnauthorized(err) || (!s.Unauthorized && err != nil) {
+			t.Fatalf("%s: unexpected error %v", s.Name, err)
+		}
+		expected := []recordedRequest{{
+			Path:          "/1.0/kb/accounts/pagination",
+			Authorization: s.Authorization,
+			APIKey:        "bob",
+			APISecret:     "lazar",
+		}}
+		if diff := cmp.Diff(expected, rec.reset()); diff != "" {
+			t.Fatalf("%s: unexpected requests (-want +got):\n%s", s.Name, diff)
+		}
+	}
+
+	// Bearer token errors are returned before sending the request.
+	client, rec := newTestClient(srv, BearerFunc(func() (string, error) { return "", errors.New("no token") }))
+	if _, err := client.Account.GetAccounts(context.Background(), &account.GetAccountsParams{}); err == nil {
+		t.Fatalf("expecting bearer token error")
+	}
+	if got := rec.reset(); len(got) != 0 {
+		t.Fatalf("expecting no request, got %v", got)
+	}
+}
+
+func TestSession(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+
+	session := NewSession("admin", "password")
+	client, rec := newTestClient(srv, session)
+	ctx := context.Background()
+	getAccounts := func() {
+		if _, err := client.Account.GetAccounts(ctx, &account.GetAccountsParams{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first request logs in.
+	getAccounts()
+	first := session.Current()
+	if first == nil || first.ID == "" {
+		t.Fatalf("expecting a session, got %v", first)
+	}
+	cookie := SessionCookie + "=" + first.ID
+	expected := []recordedRequest{
+		{Path: "/1.0/kb/security/subject", Authorization: basicAdmin},
+		{Path: "/1.0/kb/accounts/pagination", Cookie: cookie, APIKey: "bob", APISecret: "lazar"},
+	}
+	if diff := cmp.Diff(expected, rec.reset()); diff != "" {
+		t.Fatalf("unexpected requests (-want +got):\n%s", diff)
+	}
+
+	// The session is reused.
+	getAccounts()
+	expected = []recordedRequest{
+		{Path: "/1.0/kb/accounts/pagination", Cookie: cookie, APIKey: "bob", APISecret: "lazar"},
+	}
+	if diff := cmp.Diff(expected, rec.reset()); diff != "" {
+		t.Fatalf("unexpected requests (-want +got):\n%s", diff)
+	}
+
+	// Expired sessions are refreshed, and the request retried.
+	srv.ExpireSessions()
+	getAccounts()
+	second := session.Current()
+	if second == nil || second.ID == first.ID {
+		t.Fatalf("expecting a new session, got %v", second)
+	}
+	expected = []recordedRequest{
+		{Path: "/1.0/kb/accounts/pagination", Cookie: cookie, APIKey: "bob", APISecret: "lazar"},
+		{Path: "/1.0/kb/security/subject", Authorization: basicAdmin},
+		{Path: "/1.0/kb/accounts/pagination", Cookie: SessionCookie + "=" + second.ID, APIKey: "bob", APISecret: "lazar"},
+	}
+	if diff := cmp.Diff(expected, rec.reset()); diff != "" {
+		t.Fatalf("unexpected requests (-want +got):\n%s", diff)
+	}
+
+	// Login errors are returned.
+	client, _ = newTestClient(srv, NewSession("admin", "wrong"))
+	if _, err := client.Account.GetAccounts(ctx, &account.GetAccountsParams{}); !isUnauthorized(err) {
+		t.Fatalf("expecting unauthorized error, got %v", err)
+	}
+}
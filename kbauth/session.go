@@ -0,0 +1,100 @@
+package kbauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/security"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// SessionCookie is the name of the kill bill session cookie.
+const SessionCookie = "JSESSIONID"
+
+// Session authenticates requests with a kill bill session. The session is obtained by logging
+// in once with basic authentication, through GET /1.0/kb/security/subject, and is then sent
+// as JSESSIONID cookie. Until the session is obtained, requests use basic authentication.
+//
+// Session implements Refresher: use Transport to log in, and to log in again when the session expires.
+type Session struct {
+	username string
+	password string
+
+	mu      sync.Mutex
+	session *kbmodel.Session
+}
+
+// NewSession returns a session provider for the given user.
+func NewSession(username, password string) *Session {
+	return &Session{username: username, password: password}
+}
+
+// Current returns the current session, or nil if not logged in.
+func (s *Session) Current() *kbmodel.Session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.session == nil {
+		return nil
+	}
+	res := *s.session
+	return &res
+}
+
+// AuthenticateRequest implements runtime.ClientAuthInfoWriter.
+func (s *Session) AuthenticateRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	current := s.Current()
+	if current == nil {
+		return Basic(s.username, s.password).AuthenticateRequest(r, reg)
+	}
+	return r.SetHeaderParam("Cookie", (&http.Cookie{Name: SessionCookie, Value: current.ID}).String())
+}
+
+// Login implements Refresher.
+func (s *Session) Login(ctx context.Context, trp runtime.ClientTransport) error {
+	if s.Current() != nil {
+		return nil
+	}
+	return s.login(ctx, trp)
+}
+
+// Refresh implements Refresher.
+func (s *Session) Refresh(ctx context.Context, trp runtime.ClientTransport) error {
+	s.mu.Lock()
+	s.session = nil
+	s.mu.Unlock()
+	return s.login(ctx, trp)
+}
+
+func (s *Session) login(ctx context.Context, trp runtime.ClientTransport) error {
+	client := security.New(trp, strfmt.Default, Basic(s.username, s.password), kbclient.KillbillDefaults{})
+	resp, err := client.GetCurrentUserSubject(ctx, &security.GetCurrentUserSubjectParams{})
+	if err != nil {
+		return err
+	}
+
+	var session kbmodel.Session
+	if resp.Payload != nil && resp.Payload.Session != nil {
+		session = *resp.Payload.Session
+	}
+	// The session id is the value of the session cookie.
+	header := http.Header{"Set-Cookie": resp.HttpResponse.GetHeaders("Set-Cookie")}
+	for _, c := range (&http.Response{Header: header}).Cookies() {
+		if c.Name == SessionCookie && c.Value != "" {
+			session.ID = c.Value
+		}
+	}
+	if session.ID == "" {
+		return errors.New("kbauth: no session returned by kill bill")
+	}
+
+	s.mu.Lock()
+	s.session = &session
+	s.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,54 @@
+package kbauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// Transport logs in the providers implementing Refresher before their first request,
+// and refreshes their credentials and retries once when a request is rejected with 401.
+// Other providers are left untouched.
+type Transport struct {
+	next runtime.ClientTransport
+}
+
+// NewTransport wraps next.
+func NewTransport(next runtime.ClientTransport) *Transport {
+	return &Transport{next: next}
+}
+
+// Submit submits the operation.
+func (t *Transport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	refresher := asRefresher(op.AuthInfo)
+	if refresher == nil {
+		return t.next.Submit(op)
+	}
+	ctx := op.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := refresher.Login(ctx, t.next); err != nil {
+		return nil, err
+	}
+
+	result, err := t.next.Submit(op)
+	if !isUnauthorized(err) {
+		return result, err
+	}
+	if refreshErr := refresher.Refresh(ctx, t.next); refreshErr != nil {
+		// Return the original error, the credentials are likely wrong.
+		return result, err
+	}
+	return t.next.Submit(op)
+}
+
+// isUnauthorized returns true if err is a kill bill 401 response.
+func isUnauthorized(err error) bool {
+	var kbErr *kbcommon.KillbillError
+	return errors.As(err, &kbErr) && kbErr.HTTPCode == http.StatusUnauthorized
+}
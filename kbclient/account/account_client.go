@@ -53,91 +53,91 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	AddAccountBlockingState(ctx context.Context, params *AddAccountBlockingStateParams) (*AddAccountBlockingStateCreated, error)
+	AddAccountBlockingState(ctx context.Context, params *AddAccountBlockingStateParams, opts ...ClientOption) (*AddAccountBlockingStateCreated, error)
 
-	AddEmail(ctx context.Context, params *AddEmailParams) (*AddEmailCreated, error)
+	AddEmail(ctx context.Context, params *AddEmailParams, opts ...ClientOption) (*AddEmailCreated, error)
 
-	CloseAccount(ctx context.Context, params *CloseAccountParams) (*CloseAccountNoContent, error)
+	CloseAccount(ctx context.Context, params *CloseAccountParams, opts ...ClientOption) (*CloseAccountNoContent, error)
 
-	CreateAccount(ctx context.Context, params *CreateAccountParams) (*CreateAccountCreated, error)
+	CreateAccount(ctx context.Context, params *CreateAccountParams, opts ...ClientOption) (*CreateAccountCreated, error)
 
-	CreateAccountCustomFields(ctx context.Context, params *CreateAccountCustomFieldsParams) (*CreateAccountCustomFieldsCreated, error)
+	CreateAccountCustomFields(ctx context.Context, params *CreateAccountCustomFieldsParams, opts ...ClientOption) (*CreateAccountCustomFieldsCreated, error)
 
-	CreateAccountTags(ctx context.Context, params *CreateAccountTagsParams) (*CreateAccountTagsCreated, error)
+	CreateAccountTags(ctx context.Context, params *CreateAccountTagsParams, opts ...ClientOption) (*CreateAccountTagsCreated, error)
 
-	CreatePaymentMethod(ctx context.Context, params *CreatePaymentMethodParams) (*CreatePaymentMethodCreated, error)
+	CreatePaymentMethod(ctx context.Context, params *CreatePaymentMethodParams, opts ...ClientOption) (*CreatePaymentMethodCreated, error)
 
-	DeleteAccountCustomFields(ctx context.Context, params *DeleteAccountCustomFieldsParams) (*DeleteAccountCustomFieldsNoContent, error)
+	DeleteAccountCustomFields(ctx context.Context, params *DeleteAccountCustomFieldsParams, opts ...ClientOption) (*DeleteAccountCustomFieldsNoContent, error)
 
-	DeleteAccountTags(ctx context.Context, params *DeleteAccountTagsParams) (*DeleteAccountTagsNoContent, error)
+	DeleteAccountTags(ctx context.Context, params *DeleteAccountTagsParams, opts ...ClientOption) (*DeleteAccountTagsNoContent, error)
 
-	GetAccount(ctx context.Context, params *GetAccountParams) (*GetAccountOK, error)
+	GetAccount(ctx context.Context, params *GetAccountParams, opts ...ClientOption) (*GetAccountOK, error)
 
-	GetAccountAuditLogs(ctx context.Context, params *GetAccountAuditLogsParams) (*GetAccountAuditLogsOK, error)
+	GetAccountAuditLogs(ctx context.Context, params *GetAccountAuditLogsParams, opts ...ClientOption) (*GetAccountAuditLogsOK, error)
 
-	GetAccountAuditLogsWithHistory(ctx context.Context, params *GetAccountAuditLogsWithHistoryParams) (*GetAccountAuditLogsWithHistoryOK, error)
+	GetAccountAuditLogsWithHistory(ctx context.Context, params *GetAccountAuditLogsWithHistoryParams, opts ...ClientOption) (*GetAccountAuditLogsWithHistoryOK, error)
 
-	GetAccountBundles(ctx context.Context, params *GetAccountBundlesParams) (*GetAccountBundlesOK, error)
+	GetAccountBundles(ctx context.Context, params *GetAccountBundlesParams, opts ...ClientOption) (*GetAccountBundlesOK, error)
 
-	GetAccountBundlesPaginated(ctx context.Context, params *GetAccountBundlesPaginatedParams) (*GetAccountBundlesPaginatedOK, error)
+	GetAccountBundlesPaginated(ctx context.Context, params *GetAccountBundlesPaginatedParams, opts ...ClientOption) (*GetAccountBundlesPaginatedOK, error)
 
-	GetAccountByKey(ctx context.Context, params *GetAccountByKeyParams) (*GetAccountByKeyOK, error)
+	GetAccountByKey(ctx context.Context, params *GetAccountByKeyParams, opts ...ClientOption) (*GetAccountByKeyOK, error)
 
-	GetAccountCustomFields(ctx context.Context, params *GetAccountCustomFieldsParams) (*GetAccountCustomFieldsOK, error)
+	GetAccountCustomFields(ctx context.Context, params *GetAccountCustomFieldsParams, opts ...ClientOption) (*GetAccountCustomFieldsOK, error)
 
-	GetAccountEmailAuditLogsWithHistory(ctx context.Context, params *GetAccountEmailAuditLogsWithHistoryParams) (*GetAccountEmailAuditLogsWithHistoryOK, error)
+	GetAccountEmailAuditLogsWithHistory(ctx context.Context, params *GetAccountEmailAuditLogsWithHistoryParams, opts ...ClientOption) (*GetAccountEmailAuditLogsWithHistoryOK, error)
 
-	GetAccountTags(ctx context.Context, params *GetAccountTagsParams) (*GetAccountTagsOK, error)
+	GetAccountTags(ctx context.Context, params *GetAccountTagsParams, opts ...ClientOption) (*GetAccountTagsOK, error)
 
-	GetAccountTimeline(ctx context.Context, params *GetAccountTimelineParams) (*GetAccountTimelineOK, error)
+	GetAccountTimeline(ctx context.Context, params *GetAccountTimelineParams, opts ...ClientOption) (*GetAccountTimelineOK, error)
 
-	GetAccounts(ctx context.Context, params *GetAccountsParams) (*GetAccountsOK, error)
+	GetAccounts(ctx context.Context, params *GetAccountsParams, opts ...ClientOption) (*GetAccountsOK, error)
 
-	GetAllCustomFields(ctx context.Context, params *GetAllCustomFieldsParams) (*GetAllCustomFieldsOK, error)
+	GetAllCustomFields(ctx context.Context, params *GetAllCustomFieldsParams, opts ...ClientOption) (*GetAllCustomFieldsOK, error)
 
-	GetAllTags(ctx context.Context, params *GetAllTagsParams) (*GetAllTagsOK, error)
+	GetAllTags(ctx context.Context, params *GetAllTagsParams, opts ...ClientOption) (*GetAllTagsOK, error)
 
-	GetBlockingStateAuditLogsWithHistory(ctx context.Context, params *GetBlockingStateAuditLogsWithHistoryParams) (*GetBlockingStateAuditLogsWithHistoryOK, error)
+	GetBlockingStateAuditLogsWithHistory(ctx context.Context, params *GetBlockingStateAuditLogsWithHistoryParams, opts ...ClientOption) (*GetBlockingStateAuditLogsWithHistoryOK, error)
 
-	GetBlockingStates(ctx context.Context, params *GetBlockingStatesParams) (*GetBlockingStatesOK, error)
+	GetBlockingStates(ctx context.Context, params *GetBlockingStatesParams, opts ...ClientOption) (*GetBlockingStatesOK, error)
 
-	GetChildrenAccounts(ctx context.Context, params *GetChildrenAccountsParams) (*GetChildrenAccountsOK, error)
+	GetChildrenAccounts(ctx context.Context, params *GetChildrenAccountsParams, opts ...ClientOption) (*GetChildrenAccountsOK, error)
 
-	GetEmails(ctx context.Context, params *GetEmailsParams) (*GetEmailsOK, error)
+	GetEmails(ctx context.Context, params *GetEmailsParams, opts ...ClientOption) (*GetEmailsOK, error)
 
-	GetInvoicePayments(ctx context.Context, params *GetInvoicePaymentsParams) (*GetInvoicePaymentsOK, error)
+	GetInvoicePayments(ctx context.Context, params *GetInvoicePaymentsParams, opts ...ClientOption) (*GetInvoicePaymentsOK, error)
 
-	GetInvoicesForAccount(ctx context.Context, params *GetInvoicesForAccountParams) (*GetInvoicesForAccountOK, error)
+	GetInvoicesForAccount(ctx context.Context, params *GetInvoicesForAccountParams, opts ...ClientOption) (*GetInvoicesForAccountOK, error)
 
-	GetInvoicesForAccountPaginated(ctx context.Context, params *GetInvoicesForAccountPaginatedParams) (*GetInvoicesForAccountPaginatedOK, error)
+	GetInvoicesForAccountPaginated(ctx context.Context, params *GetInvoicesForAccountPaginatedParams, opts ...ClientOption) (*GetInvoicesForAccountPaginatedOK, error)
 
-	GetOverdueAccount(ctx context.Context, params *GetOverdueAccountParams) (*GetOverdueAccountOK, error)
+	GetOverdueAccount(ctx context.Context, params *GetOverdueAccountParams, opts ...ClientOption) (*GetOverdueAccountOK, error)
 
-	GetPaymentMethodsForAccount(ctx context.Context, params *GetPaymentMethodsForAccountParams) (*GetPaymentMethodsForAccountOK, error)
+	GetPaymentMethodsForAccount(ctx context.Context, params *GetPaymentMethodsForAccountParams, opts ...ClientOption) (*GetPaymentMethodsForAccountOK, error)
 
-	GetPaymentsForAccount(ctx context.Context, params *GetPaymentsForAccountParams) (*GetPaymentsForAccountOK, error)
+	GetPaymentsForAccount(ctx context.Context, params *GetPaymentsForAccountParams, opts ...ClientOption) (*GetPaymentsForAccountOK, error)
 
-	ModifyAccountCustomFields(ctx context.Context, params *ModifyAccountCustomFieldsParams) (*ModifyAccountCustomFieldsNoContent, error)
+	ModifyAccountCustomFields(ctx context.Context, params *ModifyAccountCustomFieldsParams, opts ...ClientOption) (*ModifyAccountCustomFieldsNoContent, error)
 
-	PayAllInvoices(ctx context.Context, params *PayAllInvoicesParams) (*PayAllInvoicesCreated, *PayAllInvoicesNoContent, error)
+	PayAllInvoices(ctx context.Context, params *PayAllInvoicesParams, opts ...ClientOption) (*PayAllInvoicesCreated, *PayAllInvoicesNoContent, error)
 
-	ProcessPayment(ctx context.Context, params *ProcessPaymentParams) (*ProcessPaymentCreated, error)
+	ProcessPayment(ctx context.Context, params *ProcessPaymentParams, opts ...ClientOption) (*ProcessPaymentCreated, error)
 
-	ProcessPaymentByExternalKey(ctx context.Context, params *ProcessPaymentByExternalKeyParams) (*ProcessPaymentByExternalKeyCreated, error)
+	ProcessPaymentByExternalKey(ctx context.Context, params *ProcessPaymentByExternalKeyParams, opts ...ClientOption) (*ProcessPaymentByExternalKeyCreated, error)
 
-	RebalanceExistingCBAOnAccount(ctx context.Context, params *RebalanceExistingCBAOnAccountParams) (*RebalanceExistingCBAOnAccountNoContent, error)
+	RebalanceExistingCBAOnAccount(ctx context.Context, params *RebalanceExistingCBAOnAccountParams, opts ...ClientOption) (*RebalanceExistingCBAOnAccountNoContent, error)
 
-	RefreshPaymentMethods(ctx context.Context, params *RefreshPaymentMethodsParams) (*RefreshPaymentMethodsNoContent, error)
+	RefreshPaymentMethods(ctx context.Context, params *RefreshPaymentMethodsParams, opts ...ClientOption) (*RefreshPaymentMethodsNoContent, error)
 
-	RemoveEmail(ctx context.Context, params *RemoveEmailParams) (*RemoveEmailNoContent, error)
+	RemoveEmail(ctx context.Context, params *RemoveEmailParams, opts ...ClientOption) (*RemoveEmailNoContent, error)
 
-	SearchAccounts(ctx context.Context, params *SearchAccountsParams) (*SearchAccountsOK, error)
+	SearchAccounts(ctx context.Context, params *SearchAccountsParams, opts ...ClientOption) (*SearchAccountsOK, error)
 
-	SetDefaultPaymentMethod(ctx context.Context, params *SetDefaultPaymentMethodParams) (*SetDefaultPaymentMethodNoContent, error)
+	SetDefaultPaymentMethod(ctx context.Context, params *SetDefaultPaymentMethodParams, opts ...ClientOption) (*SetDefaultPaymentMethodNoContent, error)
 
-	TransferChildCreditToParent(ctx context.Context, params *TransferChildCreditToParentParams) (*TransferChildCreditToParentNoContent, error)
+	TransferChildCreditToParent(ctx context.Context, params *TransferChildCreditToParentParams, opts ...ClientOption) (*TransferChildCreditToParentNoContent, error)
 
-	UpdateAccount(ctx context.Context, params *UpdateAccountParams) (*UpdateAccountNoContent, error)
+	UpdateAccount(ctx context.Context, params *UpdateAccountParams, opts ...ClientOption) (*UpdateAccountNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -145,7 +145,7 @@ type ClientService interface {
 /*
   AddAccountBlockingState blocks an account
 */
-func (a *Client) AddAccountBlockingState(ctx context.Context, params *AddAccountBlockingStateParams) (*AddAccountBlockingStateCreated, error) {
+func (a *Client) AddAccountBlockingState(ctx context.Context, params *AddAccountBlockingStateParams, opts ...ClientOption) (*AddAccountBlockingStateCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAddAccountBlockingStateParams()
@@ -187,6 +187,9 @@ func (a *Client) AddAccountBlockingState(ctx context.Context, params *AddAccount
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -198,7 +201,7 @@ func (a *Client) AddAccountBlockingState(ctx context.Context, params *AddAccount
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "addAccountBlockingState",
 		Method:             "GET",
 		PathPattern:        location,
@@ -210,7 +213,12 @@ func (a *Client) AddAccountBlockingState(ctx context.Context, params *AddAccount
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +229,7 @@ func (a *Client) AddAccountBlockingState(ctx context.Context, params *AddAccount
 /*
   AddEmail adds account email
 */
-func (a *Client) AddEmail(ctx context.Context, params *AddEmailParams) (*AddEmailCreated, error) {
+func (a *Client) AddEmail(ctx context.Context, params *AddEmailParams, opts ...ClientOption) (*AddEmailCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAddEmailParams()
@@ -263,6 +271,9 @@ func (a *Client) AddEmail(ctx context.Context, params *AddEmailParams) (*AddEmai
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -274,7 +285,7 @@ func (a *Client) AddEmail(ctx context.Context, params *AddEmailParams) (*AddEmai
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "addEmail",
 		Method:             "GET",
 		PathPattern:        location,
@@ -286,7 +297,12 @@ func (a *Client) AddEmail(ctx context.Context, params *AddEmailParams) (*AddEmai
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +313,7 @@ func (a *Client) AddEmail(ctx context.Context, params *AddEmailParams) (*AddEmai
 /*
   CloseAccount closes account
 */
-func (a *Client) CloseAccount(ctx context.Context, params *CloseAccountParams) (*CloseAccountNoContent, error) {
+func (a *Client) CloseAccount(ctx context.Context, params *CloseAccountParams, opts ...ClientOption) (*CloseAccountNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCloseAccountParams()
@@ -336,6 +352,9 @@ func (a *Client) CloseAccount(ctx context.Context, params *CloseAccountParams) (
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -355,7 +374,7 @@ func (a *Client) CloseAccount(ctx context.Context, params *CloseAccountParams) (
 /*
   CreateAccount creates account
 */
-func (a *Client) CreateAccount(ctx context.Context, params *CreateAccountParams) (*CreateAccountCreated, error) {
+func (a *Client) CreateAccount(ctx context.Context, params *CreateAccountParams, opts ...ClientOption) (*CreateAccountCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateAccountParams()
@@ -397,6 +416,9 @@ func (a *Client) CreateAccount(ctx context.Context, params *CreateAccountParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -408,7 +430,7 @@ func (a *Client) CreateAccount(ctx context.Context, params *CreateAccountParams)
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createAccount",
 		Method:             "GET",
 		PathPattern:        location,
@@ -420,7 +442,12 @@ func (a *Client) CreateAccount(ctx context.Context, params *CreateAccountParams)
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -431,7 +458,7 @@ func (a *Client) CreateAccount(ctx context.Context, params *CreateAccountParams)
 /*
   CreateAccountCustomFields adds custom fields to account
 */
-func (a *Client) CreateAccountCustomFields(ctx context.Context, params *CreateAccountCustomFieldsParams) (*CreateAccountCustomFieldsCreated, error) {
+func (a *Client) CreateAccountCustomFields(ctx context.Context, params *CreateAccountCustomFieldsParams, opts ...ClientOption) (*CreateAccountCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateAccountCustomFieldsParams()
@@ -473,6 +500,9 @@ func (a *Client) CreateAccountCustomFields(ctx context.Context, params *CreateAc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -484,7 +514,7 @@ func (a *Client) CreateAccountCustomFields(ctx context.Context, params *CreateAc
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createAccountCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -496,7 +526,12 @@ func (a *Client) CreateAccountCustomFields(ctx context.Context, params *CreateAc
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -507,7 +542,7 @@ func (a *Client) CreateAccountCustomFields(ctx context.Context, params *CreateAc
 /*
   CreateAccountTags adds tags to account
 */
-func (a *Client) CreateAccountTags(ctx context.Context, params *CreateAccountTagsParams) (*CreateAccountTagsCreated, error) {
+func (a *Client) CreateAccountTags(ctx context.Context, params *CreateAccountTagsParams, opts ...ClientOption) (*CreateAccountTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateAccountTagsParams()
@@ -549,6 +584,9 @@ func (a *Client) CreateAccountTags(ctx context.Context, params *CreateAccountTag
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -560,7 +598,7 @@ func (a *Client) CreateAccountTags(ctx context.Context, params *CreateAccountTag
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createAccountTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -572,7 +610,12 @@ func (a *Client) CreateAccountTags(ctx context.Context, params *CreateAccountTag
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -583,7 +626,7 @@ func (a *Client) CreateAccountTags(ctx context.Context, params *CreateAccountTag
 /*
   CreatePaymentMethod adds a payment method
 */
-func (a *Client) CreatePaymentMethod(ctx context.Context, params *CreatePaymentMethodParams) (*CreatePaymentMethodCreated, error) {
+func (a *Client) CreatePaymentMethod(ctx context.Context, params *CreatePaymentMethodParams, opts ...ClientOption) (*CreatePaymentMethodCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreatePaymentMethodParams()
@@ -625,6 +668,9 @@ func (a *Client) CreatePaymentMethod(ctx context.Context, params *CreatePaymentM
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -636,7 +682,7 @@ func (a *Client) CreatePaymentMethod(ctx context.Context, params *CreatePaymentM
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createPaymentMethod",
 		Method:             "GET",
 		PathPattern:        location,
@@ -648,7 +694,12 @@ func (a *Client) CreatePaymentMethod(ctx context.Context, params *CreatePaymentM
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -659,7 +710,7 @@ func (a *Client) CreatePaymentMethod(ctx context.Context, params *CreatePaymentM
 /*
   DeleteAccountCustomFields removes custom fields from account
 */
-func (a *Client) DeleteAccountCustomFields(ctx context.Context, params *DeleteAccountCustomFieldsParams) (*DeleteAccountCustomFieldsNoContent, error) {
+func (a *Client) DeleteAccountCustomFields(ctx context.Context, params *DeleteAccountCustomFieldsParams, opts ...ClientOption) (*DeleteAccountCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteAccountCustomFieldsParams()
@@ -698,6 +749,9 @@ func (a *Client) DeleteAccountCustomFields(ctx context.Context, params *DeleteAc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -717,7 +771,7 @@ func (a *Client) DeleteAccountCustomFields(ctx context.Context, params *DeleteAc
 /*
   DeleteAccountTags removes tags from account
 */
-func (a *Client) DeleteAccountTags(ctx context.Context, params *DeleteAccountTagsParams) (*DeleteAccountTagsNoContent, error) {
+func (a *Client) DeleteAccountTags(ctx context.Context, params *DeleteAccountTagsParams, opts ...ClientOption) (*DeleteAccountTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteAccountTagsParams()
@@ -756,6 +810,9 @@ func (a *Client) DeleteAccountTags(ctx context.Context, params *DeleteAccountTag
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -775,7 +832,7 @@ func (a *Client) DeleteAccountTags(ctx context.Context, params *DeleteAccountTag
 /*
   GetAccount retrieves an account by id
 */
-func (a *Client) GetAccount(ctx context.Context, params *GetAccountParams) (*GetAccountOK, error) {
+func (a *Client) GetAccount(ctx context.Context, params *GetAccountParams, opts ...ClientOption) (*GetAccountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountParams()
@@ -802,6 +859,9 @@ func (a *Client) GetAccount(ctx context.Context, params *GetAccountParams) (*Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -821,7 +881,7 @@ func (a *Client) GetAccount(ctx context.Context, params *GetAccountParams) (*Get
 /*
   GetAccountAuditLogs retrieves audit logs by account id
 */
-func (a *Client) GetAccountAuditLogs(ctx context.Context, params *GetAccountAuditLogsParams) (*GetAccountAuditLogsOK, error) {
+func (a *Client) GetAccountAuditLogs(ctx context.Context, params *GetAccountAuditLogsParams, opts ...ClientOption) (*GetAccountAuditLogsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountAuditLogsParams()
@@ -848,6 +908,9 @@ func (a *Client) GetAccountAuditLogs(ctx context.Context, params *GetAccountAudi
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -867,7 +930,7 @@ func (a *Client) GetAccountAuditLogs(ctx context.Context, params *GetAccountAudi
 /*
   GetAccountAuditLogsWithHistory retrieves account audit logs with history by account id
 */
-func (a *Client) GetAccountAuditLogsWithHistory(ctx context.Context, params *GetAccountAuditLogsWithHistoryParams) (*GetAccountAuditLogsWithHistoryOK, error) {
+func (a *Client) GetAccountAuditLogsWithHistory(ctx context.Context, params *GetAccountAuditLogsWithHistoryParams, opts ...ClientOption) (*GetAccountAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountAuditLogsWithHistoryParams()
@@ -894,6 +957,9 @@ func (a *Client) GetAccountAuditLogsWithHistory(ctx context.Context, params *Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -913,7 +979,7 @@ func (a *Client) GetAccountAuditLogsWithHistory(ctx context.Context, params *Get
 /*
   GetAccountBundles retrieves bundles for account
 */
-func (a *Client) GetAccountBundles(ctx context.Context, params *GetAccountBundlesParams) (*GetAccountBundlesOK, error) {
+func (a *Client) GetAccountBundles(ctx context.Context, params *GetAccountBundlesParams, opts ...ClientOption) (*GetAccountBundlesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountBundlesParams()
@@ -940,6 +1006,9 @@ func (a *Client) GetAccountBundles(ctx context.Context, params *GetAccountBundle
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -959,7 +1028,7 @@ func (a *Client) GetAccountBundles(ctx context.Context, params *GetAccountBundle
 /*
   GetAccountBundlesPaginated retrieves paginated bundles for account
 */
-func (a *Client) GetAccountBundlesPaginated(ctx context.Context, params *GetAccountBundlesPaginatedParams) (*GetAccountBundlesPaginatedOK, error) {
+func (a *Client) GetAccountBundlesPaginated(ctx context.Context, params *GetAccountBundlesPaginatedParams, opts ...ClientOption) (*GetAccountBundlesPaginatedOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountBundlesPaginatedParams()
@@ -986,6 +1055,9 @@ func (a *Client) GetAccountBundlesPaginated(ctx context.Context, params *GetAcco
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1005,7 +1077,7 @@ func (a *Client) GetAccountBundlesPaginated(ctx context.Context, params *GetAcco
 /*
   GetAccountByKey retrieves an account by external key
 */
-func (a *Client) GetAccountByKey(ctx context.Context, params *GetAccountByKeyParams) (*GetAccountByKeyOK, error) {
+func (a *Client) GetAccountByKey(ctx context.Context, params *GetAccountByKeyParams, opts ...ClientOption) (*GetAccountByKeyOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountByKeyParams()
@@ -1032,6 +1104,9 @@ func (a *Client) GetAccountByKey(ctx context.Context, params *GetAccountByKeyPar
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1051,7 +1126,7 @@ func (a *Client) GetAccountByKey(ctx context.Context, params *GetAccountByKeyPar
 /*
   GetAccountCustomFields retrieves account custom fields
 */
-func (a *Client) GetAccountCustomFields(ctx context.Context, params *GetAccountCustomFieldsParams) (*GetAccountCustomFieldsOK, error) {
+func (a *Client) GetAccountCustomFields(ctx context.Context, params *GetAccountCustomFieldsParams, opts ...ClientOption) (*GetAccountCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountCustomFieldsParams()
@@ -1078,6 +1153,9 @@ func (a *Client) GetAccountCustomFields(ctx context.Context, params *GetAccountC
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1097,7 +1175,7 @@ func (a *Client) GetAccountCustomFields(ctx context.Context, params *GetAccountC
 /*
   GetAccountEmailAuditLogsWithHistory retrieves account email audit logs with history by id
 */
-func (a *Client) GetAccountEmailAuditLogsWithHistory(ctx context.Context, params *GetAccountEmailAuditLogsWithHistoryParams) (*GetAccountEmailAuditLogsWithHistoryOK, error) {
+func (a *Client) GetAccountEmailAuditLogsWithHistory(ctx context.Context, params *GetAccountEmailAuditLogsWithHistoryParams, opts ...ClientOption) (*GetAccountEmailAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountEmailAuditLogsWithHistoryParams()
@@ -1124,6 +1202,9 @@ func (a *Client) GetAccountEmailAuditLogsWithHistory(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1143,7 +1224,7 @@ func (a *Client) GetAccountEmailAuditLogsWithHistory(ctx context.Context, params
 /*
   GetAccountTags retrieves account tags
 */
-func (a *Client) GetAccountTags(ctx context.Context, params *GetAccountTagsParams) (*GetAccountTagsOK, error) {
+func (a *Client) GetAccountTags(ctx context.Context, params *GetAccountTagsParams, opts ...ClientOption) (*GetAccountTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountTagsParams()
@@ -1170,6 +1251,9 @@ func (a *Client) GetAccountTags(ctx context.Context, params *GetAccountTagsParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1189,7 +1273,7 @@ func (a *Client) GetAccountTags(ctx context.Context, params *GetAccountTagsParam
 /*
   GetAccountTimeline retrieves account timeline
 */
-func (a *Client) GetAccountTimeline(ctx context.Context, params *GetAccountTimelineParams) (*GetAccountTimelineOK, error) {
+func (a *Client) GetAccountTimeline(ctx context.Context, params *GetAccountTimelineParams, opts ...ClientOption) (*GetAccountTimelineOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountTimelineParams()
@@ -1216,6 +1300,9 @@ func (a *Client) GetAccountTimeline(ctx context.Context, params *GetAccountTimel
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1235,7 +1322,7 @@ func (a *Client) GetAccountTimeline(ctx context.Context, params *GetAccountTimel
 /*
   GetAccounts lists accounts
 */
-func (a *Client) GetAccounts(ctx context.Context, params *GetAccountsParams) (*GetAccountsOK, error) {
+func (a *Client) GetAccounts(ctx context.Context, params *GetAccountsParams, opts ...ClientOption) (*GetAccountsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAccountsParams()
@@ -1262,6 +1349,9 @@ func (a *Client) GetAccounts(ctx context.Context, params *GetAccountsParams) (*G
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1281,7 +1371,7 @@ func (a *Client) GetAccounts(ctx context.Context, params *GetAccountsParams) (*G
 /*
   GetAllCustomFields retrieves account custom fields
 */
-func (a *Client) GetAllCustomFields(ctx context.Context, params *GetAllCustomFieldsParams) (*GetAllCustomFieldsOK, error) {
+func (a *Client) GetAllCustomFields(ctx context.Context, params *GetAllCustomFieldsParams, opts ...ClientOption) (*GetAllCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAllCustomFieldsParams()
@@ -1308,6 +1398,9 @@ func (a *Client) GetAllCustomFields(ctx context.Context, params *GetAllCustomFie
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1327,7 +1420,7 @@ func (a *Client) GetAllCustomFields(ctx context.Context, params *GetAllCustomFie
 /*
   GetAllTags retrieves account tags
 */
-func (a *Client) GetAllTags(ctx context.Context, params *GetAllTagsParams) (*GetAllTagsOK, error) {
+func (a *Client) GetAllTags(ctx context.Context, params *GetAllTagsParams, opts ...ClientOption) (*GetAllTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAllTagsParams()
@@ -1354,6 +1447,9 @@ func (a *Client) GetAllTags(ctx context.Context, params *GetAllTagsParams) (*Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1373,7 +1469,7 @@ func (a *Client) GetAllTags(ctx context.Context, params *GetAllTagsParams) (*Get
 /*
   GetBlockingStateAuditLogsWithHistory retrieves blocking state audit logs with history by id
 */
-func (a *Client) GetBlockingStateAuditLogsWithHistory(ctx context.Context, params *GetBlockingStateAuditLogsWithHistoryParams) (*GetBlockingStateAuditLogsWithHistoryOK, error) {
+func (a *Client) GetBlockingStateAuditLogsWithHistory(ctx context.Context, params *GetBlockingStateAuditLogsWithHistoryParams, opts ...ClientOption) (*GetBlockingStateAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBlockingStateAuditLogsWithHistoryParams()
@@ -1400,6 +1496,9 @@ func (a *Client) GetBlockingStateAuditLogsWithHistory(ctx context.Context, param
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1419,7 +1518,7 @@ func (a *Client) GetBlockingStateAuditLogsWithHistory(ctx context.Context, param
 /*
   GetBlockingStates retrieves blocking states for account
 */
-func (a *Client) GetBlockingStates(ctx context.Context, params *GetBlockingStatesParams) (*GetBlockingStatesOK, error) {
+func (a *Client) GetBlockingStates(ctx context.Context, params *GetBlockingStatesParams, opts ...ClientOption) (*GetBlockingStatesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBlockingStatesParams()
@@ -1446,6 +1545,9 @@ func (a *Client) GetBlockingStates(ctx context.Context, params *GetBlockingState
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1465,7 +1567,7 @@ func (a *Client) GetBlockingStates(ctx context.Context, params *GetBlockingState
 /*
   GetChildrenAccounts lists children accounts
 */
-func (a *Client) GetChildrenAccounts(ctx context.Context, params *GetChildrenAccountsParams) (*GetChildrenAccountsOK, error) {
+func (a *Client) GetChildrenAccounts(ctx context.Context, params *GetChildrenAccountsParams, opts ...ClientOption) (*GetChildrenAccountsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetChildrenAccountsParams()
@@ -1492,6 +1594,9 @@ func (a *Client) GetChildrenAccounts(ctx context.Context, params *GetChildrenAcc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1511,7 +1616,7 @@ func (a *Client) GetChildrenAccounts(ctx context.Context, params *GetChildrenAcc
 /*
   GetEmails retrieves an account emails
 */
-func (a *Client) GetEmails(ctx context.Context, params *GetEmailsParams) (*GetEmailsOK, error) {
+func (a *Client) GetEmails(ctx context.Context, params *GetEmailsParams, opts ...ClientOption) (*GetEmailsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetEmailsParams()
@@ -1538,6 +1643,9 @@ func (a *Client) GetEmails(ctx context.Context, params *GetEmailsParams) (*GetEm
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1557,7 +1665,7 @@ func (a *Client) GetEmails(ctx context.Context, params *GetEmailsParams) (*GetEm
 /*
   GetInvoicePayments retrieves account invoice payments
 */
-func (a *Client) GetInvoicePayments(ctx context.Context, params *GetInvoicePaymentsParams) (*GetInvoicePaymentsOK, error) {
+func (a *Client) GetInvoicePayments(ctx context.Context, params *GetInvoicePaymentsParams, opts ...ClientOption) (*GetInvoicePaymentsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicePaymentsParams()
@@ -1584,6 +1692,9 @@ func (a *Client) GetInvoicePayments(ctx context.Context, params *GetInvoicePayme
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1603,7 +1714,7 @@ func (a *Client) GetInvoicePayments(ctx context.Context, params *GetInvoicePayme
 /*
   GetInvoicesForAccount retrieves account invoices
 */
-func (a *Client) GetInvoicesForAccount(ctx context.Context, params *GetInvoicesForAccountParams) (*GetInvoicesForAccountOK, error) {
+func (a *Client) GetInvoicesForAccount(ctx context.Context, params *GetInvoicesForAccountParams, opts ...ClientOption) (*GetInvoicesForAccountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicesForAccountParams()
@@ -1630,6 +1741,9 @@ func (a *Client) GetInvoicesForAccount(ctx context.Context, params *GetInvoicesF
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1649,7 +1763,7 @@ func (a *Client) GetInvoicesForAccount(ctx context.Context, params *GetInvoicesF
 /*
   GetInvoicesForAccountPaginated retrieves paginated invoices for account
 */
-func (a *Client) GetInvoicesForAccountPaginated(ctx context.Context, params *GetInvoicesForAccountPaginatedParams) (*GetInvoicesForAccountPaginatedOK, error) {
+func (a *Client) GetInvoicesForAccountPaginated(ctx context.Context, params *GetInvoicesForAccountPaginatedParams, opts ...ClientOption) (*GetInvoicesForAccountPaginatedOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicesForAccountPaginatedParams()
@@ -1676,6 +1790,9 @@ func (a *Client) GetInvoicesForAccountPaginated(ctx context.Context, params *Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1695,7 +1812,7 @@ func (a *Client) GetInvoicesForAccountPaginated(ctx context.Context, params *Get
 /*
   GetOverdueAccount retrieves overdue state for account
 */
-func (a *Client) GetOverdueAccount(ctx context.Context, params *GetOverdueAccountParams) (*GetOverdueAccountOK, error) {
+func (a *Client) GetOverdueAccount(ctx context.Context, params *GetOverdueAccountParams, opts ...ClientOption) (*GetOverdueAccountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetOverdueAccountParams()
@@ -1722,6 +1839,9 @@ func (a *Client) GetOverdueAccount(ctx context.Context, params *GetOverdueAccoun
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1741,7 +1861,7 @@ func (a *Client) GetOverdueAccount(ctx context.Context, params *GetOverdueAccoun
 /*
   GetPaymentMethodsForAccount retrieves account payment methods
 */
-func (a *Client) GetPaymentMethodsForAccount(ctx context.Context, params *GetPaymentMethodsForAccountParams) (*GetPaymentMethodsForAccountOK, error) {
+func (a *Client) GetPaymentMethodsForAccount(ctx context.Context, params *GetPaymentMethodsForAccountParams, opts ...ClientOption) (*GetPaymentMethodsForAccountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentMethodsForAccountParams()
@@ -1768,6 +1888,9 @@ func (a *Client) GetPaymentMethodsForAccount(ctx context.Context, params *GetPay
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1787,7 +1910,7 @@ func (a *Client) GetPaymentMethodsForAccount(ctx context.Context, params *GetPay
 /*
   GetPaymentsForAccount retrieves account payments
 */
-func (a *Client) GetPaymentsForAccount(ctx context.Context, params *GetPaymentsForAccountParams) (*GetPaymentsForAccountOK, error) {
+func (a *Client) GetPaymentsForAccount(ctx context.Context, params *GetPaymentsForAccountParams, opts ...ClientOption) (*GetPaymentsForAccountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentsForAccountParams()
@@ -1814,6 +1937,9 @@ func (a *Client) GetPaymentsForAccount(ctx context.Context, params *GetPaymentsF
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1833,7 +1959,7 @@ func (a *Client) GetPaymentsForAccount(ctx context.Context, params *GetPaymentsF
 /*
   ModifyAccountCustomFields modifies custom fields to account
 */
-func (a *Client) ModifyAccountCustomFields(ctx context.Context, params *ModifyAccountCustomFieldsParams) (*ModifyAccountCustomFieldsNoContent, error) {
+func (a *Client) ModifyAccountCustomFields(ctx context.Context, params *ModifyAccountCustomFieldsParams, opts ...ClientOption) (*ModifyAccountCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyAccountCustomFieldsParams()
@@ -1872,6 +1998,9 @@ func (a *Client) ModifyAccountCustomFields(ctx context.Context, params *ModifyAc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1891,7 +2020,7 @@ func (a *Client) ModifyAccountCustomFields(ctx context.Context, params *ModifyAc
 /*
   PayAllInvoices triggers a payment for all unpaid invoices
 */
-func (a *Client) PayAllInvoices(ctx context.Context, params *PayAllInvoicesParams) (*PayAllInvoicesCreated, *PayAllInvoicesNoContent, error) {
+func (a *Client) PayAllInvoices(ctx context.Context, params *PayAllInvoicesParams, opts ...ClientOption) (*PayAllInvoicesCreated, *PayAllInvoicesNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewPayAllInvoicesParams()
@@ -1930,6 +2059,9 @@ func (a *Client) PayAllInvoices(ctx context.Context, params *PayAllInvoicesParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1950,7 +2082,7 @@ func (a *Client) PayAllInvoices(ctx context.Context, params *PayAllInvoicesParam
 /*
   ProcessPayment triggers a payment authorization purchase or credit
 */
-func (a *Client) ProcessPayment(ctx context.Context, params *ProcessPaymentParams) (*ProcessPaymentCreated, error) {
+func (a *Client) ProcessPayment(ctx context.Context, params *ProcessPaymentParams, opts ...ClientOption) (*ProcessPaymentCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewProcessPaymentParams()
@@ -1992,6 +2124,9 @@ func (a *Client) ProcessPayment(ctx context.Context, params *ProcessPaymentParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2003,7 +2138,7 @@ func (a *Client) ProcessPayment(ctx context.Context, params *ProcessPaymentParam
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "processPayment",
 		Method:             "GET",
 		PathPattern:        location,
@@ -2015,7 +2150,12 @@ func (a *Client) ProcessPayment(ctx context.Context, params *ProcessPaymentParam
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -2026,7 +2166,7 @@ func (a *Client) ProcessPayment(ctx context.Context, params *ProcessPaymentParam
 /*
   ProcessPaymentByExternalKey triggers a payment using the account external key authorization purchase or credit
 */
-func (a *Client) ProcessPaymentByExternalKey(ctx context.Context, params *ProcessPaymentByExternalKeyParams) (*ProcessPaymentByExternalKeyCreated, error) {
+func (a *Client) ProcessPaymentByExternalKey(ctx context.Context, params *ProcessPaymentByExternalKeyParams, opts ...ClientOption) (*ProcessPaymentByExternalKeyCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewProcessPaymentByExternalKeyParams()
@@ -2068,6 +2208,9 @@ func (a *Client) ProcessPaymentByExternalKey(ctx context.Context, params *Proces
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2079,7 +2222,7 @@ func (a *Client) ProcessPaymentByExternalKey(ctx context.Context, params *Proces
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "processPaymentByExternalKey",
 		Method:             "GET",
 		PathPattern:        location,
@@ -2091,7 +2234,12 @@ func (a *Client) ProcessPaymentByExternalKey(ctx context.Context, params *Proces
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -2102,7 +2250,7 @@ func (a *Client) ProcessPaymentByExternalKey(ctx context.Context, params *Proces
 /*
   RebalanceExistingCBAOnAccount rebalances account c b a
 */
-func (a *Client) RebalanceExistingCBAOnAccount(ctx context.Context, params *RebalanceExistingCBAOnAccountParams) (*RebalanceExistingCBAOnAccountNoContent, error) {
+func (a *Client) RebalanceExistingCBAOnAccount(ctx context.Context, params *RebalanceExistingCBAOnAccountParams, opts ...ClientOption) (*RebalanceExistingCBAOnAccountNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRebalanceExistingCBAOnAccountParams()
@@ -2141,6 +2289,9 @@ func (a *Client) RebalanceExistingCBAOnAccount(ctx context.Context, params *Reba
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2160,7 +2311,7 @@ func (a *Client) RebalanceExistingCBAOnAccount(ctx context.Context, params *Reba
 /*
   RefreshPaymentMethods refreshes account payment methods
 */
-func (a *Client) RefreshPaymentMethods(ctx context.Context, params *RefreshPaymentMethodsParams) (*RefreshPaymentMethodsNoContent, error) {
+func (a *Client) RefreshPaymentMethods(ctx context.Context, params *RefreshPaymentMethodsParams, opts ...ClientOption) (*RefreshPaymentMethodsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRefreshPaymentMethodsParams()
@@ -2199,6 +2350,9 @@ func (a *Client) RefreshPaymentMethods(ctx context.Context, params *RefreshPayme
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2218,7 +2372,7 @@ func (a *Client) RefreshPaymentMethods(ctx context.Context, params *RefreshPayme
 /*
   RemoveEmail deletes email from account
 */
-func (a *Client) RemoveEmail(ctx context.Context, params *RemoveEmailParams) (*RemoveEmailNoContent, error) {
+func (a *Client) RemoveEmail(ctx context.Context, params *RemoveEmailParams, opts ...ClientOption) (*RemoveEmailNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRemoveEmailParams()
@@ -2257,6 +2411,9 @@ func (a *Client) RemoveEmail(ctx context.Context, params *RemoveEmailParams) (*R
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2276,7 +2433,7 @@ func (a *Client) RemoveEmail(ctx context.Context, params *RemoveEmailParams) (*R
 /*
   SearchAccounts searches accounts
 */
-func (a *Client) SearchAccounts(ctx context.Context, params *SearchAccountsParams) (*SearchAccountsOK, error) {
+func (a *Client) SearchAccounts(ctx context.Context, params *SearchAccountsParams, opts ...ClientOption) (*SearchAccountsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchAccountsParams()
@@ -2303,6 +2460,9 @@ func (a *Client) SearchAccounts(ctx context.Context, params *SearchAccountsParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2322,7 +2482,7 @@ func (a *Client) SearchAccounts(ctx context.Context, params *SearchAccountsParam
 /*
   SetDefaultPaymentMethod sets the default payment method
 */
-func (a *Client) SetDefaultPaymentMethod(ctx context.Context, params *SetDefaultPaymentMethodParams) (*SetDefaultPaymentMethodNoContent, error) {
+func (a *Client) SetDefaultPaymentMethod(ctx context.Context, params *SetDefaultPaymentMethodParams, opts ...ClientOption) (*SetDefaultPaymentMethodNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSetDefaultPaymentMethodParams()
@@ -2361,6 +2521,9 @@ func (a *Client) SetDefaultPaymentMethod(ctx context.Context, params *SetDefault
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2380,7 +2543,7 @@ func (a *Client) SetDefaultPaymentMethod(ctx context.Context, params *SetDefault
 /*
   TransferChildCreditToParent moves a given child credit to the parent level
 */
-func (a *Client) TransferChildCreditToParent(ctx context.Context, params *TransferChildCreditToParentParams) (*TransferChildCreditToParentNoContent, error) {
+func (a *Client) TransferChildCreditToParent(ctx context.Context, params *TransferChildCreditToParentParams, opts ...ClientOption) (*TransferChildCreditToParentNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewTransferChildCreditToParentParams()
@@ -2419,6 +2582,9 @@ func (a *Client) TransferChildCreditToParent(ctx context.Context, params *Transf
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2438,7 +2604,7 @@ func (a *Client) TransferChildCreditToParent(ctx context.Context, params *Transf
 /*
   UpdateAccount updates account
 */
-func (a *Client) UpdateAccount(ctx context.Context, params *UpdateAccountParams) (*UpdateAccountNoContent, error) {
+func (a *Client) UpdateAccount(ctx context.Context, params *UpdateAccountParams, opts ...ClientOption) (*UpdateAccountNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateAccountParams()
@@ -2477,6 +2643,9 @@ func (a *Client) UpdateAccount(ctx context.Context, params *UpdateAccountParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
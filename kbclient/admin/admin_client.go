@@ -52,21 +52,21 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetQueueEntries(ctx context.Context, params *GetQueueEntriesParams) (*GetQueueEntriesOK, error)
+	GetQueueEntries(ctx context.Context, params *GetQueueEntriesParams, opts ...ClientOption) (*GetQueueEntriesOK, error)
 
-	InvalidatesCache(ctx context.Context, params *InvalidatesCacheParams) (*InvalidatesCacheNoContent, error)
+	InvalidatesCache(ctx context.Context, params *InvalidatesCacheParams, opts ...ClientOption) (*InvalidatesCacheNoContent, error)
 
-	InvalidatesCacheByAccount(ctx context.Context, params *InvalidatesCacheByAccountParams) (*InvalidatesCacheByAccountNoContent, error)
+	InvalidatesCacheByAccount(ctx context.Context, params *InvalidatesCacheByAccountParams, opts ...ClientOption) (*InvalidatesCacheByAccountNoContent, error)
 
-	InvalidatesCacheByTenant(ctx context.Context, params *InvalidatesCacheByTenantParams) (*InvalidatesCacheByTenantNoContent, error)
+	InvalidatesCacheByTenant(ctx context.Context, params *InvalidatesCacheByTenantParams, opts ...ClientOption) (*InvalidatesCacheByTenantNoContent, error)
 
-	PutInRotation(ctx context.Context, params *PutInRotationParams) (*PutInRotationNoContent, error)
+	PutInRotation(ctx context.Context, params *PutInRotationParams, opts ...ClientOption) (*PutInRotationNoContent, error)
 
-	PutOutOfRotation(ctx context.Context, params *PutOutOfRotationParams) (*PutOutOfRotationNoContent, error)
+	PutOutOfRotation(ctx context.Context, params *PutOutOfRotationParams, opts ...ClientOption) (*PutOutOfRotationNoContent, error)
 
-	TriggerInvoiceGenerationForParkedAccounts(ctx context.Context, params *TriggerInvoiceGenerationForParkedAccountsParams) (*TriggerInvoiceGenerationForParkedAccountsOK, error)
+	TriggerInvoiceGenerationForParkedAccounts(ctx context.Context, params *TriggerInvoiceGenerationForParkedAccountsParams, opts ...ClientOption) (*TriggerInvoiceGenerationForParkedAccountsOK, error)
 
-	UpdatePaymentTransactionState(ctx context.Context, params *UpdatePaymentTransactionStateParams) (*UpdatePaymentTransactionStateNoContent, error)
+	UpdatePaymentTransactionState(ctx context.Context, params *UpdatePaymentTransactionStateParams, opts ...ClientOption) (*UpdatePaymentTransactionStateNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -74,7 +74,7 @@ type ClientService interface {
 /*
   GetQueueEntries gets queues entries
 */
-func (a *Client) GetQueueEntries(ctx context.Context, params *GetQueueEntriesParams) (*GetQueueEntriesOK, error) {
+func (a *Client) GetQueueEntries(ctx context.Context, params *GetQueueEntriesParams, opts ...ClientOption) (*GetQueueEntriesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetQueueEntriesParams()
@@ -101,6 +101,9 @@ func (a *Client) GetQueueEntries(ctx context.Context, params *GetQueueEntriesPar
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -120,7 +123,7 @@ func (a *Client) GetQueueEntries(ctx context.Context, params *GetQueueEntriesPar
 /*
   InvalidatesCache invalidates the given cache if specified otherwise invalidates all caches
 */
-func (a *Client) InvalidatesCache(ctx context.Context, params *InvalidatesCacheParams) (*InvalidatesCacheNoContent, error) {
+func (a *Client) InvalidatesCache(ctx context.Context, params *InvalidatesCacheParams, opts ...ClientOption) (*InvalidatesCacheNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewInvalidatesCacheParams()
@@ -147,6 +150,9 @@ func (a *Client) InvalidatesCache(ctx context.Context, params *InvalidatesCacheP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -166,7 +172,7 @@ func (a *Client) InvalidatesCache(ctx context.Context, params *InvalidatesCacheP
 /*
   InvalidatesCacheByAccount invalidates caches per account level
 */
-func (a *Client) InvalidatesCacheByAccount(ctx context.Context, params *InvalidatesCacheByAccountParams) (*InvalidatesCacheByAccountNoContent, error) {
+func (a *Client) InvalidatesCacheByAccount(ctx context.Context, params *InvalidatesCacheByAccountParams, opts ...ClientOption) (*InvalidatesCacheByAccountNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewInvalidatesCacheByAccountParams()
@@ -193,6 +199,9 @@ func (a *Client) InvalidatesCacheByAccount(ctx context.Context, params *Invalida
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -212,7 +221,7 @@ func (a *Client) InvalidatesCacheByAccount(ctx context.Context, params *Invalida
 /*
   InvalidatesCacheByTenant invalidates caches per tenant level
 */
-func (a *Client) InvalidatesCacheByTenant(ctx context.Context, params *InvalidatesCacheByTenantParams) (*InvalidatesCacheByTenantNoContent, error) {
+func (a *Client) InvalidatesCacheByTenant(ctx context.Context, params *InvalidatesCacheByTenantParams, opts ...ClientOption) (*InvalidatesCacheByTenantNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewInvalidatesCacheByTenantParams()
@@ -239,6 +248,9 @@ func (a *Client) InvalidatesCacheByTenant(ctx context.Context, params *Invalidat
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -258,7 +270,7 @@ func (a *Client) InvalidatesCacheByTenant(ctx context.Context, params *Invalidat
 /*
   PutInRotation puts the host back into rotation
 */
-func (a *Client) PutInRotation(ctx context.Context, params *PutInRotationParams) (*PutInRotationNoContent, error) {
+func (a *Client) PutInRotation(ctx context.Context, params *PutInRotationParams, opts ...ClientOption) (*PutInRotationNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewPutInRotationParams()
@@ -285,6 +297,9 @@ func (a *Client) PutInRotation(ctx context.Context, params *PutInRotationParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -304,7 +319,7 @@ func (a *Client) PutInRotation(ctx context.Context, params *PutInRotationParams)
 /*
   PutOutOfRotation puts the host out of rotation
 */
-func (a *Client) PutOutOfRotation(ctx context.Context, params *PutOutOfRotationParams) (*PutOutOfRotationNoContent, error) {
+func (a *Client) PutOutOfRotation(ctx context.Context, params *PutOutOfRotationParams, opts ...ClientOption) (*PutOutOfRotationNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewPutOutOfRotationParams()
@@ -331,6 +346,9 @@ func (a *Client) PutOutOfRotation(ctx context.Context, params *PutOutOfRotationP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -350,7 +368,7 @@ func (a *Client) PutOutOfRotation(ctx context.Context, params *PutOutOfRotationP
 /*
   TriggerInvoiceGenerationForParkedAccounts triggers an invoice generation for all parked accounts
 */
-func (a *Client) TriggerInvoiceGenerationForParkedAccounts(ctx context.Context, params *TriggerInvoiceGenerationForParkedAccountsParams) (*TriggerInvoiceGenerationForParkedAccountsOK, error) {
+func (a *Client) TriggerInvoiceGenerationForParkedAccounts(ctx context.Context, params *TriggerInvoiceGenerationForParkedAccountsParams, opts ...ClientOption) (*TriggerInvoiceGenerationForParkedAccountsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewTriggerInvoiceGenerationForParkedAccountsParams()
@@ -389,6 +407,9 @@ func (a *Client) TriggerInvoiceGenerationForParkedAccounts(ctx context.Context,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -408,7 +429,7 @@ func (a *Client) TriggerInvoiceGenerationForParkedAccounts(ctx context.Context,
 /*
   UpdatePaymentTransactionState updates existing payment transaction and associated payment state
 */
-func (a *Client) UpdatePaymentTransactionState(ctx context.Context, params *UpdatePaymentTransactionStateParams) (*UpdatePaymentTransactionStateNoContent, error) {
+func (a *Client) UpdatePaymentTransactionState(ctx context.Context, params *UpdatePaymentTransactionStateParams, opts ...ClientOption) (*UpdatePaymentTransactionStateNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdatePaymentTransactionStateParams()
@@ -447,6 +468,9 @@ func (a *Client) UpdatePaymentTransactionState(ctx context.Context, params *Upda
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
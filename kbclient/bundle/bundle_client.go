@@ -53,39 +53,39 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	AddBundleBlockingState(ctx context.Context, params *AddBundleBlockingStateParams) (*AddBundleBlockingStateCreated, error)
+	AddBundleBlockingState(ctx context.Context, params *AddBundleBlockingStateParams, opts ...ClientOption) (*AddBundleBlockingStateCreated, error)
 
-	CreateBundleCustomFields(ctx context.Context, params *CreateBundleCustomFieldsParams) (*CreateBundleCustomFieldsCreated, error)
+	CreateBundleCustomFields(ctx context.Context, params *CreateBundleCustomFieldsParams, opts ...ClientOption) (*CreateBundleCustomFieldsCreated, error)
 
-	CreateBundleTags(ctx context.Context, params *CreateBundleTagsParams) (*CreateBundleTagsCreated, error)
+	CreateBundleTags(ctx context.Context, params *CreateBundleTagsParams, opts ...ClientOption) (*CreateBundleTagsCreated, error)
 
-	DeleteBundleCustomFields(ctx context.Context, params *DeleteBundleCustomFieldsParams) (*DeleteBundleCustomFieldsNoContent, error)
+	DeleteBundleCustomFields(ctx context.Context, params *DeleteBundleCustomFieldsParams, opts ...ClientOption) (*DeleteBundleCustomFieldsNoContent, error)
 
-	DeleteBundleTags(ctx context.Context, params *DeleteBundleTagsParams) (*DeleteBundleTagsNoContent, error)
+	DeleteBundleTags(ctx context.Context, params *DeleteBundleTagsParams, opts ...ClientOption) (*DeleteBundleTagsNoContent, error)
 
-	GetBundle(ctx context.Context, params *GetBundleParams) (*GetBundleOK, error)
+	GetBundle(ctx context.Context, params *GetBundleParams, opts ...ClientOption) (*GetBundleOK, error)
 
-	GetBundleAuditLogsWithHistory(ctx context.Context, params *GetBundleAuditLogsWithHistoryParams) (*GetBundleAuditLogsWithHistoryOK, error)
+	GetBundleAuditLogsWithHistory(ctx context.Context, params *GetBundleAuditLogsWithHistoryParams, opts ...ClientOption) (*GetBundleAuditLogsWithHistoryOK, error)
 
-	GetBundleByKey(ctx context.Context, params *GetBundleByKeyParams) (*GetBundleByKeyOK, error)
+	GetBundleByKey(ctx context.Context, params *GetBundleByKeyParams, opts ...ClientOption) (*GetBundleByKeyOK, error)
 
-	GetBundleCustomFields(ctx context.Context, params *GetBundleCustomFieldsParams) (*GetBundleCustomFieldsOK, error)
+	GetBundleCustomFields(ctx context.Context, params *GetBundleCustomFieldsParams, opts ...ClientOption) (*GetBundleCustomFieldsOK, error)
 
-	GetBundleTags(ctx context.Context, params *GetBundleTagsParams) (*GetBundleTagsOK, error)
+	GetBundleTags(ctx context.Context, params *GetBundleTagsParams, opts ...ClientOption) (*GetBundleTagsOK, error)
 
-	GetBundles(ctx context.Context, params *GetBundlesParams) (*GetBundlesOK, error)
+	GetBundles(ctx context.Context, params *GetBundlesParams, opts ...ClientOption) (*GetBundlesOK, error)
 
-	ModifyBundleCustomFields(ctx context.Context, params *ModifyBundleCustomFieldsParams) (*ModifyBundleCustomFieldsNoContent, error)
+	ModifyBundleCustomFields(ctx context.Context, params *ModifyBundleCustomFieldsParams, opts ...ClientOption) (*ModifyBundleCustomFieldsNoContent, error)
 
-	PauseBundle(ctx context.Context, params *PauseBundleParams) (*PauseBundleNoContent, error)
+	PauseBundle(ctx context.Context, params *PauseBundleParams, opts ...ClientOption) (*PauseBundleNoContent, error)
 
-	RenameExternalKey(ctx context.Context, params *RenameExternalKeyParams) (*RenameExternalKeyNoContent, error)
+	RenameExternalKey(ctx context.Context, params *RenameExternalKeyParams, opts ...ClientOption) (*RenameExternalKeyNoContent, error)
 
-	ResumeBundle(ctx context.Context, params *ResumeBundleParams) (*ResumeBundleNoContent, error)
+	ResumeBundle(ctx context.Context, params *ResumeBundleParams, opts ...ClientOption) (*ResumeBundleNoContent, error)
 
-	SearchBundles(ctx context.Context, params *SearchBundlesParams) (*SearchBundlesOK, error)
+	SearchBundles(ctx context.Context, params *SearchBundlesParams, opts ...ClientOption) (*SearchBundlesOK, error)
 
-	TransferBundle(ctx context.Context, params *TransferBundleParams) (*TransferBundleCreated, error)
+	TransferBundle(ctx context.Context, params *TransferBundleParams, opts ...ClientOption) (*TransferBundleCreated, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -93,7 +93,7 @@ type ClientService interface {
 /*
   AddBundleBlockingState blocks a bundle
 */
-func (a *Client) AddBundleBlockingState(ctx context.Context, params *AddBundleBlockingStateParams) (*AddBundleBlockingStateCreated, error) {
+func (a *Client) AddBundleBlockingState(ctx context.Context, params *AddBundleBlockingStateParams, opts ...ClientOption) (*AddBundleBlockingStateCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAddBundleBlockingStateParams()
@@ -135,6 +135,9 @@ func (a *Client) AddBundleBlockingState(ctx context.Context, params *AddBundleBl
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -146,7 +149,7 @@ func (a *Client) AddBundleBlockingState(ctx context.Context, params *AddBundleBl
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "addBundleBlockingState",
 		Method:             "GET",
 		PathPattern:        location,
@@ -158,7 +161,12 @@ func (a *Client) AddBundleBlockingState(ctx context.Context, params *AddBundleBl
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +177,7 @@ func (a *Client) AddBundleBlockingState(ctx context.Context, params *AddBundleBl
 /*
   CreateBundleCustomFields adds custom fields to bundle
 */
-func (a *Client) CreateBundleCustomFields(ctx context.Context, params *CreateBundleCustomFieldsParams) (*CreateBundleCustomFieldsCreated, error) {
+func (a *Client) CreateBundleCustomFields(ctx context.Context, params *CreateBundleCustomFieldsParams, opts ...ClientOption) (*CreateBundleCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateBundleCustomFieldsParams()
@@ -211,6 +219,9 @@ func (a *Client) CreateBundleCustomFields(ctx context.Context, params *CreateBun
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -222,7 +233,7 @@ func (a *Client) CreateBundleCustomFields(ctx context.Context, params *CreateBun
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createBundleCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -234,7 +245,12 @@ func (a *Client) CreateBundleCustomFields(ctx context.Context, params *CreateBun
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +261,7 @@ func (a *Client) CreateBundleCustomFields(ctx context.Context, params *CreateBun
 /*
   CreateBundleTags adds tags to bundle
 */
-func (a *Client) CreateBundleTags(ctx context.Context, params *CreateBundleTagsParams) (*CreateBundleTagsCreated, error) {
+func (a *Client) CreateBundleTags(ctx context.Context, params *CreateBundleTagsParams, opts ...ClientOption) (*CreateBundleTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateBundleTagsParams()
@@ -287,6 +303,9 @@ func (a *Client) CreateBundleTags(ctx context.Context, params *CreateBundleTagsP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -298,7 +317,7 @@ func (a *Client) CreateBundleTags(ctx context.Context, params *CreateBundleTagsP
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createBundleTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -310,7 +329,12 @@ func (a *Client) CreateBundleTags(ctx context.Context, params *CreateBundleTagsP
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -321,7 +345,7 @@ func (a *Client) CreateBundleTags(ctx context.Context, params *CreateBundleTagsP
 /*
   DeleteBundleCustomFields removes custom fields from bundle
 */
-func (a *Client) DeleteBundleCustomFields(ctx context.Context, params *DeleteBundleCustomFieldsParams) (*DeleteBundleCustomFieldsNoContent, error) {
+func (a *Client) DeleteBundleCustomFields(ctx context.Context, params *DeleteBundleCustomFieldsParams, opts ...ClientOption) (*DeleteBundleCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteBundleCustomFieldsParams()
@@ -360,6 +384,9 @@ func (a *Client) DeleteBundleCustomFields(ctx context.Context, params *DeleteBun
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -379,7 +406,7 @@ func (a *Client) DeleteBundleCustomFields(ctx context.Context, params *DeleteBun
 /*
   DeleteBundleTags removes tags from bundle
 */
-func (a *Client) DeleteBundleTags(ctx context.Context, params *DeleteBundleTagsParams) (*DeleteBundleTagsNoContent, error) {
+func (a *Client) DeleteBundleTags(ctx context.Context, params *DeleteBundleTagsParams, opts ...ClientOption) (*DeleteBundleTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteBundleTagsParams()
@@ -418,6 +445,9 @@ func (a *Client) DeleteBundleTags(ctx context.Context, params *DeleteBundleTagsP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -437,7 +467,7 @@ func (a *Client) DeleteBundleTags(ctx context.Context, params *DeleteBundleTagsP
 /*
   GetBundle retrieves a bundle by id
 */
-func (a *Client) GetBundle(ctx context.Context, params *GetBundleParams) (*GetBundleOK, error) {
+func (a *Client) GetBundle(ctx context.Context, params *GetBundleParams, opts ...ClientOption) (*GetBundleOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBundleParams()
@@ -464,6 +494,9 @@ func (a *Client) GetBundle(ctx context.Context, params *GetBundleParams) (*GetBu
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -483,7 +516,7 @@ func (a *Client) GetBundle(ctx context.Context, params *GetBundleParams) (*GetBu
 /*
   GetBundleAuditLogsWithHistory retrieves bundle audit logs with history by id
 */
-func (a *Client) GetBundleAuditLogsWithHistory(ctx context.Context, params *GetBundleAuditLogsWithHistoryParams) (*GetBundleAuditLogsWithHistoryOK, error) {
+func (a *Client) GetBundleAuditLogsWithHistory(ctx context.Context, params *GetBundleAuditLogsWithHistoryParams, opts ...ClientOption) (*GetBundleAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBundleAuditLogsWithHistoryParams()
@@ -510,6 +543,9 @@ func (a *Client) GetBundleAuditLogsWithHistory(ctx context.Context, params *GetB
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -529,7 +565,7 @@ func (a *Client) GetBundleAuditLogsWithHistory(ctx context.Context, params *GetB
 /*
   GetBundleByKey retrieves a bundle by external key
 */
-func (a *Client) GetBundleByKey(ctx context.Context, params *GetBundleByKeyParams) (*GetBundleByKeyOK, error) {
+func (a *Client) GetBundleByKey(ctx context.Context, params *GetBundleByKeyParams, opts ...ClientOption) (*GetBundleByKeyOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBundleByKeyParams()
@@ -556,6 +592,9 @@ func (a *Client) GetBundleByKey(ctx context.Context, params *GetBundleByKeyParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -575,7 +614,7 @@ func (a *Client) GetBundleByKey(ctx context.Context, params *GetBundleByKeyParam
 /*
   GetBundleCustomFields retrieves bundle custom fields
 */
-func (a *Client) GetBundleCustomFields(ctx context.Context, params *GetBundleCustomFieldsParams) (*GetBundleCustomFieldsOK, error) {
+func (a *Client) GetBundleCustomFields(ctx context.Context, params *GetBundleCustomFieldsParams, opts ...ClientOption) (*GetBundleCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBundleCustomFieldsParams()
@@ -602,6 +641,9 @@ func (a *Client) GetBundleCustomFields(ctx context.Context, params *GetBundleCus
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -621,7 +663,7 @@ func (a *Client) GetBundleCustomFields(ctx context.Context, params *GetBundleCus
 /*
   GetBundleTags retrieves bundle tags
 */
-func (a *Client) GetBundleTags(ctx context.Context, params *GetBundleTagsParams) (*GetBundleTagsOK, error) {
+func (a *Client) GetBundleTags(ctx context.Context, params *GetBundleTagsParams, opts ...ClientOption) (*GetBundleTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBundleTagsParams()
@@ -648,6 +690,9 @@ func (a *Client) GetBundleTags(ctx context.Context, params *GetBundleTagsParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -667,7 +712,7 @@ func (a *Client) GetBundleTags(ctx context.Context, params *GetBundleTagsParams)
 /*
   GetBundles lists bundles
 */
-func (a *Client) GetBundles(ctx context.Context, params *GetBundlesParams) (*GetBundlesOK, error) {
+func (a *Client) GetBundles(ctx context.Context, params *GetBundlesParams, opts ...ClientOption) (*GetBundlesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetBundlesParams()
@@ -694,6 +739,9 @@ func (a *Client) GetBundles(ctx context.Context, params *GetBundlesParams) (*Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -713,7 +761,7 @@ func (a *Client) GetBundles(ctx context.Context, params *GetBundlesParams) (*Get
 /*
   ModifyBundleCustomFields modifies custom fields to bundle
 */
-func (a *Client) ModifyBundleCustomFields(ctx context.Context, params *ModifyBundleCustomFieldsParams) (*ModifyBundleCustomFieldsNoContent, error) {
+func (a *Client) ModifyBundleCustomFields(ctx context.Context, params *ModifyBundleCustomFieldsParams, opts ...ClientOption) (*ModifyBundleCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyBundleCustomFieldsParams()
@@ -752,6 +800,9 @@ func (a *Client) ModifyBundleCustomFields(ctx context.Context, params *ModifyBun
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -771,7 +822,7 @@ func (a *Client) ModifyBundleCustomFields(ctx context.Context, params *ModifyBun
 /*
   PauseBundle pauses a bundle
 */
-func (a *Client) PauseBundle(ctx context.Context, params *PauseBundleParams) (*PauseBundleNoContent, error) {
+func (a *Client) PauseBundle(ctx context.Context, params *PauseBundleParams, opts ...ClientOption) (*PauseBundleNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewPauseBundleParams()
@@ -810,6 +861,9 @@ func (a *Client) PauseBundle(ctx context.Context, params *PauseBundleParams) (*P
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -829,7 +883,7 @@ func (a *Client) PauseBundle(ctx context.Context, params *PauseBundleParams) (*P
 /*
   RenameExternalKey updates a bundle external key
 */
-func (a *Client) RenameExternalKey(ctx context.Context, params *RenameExternalKeyParams) (*RenameExternalKeyNoContent, error) {
+func (a *Client) RenameExternalKey(ctx context.Context, params *RenameExternalKeyParams, opts ...ClientOption) (*RenameExternalKeyNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRenameExternalKeyParams()
@@ -868,6 +922,9 @@ func (a *Client) RenameExternalKey(ctx context.Context, params *RenameExternalKe
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -887,7 +944,7 @@ func (a *Client) RenameExternalKey(ctx context.Context, params *RenameExternalKe
 /*
   ResumeBundle resumes a bundle
 */
-func (a *Client) ResumeBundle(ctx context.Context, params *ResumeBundleParams) (*ResumeBundleNoContent, error) {
+func (a *Client) ResumeBundle(ctx context.Context, params *ResumeBundleParams, opts ...ClientOption) (*ResumeBundleNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewResumeBundleParams()
@@ -926,6 +983,9 @@ func (a *Client) ResumeBundle(ctx context.Context, params *ResumeBundleParams) (
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -945,7 +1005,7 @@ func (a *Client) ResumeBundle(ctx context.Context, params *ResumeBundleParams) (
 /*
   SearchBundles searches bundles
 */
-func (a *Client) SearchBundles(ctx context.Context, params *SearchBundlesParams) (*SearchBundlesOK, error) {
+func (a *Client) SearchBundles(ctx context.Context, params *SearchBundlesParams, opts ...ClientOption) (*SearchBundlesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchBundlesParams()
@@ -972,6 +1032,9 @@ func (a *Client) SearchBundles(ctx context.Context, params *SearchBundlesParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -991,7 +1054,7 @@ func (a *Client) SearchBundles(ctx context.Context, params *SearchBundlesParams)
 /*
   TransferBundle transfers a bundle to another account
 */
-func (a *Client) TransferBundle(ctx context.Context, params *TransferBundleParams) (*TransferBundleCreated, error) {
+func (a *Client) TransferBundle(ctx context.Context, params *TransferBundleParams, opts ...ClientOption) (*TransferBundleCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewTransferBundleParams()
@@ -1033,6 +1096,9 @@ func (a *Client) TransferBundle(ctx context.Context, params *TransferBundleParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1044,7 +1110,7 @@ func (a *Client) TransferBundle(ctx context.Context, params *TransferBundleParam
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "transferBundle",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1056,7 +1122,12 @@ func (a *Client) TransferBundle(ctx context.Context, params *TransferBundleParam
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -53,31 +53,31 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	AddSimplePlan(ctx context.Context, params *AddSimplePlanParams) (*AddSimplePlanCreated, error)
+	AddSimplePlan(ctx context.Context, params *AddSimplePlanParams, opts ...ClientOption) (*AddSimplePlanCreated, error)
 
-	DeleteCatalog(ctx context.Context, params *DeleteCatalogParams) (*DeleteCatalogNoContent, error)
+	DeleteCatalog(ctx context.Context, params *DeleteCatalogParams, opts ...ClientOption) (*DeleteCatalogNoContent, error)
 
-	GetAvailableAddons(ctx context.Context, params *GetAvailableAddonsParams) (*GetAvailableAddonsOK, error)
+	GetAvailableAddons(ctx context.Context, params *GetAvailableAddonsParams, opts ...ClientOption) (*GetAvailableAddonsOK, error)
 
-	GetAvailableBasePlans(ctx context.Context, params *GetAvailableBasePlansParams) (*GetAvailableBasePlansOK, error)
+	GetAvailableBasePlans(ctx context.Context, params *GetAvailableBasePlansParams, opts ...ClientOption) (*GetAvailableBasePlansOK, error)
 
-	GetCatalogJSON(ctx context.Context, params *GetCatalogJSONParams) (*GetCatalogJSONOK, error)
+	GetCatalogJSON(ctx context.Context, params *GetCatalogJSONParams, opts ...ClientOption) (*GetCatalogJSONOK, error)
 
-	GetCatalogVersions(ctx context.Context, params *GetCatalogVersionsParams) (*GetCatalogVersionsOK, error)
+	GetCatalogVersions(ctx context.Context, params *GetCatalogVersionsParams, opts ...ClientOption) (*GetCatalogVersionsOK, error)
 
-	GetCatalogXML(ctx context.Context, params *GetCatalogXMLParams) (*GetCatalogXMLOK, error)
+	GetCatalogXML(ctx context.Context, params *GetCatalogXMLParams, opts ...ClientOption) (*GetCatalogXMLOK, error)
 
-	GetPhaseForSubscriptionAndDate(ctx context.Context, params *GetPhaseForSubscriptionAndDateParams) (*GetPhaseForSubscriptionAndDateOK, error)
+	GetPhaseForSubscriptionAndDate(ctx context.Context, params *GetPhaseForSubscriptionAndDateParams, opts ...ClientOption) (*GetPhaseForSubscriptionAndDateOK, error)
 
-	GetPlanForSubscriptionAndDate(ctx context.Context, params *GetPlanForSubscriptionAndDateParams) (*GetPlanForSubscriptionAndDateOK, error)
+	GetPlanForSubscriptionAndDate(ctx context.Context, params *GetPlanForSubscriptionAndDateParams, opts ...ClientOption) (*GetPlanForSubscriptionAndDateOK, error)
 
-	GetPriceListForSubscriptionAndDate(ctx context.Context, params *GetPriceListForSubscriptionAndDateParams) (*GetPriceListForSubscriptionAndDateOK, error)
+	GetPriceListForSubscriptionAndDate(ctx context.Context, params *GetPriceListForSubscriptionAndDateParams, opts ...ClientOption) (*GetPriceListForSubscriptionAndDateOK, error)
 
-	GetProductForSubscriptionAndDate(ctx context.Context, params *GetProductForSubscriptionAndDateParams) (*GetProductForSubscriptionAndDateOK, error)
+	GetProductForSubscriptionAndDate(ctx context.Context, params *GetProductForSubscriptionAndDateParams, opts ...ClientOption) (*GetProductForSubscriptionAndDateOK, error)
 
-	UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLParams) (*UploadCatalogXMLCreated, error)
+	UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLParams, opts ...ClientOption) (*UploadCatalogXMLCreated, error)
 
-	ValidateCatalogXML(ctx context.Context, params *ValidateCatalogXMLParams) (*ValidateCatalogXMLOK, error)
+	ValidateCatalogXML(ctx context.Context, params *ValidateCatalogXMLParams, opts ...ClientOption) (*ValidateCatalogXMLOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -85,7 +85,7 @@ type ClientService interface {
 /*
   AddSimplePlan adds a simple plan entry in the current version of the catalog
 */
-func (a *Client) AddSimplePlan(ctx context.Context, params *AddSimplePlanParams) (*AddSimplePlanCreated, error) {
+func (a *Client) AddSimplePlan(ctx context.Context, params *AddSimplePlanParams, opts ...ClientOption) (*AddSimplePlanCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAddSimplePlanParams()
@@ -127,6 +127,9 @@ func (a *Client) AddSimplePlan(ctx context.Context, params *AddSimplePlanParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -138,7 +141,7 @@ func (a *Client) AddSimplePlan(ctx context.Context, params *AddSimplePlanParams)
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "addSimplePlan",
 		Method:             "GET",
 		PathPattern:        location,
@@ -150,7 +153,12 @@ func (a *Client) AddSimplePlan(ctx context.Context, params *AddSimplePlanParams)
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +169,7 @@ func (a *Client) AddSimplePlan(ctx context.Context, params *AddSimplePlanParams)
 /*
   DeleteCatalog deletes all versions for a per tenant catalog
 */
-func (a *Client) DeleteCatalog(ctx context.Context, params *DeleteCatalogParams) (*DeleteCatalogNoContent, error) {
+func (a *Client) DeleteCatalog(ctx context.Context, params *DeleteCatalogParams, opts ...ClientOption) (*DeleteCatalogNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteCatalogParams()
@@ -200,6 +208,9 @@ func (a *Client) DeleteCatalog(ctx context.Context, params *DeleteCatalogParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -219,7 +230,7 @@ func (a *Client) DeleteCatalog(ctx context.Context, params *DeleteCatalogParams)
 /*
   GetAvailableAddons retrieves available add ons for a given product
 */
-func (a *Client) GetAvailableAddons(ctx context.Context, params *GetAvailableAddonsParams) (*GetAvailableAddonsOK, error) {
+func (a *Client) GetAvailableAddons(ctx context.Context, params *GetAvailableAddonsParams, opts ...ClientOption) (*GetAvailableAddonsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAvailableAddonsParams()
@@ -246,6 +257,9 @@ func (a *Client) GetAvailableAddons(ctx context.Context, params *GetAvailableAdd
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -265,7 +279,7 @@ func (a *Client) GetAvailableAddons(ctx context.Context, params *GetAvailableAdd
 /*
   GetAvailableBasePlans retrieves available base plans
 */
-func (a *Client) GetAvailableBasePlans(ctx context.Context, params *GetAvailableBasePlansParams) (*GetAvailableBasePlansOK, error) {
+func (a *Client) GetAvailableBasePlans(ctx context.Context, params *GetAvailableBasePlansParams, opts ...ClientOption) (*GetAvailableBasePlansOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAvailableBasePlansParams()
@@ -292,6 +306,9 @@ func (a *Client) GetAvailableBasePlans(ctx context.Context, params *GetAvailable
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -311,7 +328,7 @@ func (a *Client) GetAvailableBasePlans(ctx context.Context, params *GetAvailable
 /*
   GetCatalogJSON retrieves the catalog as JSON
 */
-func (a *Client) GetCatalogJSON(ctx context.Context, params *GetCatalogJSONParams) (*GetCatalogJSONOK, error) {
+func (a *Client) GetCatalogJSON(ctx context.Context, params *GetCatalogJSONParams, opts ...ClientOption) (*GetCatalogJSONOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCatalogJSONParams()
@@ -338,6 +355,9 @@ func (a *Client) GetCatalogJSON(ctx context.Context, params *GetCatalogJSONParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -357,7 +377,7 @@ func (a *Client) GetCatalogJSON(ctx context.Context, params *GetCatalogJSONParam
 /*
   GetCatalogVersions retrieves a list of catalog versions
 */
-func (a *Client) GetCatalogVersions(ctx context.Context, params *GetCatalogVersionsParams) (*GetCatalogVersionsOK, error) {
+func (a *Client) GetCatalogVersions(ctx context.Context, params *GetCatalogVersionsParams, opts ...ClientOption) (*GetCatalogVersionsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCatalogVersionsParams()
@@ -384,6 +404,9 @@ func (a *Client) GetCatalogVersions(ctx context.Context, params *GetCatalogVersi
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -403,7 +426,7 @@ func (a *Client) GetCatalogVersions(ctx context.Context, params *GetCatalogVersi
 /*
   GetCatalogXML retrieves the full catalog as XML
 */
-func (a *Client) GetCatalogXML(ctx context.Context, params *GetCatalogXMLParams) (*GetCatalogXMLOK, error) {
+func (a *Client) GetCatalogXML(ctx context.Context, params *GetCatalogXMLParams, opts ...ClientOption) (*GetCatalogXMLOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCatalogXMLParams()
@@ -430,6 +453,9 @@ func (a *Client) GetCatalogXML(ctx context.Context, params *GetCatalogXMLParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -449,7 +475,7 @@ func (a *Client) GetCatalogXML(ctx context.Context, params *GetCatalogXMLParams)
 /*
   GetPhaseForSubscriptionAndDate retrieves phase for a given subscription and date
 */
-func (a *Client) GetPhaseForSubscriptionAndDate(ctx context.Context, params *GetPhaseForSubscriptionAndDateParams) (*GetPhaseForSubscriptionAndDateOK, error) {
+func (a *Client) GetPhaseForSubscriptionAndDate(ctx context.Context, params *GetPhaseForSubscriptionAndDateParams, opts ...ClientOption) (*GetPhaseForSubscriptionAndDateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPhaseForSubscriptionAndDateParams()
@@ -476,6 +502,9 @@ func (a *Client) GetPhaseForSubscriptionAndDate(ctx context.Context, params *Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -495,7 +524,7 @@ func (a *Client) GetPhaseForSubscriptionAndDate(ctx context.Context, params *Get
 /*
   GetPlanForSubscriptionAndDate retrieves plan for a given subscription and date
 */
-func (a *Client) GetPlanForSubscriptionAndDate(ctx context.Context, params *GetPlanForSubscriptionAndDateParams) (*GetPlanForSubscriptionAndDateOK, error) {
+func (a *Client) GetPlanForSubscriptionAndDate(ctx context.Context, params *GetPlanForSubscriptionAndDateParams, opts ...ClientOption) (*GetPlanForSubscriptionAndDateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPlanForSubscriptionAndDateParams()
@@ -522,6 +551,9 @@ func (a *Client) GetPlanForSubscriptionAndDate(ctx context.Context, params *GetP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -541,7 +573,7 @@ func (a *Client) GetPlanForSubscriptionAndDate(ctx context.Context, params *GetP
 /*
   GetPriceListForSubscriptionAndDate retrieves price list for a given subscription and date
 */
-func (a *Client) GetPriceListForSubscriptionAndDate(ctx context.Context, params *GetPriceListForSubscriptionAndDateParams) (*GetPriceListForSubscriptionAndDateOK, error) {
+func (a *Client) GetPriceListForSubscriptionAndDate(ctx context.Context, params *GetPriceListForSubscriptionAndDateParams, opts ...ClientOption) (*GetPriceListForSubscriptionAndDateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPriceListForSubscriptionAndDateParams()
@@ -568,6 +600,9 @@ func (a *Client) GetPriceListForSubscriptionAndDate(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -587,7 +622,7 @@ func (a *Client) GetPriceListForSubscriptionAndDate(ctx context.Context, params
 /*
   GetProductForSubscriptionAndDate retrieves product for a given subscription and date
 */
-func (a *Client) GetProductForSubscriptionAndDate(ctx context.Context, params *GetProductForSubscriptionAndDateParams) (*GetProductForSubscriptionAndDateOK, error) {
+func (a *Client) GetProductForSubscriptionAndDate(ctx context.Context, params *GetProductForSubscriptionAndDateParams, opts ...ClientOption) (*GetProductForSubscriptionAndDateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetProductForSubscriptionAndDateParams()
@@ -614,6 +649,9 @@ func (a *Client) GetProductForSubscriptionAndDate(ctx context.Context, params *G
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -633,7 +671,7 @@ func (a *Client) GetProductForSubscriptionAndDate(ctx context.Context, params *G
 /*
   UploadCatalogXML uploads the full catalog as XML
 */
-func (a *Client) UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLParams) (*UploadCatalogXMLCreated, error) {
+func (a *Client) UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLParams, opts ...ClientOption) (*UploadCatalogXMLCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadCatalogXMLParams()
@@ -675,6 +713,9 @@ func (a *Client) UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -686,7 +727,7 @@ func (a *Client) UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLP
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadCatalogXml",
 		Method:             "GET",
 		PathPattern:        location,
@@ -698,7 +739,12 @@ func (a *Client) UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLP
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -709,7 +755,7 @@ func (a *Client) UploadCatalogXML(ctx context.Context, params *UploadCatalogXMLP
 /*
   ValidateCatalogXML validates a XML catalog
 */
-func (a *Client) ValidateCatalogXML(ctx context.Context, params *ValidateCatalogXMLParams) (*ValidateCatalogXMLOK, error) {
+func (a *Client) ValidateCatalogXML(ctx context.Context, params *ValidateCatalogXMLParams, opts ...ClientOption) (*ValidateCatalogXMLOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewValidateCatalogXMLParams()
@@ -748,6 +794,9 @@ func (a *Client) ValidateCatalogXML(ctx context.Context, params *ValidateCatalog
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
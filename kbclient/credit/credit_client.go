@@ -53,9 +53,9 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateCredits(ctx context.Context, params *CreateCreditsParams) (*CreateCreditsCreated, error)
+	CreateCredits(ctx context.Context, params *CreateCreditsParams, opts ...ClientOption) (*CreateCreditsCreated, error)
 
-	GetCredit(ctx context.Context, params *GetCreditParams) (*GetCreditOK, error)
+	GetCredit(ctx context.Context, params *GetCreditParams, opts ...ClientOption) (*GetCreditOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -63,7 +63,7 @@ type ClientService interface {
 /*
   CreateCredits creates a credit
 */
-func (a *Client) CreateCredits(ctx context.Context, params *CreateCreditsParams) (*CreateCreditsCreated, error) {
+func (a *Client) CreateCredits(ctx context.Context, params *CreateCreditsParams, opts ...ClientOption) (*CreateCreditsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateCreditsParams()
@@ -105,6 +105,9 @@ func (a *Client) CreateCredits(ctx context.Context, params *CreateCreditsParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -116,7 +119,7 @@ func (a *Client) CreateCredits(ctx context.Context, params *CreateCreditsParams)
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createCredits",
 		Method:             "GET",
 		PathPattern:        location,
@@ -128,7 +131,12 @@ func (a *Client) CreateCredits(ctx context.Context, params *CreateCreditsParams)
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +147,7 @@ func (a *Client) CreateCredits(ctx context.Context, params *CreateCreditsParams)
 /*
   GetCredit retrieves a credit by id
 */
-func (a *Client) GetCredit(ctx context.Context, params *GetCreditParams) (*GetCreditOK, error) {
+func (a *Client) GetCredit(ctx context.Context, params *GetCreditParams, opts ...ClientOption) (*GetCreditOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCreditParams()
@@ -166,6 +174,9 @@ func (a *Client) GetCredit(ctx context.Context, params *GetCreditParams) (*GetCr
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -52,13 +52,13 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetCustomFieldAuditLogsWithHistory(ctx context.Context, params *GetCustomFieldAuditLogsWithHistoryParams) (*GetCustomFieldAuditLogsWithHistoryOK, error)
+	GetCustomFieldAuditLogsWithHistory(ctx context.Context, params *GetCustomFieldAuditLogsWithHistoryParams, opts ...ClientOption) (*GetCustomFieldAuditLogsWithHistoryOK, error)
 
-	GetCustomFields(ctx context.Context, params *GetCustomFieldsParams) (*GetCustomFieldsOK, error)
+	GetCustomFields(ctx context.Context, params *GetCustomFieldsParams, opts ...ClientOption) (*GetCustomFieldsOK, error)
 
-	SearchCustomFields(ctx context.Context, params *SearchCustomFieldsParams) (*SearchCustomFieldsOK, error)
+	SearchCustomFields(ctx context.Context, params *SearchCustomFieldsParams, opts ...ClientOption) (*SearchCustomFieldsOK, error)
 
-	SearchCustomFieldsByTypeName(ctx context.Context, params *SearchCustomFieldsByTypeNameParams) (*SearchCustomFieldsByTypeNameOK, error)
+	SearchCustomFieldsByTypeName(ctx context.Context, params *SearchCustomFieldsByTypeNameParams, opts ...ClientOption) (*SearchCustomFieldsByTypeNameOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -66,7 +66,7 @@ type ClientService interface {
 /*
   GetCustomFieldAuditLogsWithHistory retrieves custom field audit logs with history by id
 */
-func (a *Client) GetCustomFieldAuditLogsWithHistory(ctx context.Context, params *GetCustomFieldAuditLogsWithHistoryParams) (*GetCustomFieldAuditLogsWithHistoryOK, error) {
+func (a *Client) GetCustomFieldAuditLogsWithHistory(ctx context.Context, params *GetCustomFieldAuditLogsWithHistoryParams, opts ...ClientOption) (*GetCustomFieldAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCustomFieldAuditLogsWithHistoryParams()
@@ -93,6 +93,9 @@ func (a *Client) GetCustomFieldAuditLogsWithHistory(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -112,7 +115,7 @@ func (a *Client) GetCustomFieldAuditLogsWithHistory(ctx context.Context, params
 /*
   GetCustomFields lists custom fields
 */
-func (a *Client) GetCustomFields(ctx context.Context, params *GetCustomFieldsParams) (*GetCustomFieldsOK, error) {
+func (a *Client) GetCustomFields(ctx context.Context, params *GetCustomFieldsParams, opts ...ClientOption) (*GetCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCustomFieldsParams()
@@ -139,6 +142,9 @@ func (a *Client) GetCustomFields(ctx context.Context, params *GetCustomFieldsPar
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -158,7 +164,7 @@ func (a *Client) GetCustomFields(ctx context.Context, params *GetCustomFieldsPar
 /*
   SearchCustomFields searches custom fields
 */
-func (a *Client) SearchCustomFields(ctx context.Context, params *SearchCustomFieldsParams) (*SearchCustomFieldsOK, error) {
+func (a *Client) SearchCustomFields(ctx context.Context, params *SearchCustomFieldsParams, opts ...ClientOption) (*SearchCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchCustomFieldsParams()
@@ -185,6 +191,9 @@ func (a *Client) SearchCustomFields(ctx context.Context, params *SearchCustomFie
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -204,7 +213,7 @@ func (a *Client) SearchCustomFields(ctx context.Context, params *SearchCustomFie
 /*
   SearchCustomFieldsByTypeName searches custom fields by type name and optional value
 */
-func (a *Client) SearchCustomFieldsByTypeName(ctx context.Context, params *SearchCustomFieldsByTypeNameParams) (*SearchCustomFieldsByTypeNameOK, error) {
+func (a *Client) SearchCustomFieldsByTypeName(ctx context.Context, params *SearchCustomFieldsByTypeNameParams, opts ...ClientOption) (*SearchCustomFieldsByTypeNameOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchCustomFieldsByTypeNameParams()
@@ -231,6 +240,9 @@ func (a *Client) SearchCustomFieldsByTypeName(ctx context.Context, params *Searc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -52,9 +52,9 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetClock(ctx context.Context, params *GetClockParams) (*GetClockOK, error)
+	GetClock(ctx context.Context, params *GetClockParams, opts ...ClientOption) (*GetClockOK, error)
 
-	SetClock(ctx context.Context, params *SetClockParams) (*SetClockOK, error)
+	SetClock(ctx context.Context, params *SetClockParams, opts ...ClientOption) (*SetClockOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -62,7 +62,7 @@ type ClientService interface {
 /*
   GetClock gets the test clock
 */
-func (a *Client) GetClock(ctx context.Context, params *GetClockParams) (*GetClockOK, error) {
+func (a *Client) GetClock(ctx context.Context, params *GetClockParams, opts ...ClientOption) (*GetClockOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetClockParams()
@@ -89,6 +89,9 @@ func (a *Client) GetClock(ctx context.Context, params *GetClockParams) (*GetCloc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -108,7 +111,7 @@ func (a *Client) GetClock(ctx context.Context, params *GetClockParams) (*GetCloc
 /*
   SetClock sets the test clock
 */
-func (a *Client) SetClock(ctx context.Context, params *SetClockParams) (*SetClockOK, error) {
+func (a *Client) SetClock(ctx context.Context, params *SetClockParams, opts ...ClientOption) (*SetClockOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSetClockParams()
@@ -135,6 +138,9 @@ func (a *Client) SetClock(ctx context.Context, params *SetClockParams) (*SetCloc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
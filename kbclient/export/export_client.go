@@ -52,7 +52,7 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	ExportDataForAccount(ctx context.Context, params *ExportDataForAccountParams) (*ExportDataForAccountOK, error)
+	ExportDataForAccount(ctx context.Context, params *ExportDataForAccountParams, opts ...ClientOption) (*ExportDataForAccountOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -60,7 +60,7 @@ type ClientService interface {
 /*
   ExportDataForAccount exports account data
 */
-func (a *Client) ExportDataForAccount(ctx context.Context, params *ExportDataForAccountParams) (*ExportDataForAccountOK, error) {
+func (a *Client) ExportDataForAccount(ctx context.Context, params *ExportDataForAccountParams, opts ...ClientOption) (*ExportDataForAccountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewExportDataForAccountParams()
@@ -99,6 +99,9 @@ func (a *Client) ExportDataForAccount(ctx context.Context, params *ExportDataFor
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
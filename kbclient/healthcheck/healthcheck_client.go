@@ -52,7 +52,7 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	Healthcheck(ctx context.Context, params *HealthcheckParams) (*HealthcheckOK, error)
+	Healthcheck(ctx context.Context, params *HealthcheckParams, opts ...ClientOption) (*HealthcheckOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -60,7 +60,7 @@ type ClientService interface {
 /*
   Healthcheck gets the healthcheck information
 */
-func (a *Client) Healthcheck(ctx context.Context, params *HealthcheckParams) (*HealthcheckOK, error) {
+func (a *Client) Healthcheck(ctx context.Context, params *HealthcheckParams, opts ...ClientOption) (*HealthcheckOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewHealthcheckParams()
@@ -87,6 +87,9 @@ func (a *Client) Healthcheck(ctx context.Context, params *HealthcheckParams) (*H
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
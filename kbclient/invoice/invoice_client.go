@@ -53,75 +53,75 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceItemParams) (*AdjustInvoiceItemCreated, error)
+	AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceItemParams, opts ...ClientOption) (*AdjustInvoiceItemCreated, error)
 
-	CommitInvoice(ctx context.Context, params *CommitInvoiceParams) (*CommitInvoiceNoContent, error)
+	CommitInvoice(ctx context.Context, params *CommitInvoiceParams, opts ...ClientOption) (*CommitInvoiceNoContent, error)
 
-	CreateExternalCharges(ctx context.Context, params *CreateExternalChargesParams) (*CreateExternalChargesCreated, error)
+	CreateExternalCharges(ctx context.Context, params *CreateExternalChargesParams, opts ...ClientOption) (*CreateExternalChargesCreated, error)
 
-	CreateFutureInvoice(ctx context.Context, params *CreateFutureInvoiceParams) (*CreateFutureInvoiceCreated, error)
+	CreateFutureInvoice(ctx context.Context, params *CreateFutureInvoiceParams, opts ...ClientOption) (*CreateFutureInvoiceCreated, error)
 
-	CreateFutureInvoiceGroup(ctx context.Context, params *CreateFutureInvoiceGroupParams) (*CreateFutureInvoiceGroupCreated, error)
+	CreateFutureInvoiceGroup(ctx context.Context, params *CreateFutureInvoiceGroupParams, opts ...ClientOption) (*CreateFutureInvoiceGroupCreated, error)
 
-	CreateInstantPayment(ctx context.Context, params *CreateInstantPaymentParams) (*CreateInstantPaymentCreated, *CreateInstantPaymentNoContent, error)
+	CreateInstantPayment(ctx context.Context, params *CreateInstantPaymentParams, opts ...ClientOption) (*CreateInstantPaymentCreated, *CreateInstantPaymentNoContent, error)
 
-	CreateInvoiceCustomFields(ctx context.Context, params *CreateInvoiceCustomFieldsParams) (*CreateInvoiceCustomFieldsCreated, error)
+	CreateInvoiceCustomFields(ctx context.Context, params *CreateInvoiceCustomFieldsParams, opts ...ClientOption) (*CreateInvoiceCustomFieldsCreated, error)
 
-	CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTagsParams) (*CreateInvoiceTagsCreated, error)
+	CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTagsParams, opts ...ClientOption) (*CreateInvoiceTagsCreated, error)
 
-	CreateMigrationInvoice(ctx context.Context, params *CreateMigrationInvoiceParams) (*CreateMigrationInvoiceCreated, error)
+	CreateMigrationInvoice(ctx context.Context, params *CreateMigrationInvoiceParams, opts ...ClientOption) (*CreateMigrationInvoiceCreated, error)
 
-	CreateTaxItems(ctx context.Context, params *CreateTaxItemsParams) (*CreateTaxItemsCreated, error)
+	CreateTaxItems(ctx context.Context, params *CreateTaxItemsParams, opts ...ClientOption) (*CreateTaxItemsCreated, error)
 
-	DeleteCBA(ctx context.Context, params *DeleteCBAParams) (*DeleteCBANoContent, error)
+	DeleteCBA(ctx context.Context, params *DeleteCBAParams, opts ...ClientOption) (*DeleteCBANoContent, error)
 
-	DeleteInvoiceCustomFields(ctx context.Context, params *DeleteInvoiceCustomFieldsParams) (*DeleteInvoiceCustomFieldsNoContent, error)
+	DeleteInvoiceCustomFields(ctx context.Context, params *DeleteInvoiceCustomFieldsParams, opts ...ClientOption) (*DeleteInvoiceCustomFieldsNoContent, error)
 
-	DeleteInvoiceTags(ctx context.Context, params *DeleteInvoiceTagsParams) (*DeleteInvoiceTagsNoContent, error)
+	DeleteInvoiceTags(ctx context.Context, params *DeleteInvoiceTagsParams, opts ...ClientOption) (*DeleteInvoiceTagsNoContent, error)
 
-	GenerateDryRunInvoice(ctx context.Context, params *GenerateDryRunInvoiceParams) (*GenerateDryRunInvoiceOK, *GenerateDryRunInvoiceNoContent, error)
+	GenerateDryRunInvoice(ctx context.Context, params *GenerateDryRunInvoiceParams, opts ...ClientOption) (*GenerateDryRunInvoiceOK, *GenerateDryRunInvoiceNoContent, error)
 
-	GetCatalogTranslation(ctx context.Context, params *GetCatalogTranslationParams) (*GetCatalogTranslationOK, error)
+	GetCatalogTranslation(ctx context.Context, params *GetCatalogTranslationParams, opts ...ClientOption) (*GetCatalogTranslationOK, error)
 
-	GetInvoice(ctx context.Context, params *GetInvoiceParams) (*GetInvoiceOK, error)
+	GetInvoice(ctx context.Context, params *GetInvoiceParams, opts ...ClientOption) (*GetInvoiceOK, error)
 
-	GetInvoiceAsHTML(ctx context.Context, params *GetInvoiceAsHTMLParams) (*GetInvoiceAsHTMLOK, error)
+	GetInvoiceAsHTML(ctx context.Context, params *GetInvoiceAsHTMLParams, opts ...ClientOption) (*GetInvoiceAsHTMLOK, error)
 
-	GetInvoiceAuditLogsWithHistory(ctx context.Context, params *GetInvoiceAuditLogsWithHistoryParams) (*GetInvoiceAuditLogsWithHistoryOK, error)
+	GetInvoiceAuditLogsWithHistory(ctx context.Context, params *GetInvoiceAuditLogsWithHistoryParams, opts ...ClientOption) (*GetInvoiceAuditLogsWithHistoryOK, error)
 
-	GetInvoiceByItemID(ctx context.Context, params *GetInvoiceByItemIDParams) (*GetInvoiceByItemIDOK, error)
+	GetInvoiceByItemID(ctx context.Context, params *GetInvoiceByItemIDParams, opts ...ClientOption) (*GetInvoiceByItemIDOK, error)
 
-	GetInvoiceByNumber(ctx context.Context, params *GetInvoiceByNumberParams) (*GetInvoiceByNumberOK, error)
+	GetInvoiceByNumber(ctx context.Context, params *GetInvoiceByNumberParams, opts ...ClientOption) (*GetInvoiceByNumberOK, error)
 
-	GetInvoiceCustomFields(ctx context.Context, params *GetInvoiceCustomFieldsParams) (*GetInvoiceCustomFieldsOK, error)
+	GetInvoiceCustomFields(ctx context.Context, params *GetInvoiceCustomFieldsParams, opts ...ClientOption) (*GetInvoiceCustomFieldsOK, error)
 
-	GetInvoiceMPTemplate(ctx context.Context, params *GetInvoiceMPTemplateParams) (*GetInvoiceMPTemplateOK, error)
+	GetInvoiceMPTemplate(ctx context.Context, params *GetInvoiceMPTemplateParams, opts ...ClientOption) (*GetInvoiceMPTemplateOK, error)
 
-	GetInvoiceTags(ctx context.Context, params *GetInvoiceTagsParams) (*GetInvoiceTagsOK, error)
+	GetInvoiceTags(ctx context.Context, params *GetInvoiceTagsParams, opts ...ClientOption) (*GetInvoiceTagsOK, error)
 
-	GetInvoiceTemplate(ctx context.Context, params *GetInvoiceTemplateParams) (*GetInvoiceTemplateOK, error)
+	GetInvoiceTemplate(ctx context.Context, params *GetInvoiceTemplateParams, opts ...ClientOption) (*GetInvoiceTemplateOK, error)
 
-	GetInvoiceTranslation(ctx context.Context, params *GetInvoiceTranslationParams) (*GetInvoiceTranslationOK, error)
+	GetInvoiceTranslation(ctx context.Context, params *GetInvoiceTranslationParams, opts ...ClientOption) (*GetInvoiceTranslationOK, error)
 
-	GetInvoices(ctx context.Context, params *GetInvoicesParams) (*GetInvoicesOK, error)
+	GetInvoices(ctx context.Context, params *GetInvoicesParams, opts ...ClientOption) (*GetInvoicesOK, error)
 
-	GetInvoicesGroup(ctx context.Context, params *GetInvoicesGroupParams) (*GetInvoicesGroupOK, error)
+	GetInvoicesGroup(ctx context.Context, params *GetInvoicesGroupParams, opts ...ClientOption) (*GetInvoicesGroupOK, error)
 
-	GetPaymentsForInvoice(ctx context.Context, params *GetPaymentsForInvoiceParams) (*GetPaymentsForInvoiceOK, error)
+	GetPaymentsForInvoice(ctx context.Context, params *GetPaymentsForInvoiceParams, opts ...ClientOption) (*GetPaymentsForInvoiceOK, error)
 
-	ModifyInvoiceCustomFields(ctx context.Context, params *ModifyInvoiceCustomFieldsParams) (*ModifyInvoiceCustomFieldsNoContent, error)
+	ModifyInvoiceCustomFields(ctx context.Context, params *ModifyInvoiceCustomFieldsParams, opts ...ClientOption) (*ModifyInvoiceCustomFieldsNoContent, error)
 
-	SearchInvoices(ctx context.Context, params *SearchInvoicesParams) (*SearchInvoicesOK, error)
+	SearchInvoices(ctx context.Context, params *SearchInvoicesParams, opts ...ClientOption) (*SearchInvoicesOK, error)
 
-	UploadCatalogTranslation(ctx context.Context, params *UploadCatalogTranslationParams) (*UploadCatalogTranslationCreated, error)
+	UploadCatalogTranslation(ctx context.Context, params *UploadCatalogTranslationParams, opts ...ClientOption) (*UploadCatalogTranslationCreated, error)
 
-	UploadInvoiceMPTemplate(ctx context.Context, params *UploadInvoiceMPTemplateParams) (*UploadInvoiceMPTemplateOK, error)
+	UploadInvoiceMPTemplate(ctx context.Context, params *UploadInvoiceMPTemplateParams, opts ...ClientOption) (*UploadInvoiceMPTemplateOK, error)
 
-	UploadInvoiceTemplate(ctx context.Context, params *UploadInvoiceTemplateParams) (*UploadInvoiceTemplateCreated, error)
+	UploadInvoiceTemplate(ctx context.Context, params *UploadInvoiceTemplateParams, opts ...ClientOption) (*UploadInvoiceTemplateCreated, error)
 
-	UploadInvoiceTranslation(ctx context.Context, params *UploadInvoiceTranslationParams) (*UploadInvoiceTranslationCreated, error)
+	UploadInvoiceTranslation(ctx context.Context, params *UploadInvoiceTranslationParams, opts ...ClientOption) (*UploadInvoiceTranslationCreated, error)
 
-	VoidInvoice(ctx context.Context, params *VoidInvoiceParams) (*VoidInvoiceNoContent, error)
+	VoidInvoice(ctx context.Context, params *VoidInvoiceParams, opts ...ClientOption) (*VoidInvoiceNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -129,7 +129,7 @@ type ClientService interface {
 /*
   AdjustInvoiceItem adjusts an invoice item
 */
-func (a *Client) AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceItemParams) (*AdjustInvoiceItemCreated, error) {
+func (a *Client) AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceItemParams, opts ...ClientOption) (*AdjustInvoiceItemCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAdjustInvoiceItemParams()
@@ -171,6 +171,9 @@ func (a *Client) AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceIte
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -182,7 +185,7 @@ func (a *Client) AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceIte
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "adjustInvoiceItem",
 		Method:             "GET",
 		PathPattern:        location,
@@ -194,7 +197,12 @@ func (a *Client) AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceIte
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +213,7 @@ func (a *Client) AdjustInvoiceItem(ctx context.Context, params *AdjustInvoiceIte
 /*
   CommitInvoice performs the invoice status transition from d r a f t to c o m m i t t e d
 */
-func (a *Client) CommitInvoice(ctx context.Context, params *CommitInvoiceParams) (*CommitInvoiceNoContent, error) {
+func (a *Client) CommitInvoice(ctx context.Context, params *CommitInvoiceParams, opts ...ClientOption) (*CommitInvoiceNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCommitInvoiceParams()
@@ -244,6 +252,9 @@ func (a *Client) CommitInvoice(ctx context.Context, params *CommitInvoiceParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -263,7 +274,7 @@ func (a *Client) CommitInvoice(ctx context.Context, params *CommitInvoiceParams)
 /*
   CreateExternalCharges creates external charge s
 */
-func (a *Client) CreateExternalCharges(ctx context.Context, params *CreateExternalChargesParams) (*CreateExternalChargesCreated, error) {
+func (a *Client) CreateExternalCharges(ctx context.Context, params *CreateExternalChargesParams, opts ...ClientOption) (*CreateExternalChargesCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateExternalChargesParams()
@@ -305,6 +316,9 @@ func (a *Client) CreateExternalCharges(ctx context.Context, params *CreateExtern
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -316,7 +330,7 @@ func (a *Client) CreateExternalCharges(ctx context.Context, params *CreateExtern
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createExternalCharges",
 		Method:             "GET",
 		PathPattern:        location,
@@ -328,7 +342,12 @@ func (a *Client) CreateExternalCharges(ctx context.Context, params *CreateExtern
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +358,7 @@ func (a *Client) CreateExternalCharges(ctx context.Context, params *CreateExtern
 /*
   CreateFutureInvoice triggers an invoice generation
 */
-func (a *Client) CreateFutureInvoice(ctx context.Context, params *CreateFutureInvoiceParams) (*CreateFutureInvoiceCreated, error) {
+func (a *Client) CreateFutureInvoice(ctx context.Context, params *CreateFutureInvoiceParams, opts ...ClientOption) (*CreateFutureInvoiceCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateFutureInvoiceParams()
@@ -381,6 +400,9 @@ func (a *Client) CreateFutureInvoice(ctx context.Context, params *CreateFutureIn
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -392,7 +414,7 @@ func (a *Client) CreateFutureInvoice(ctx context.Context, params *CreateFutureIn
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createFutureInvoice",
 		Method:             "GET",
 		PathPattern:        location,
@@ -404,7 +426,12 @@ func (a *Client) CreateFutureInvoice(ctx context.Context, params *CreateFutureIn
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -415,7 +442,7 @@ func (a *Client) CreateFutureInvoice(ctx context.Context, params *CreateFutureIn
 /*
   CreateFutureInvoiceGroup triggers an invoice generation
 */
-func (a *Client) CreateFutureInvoiceGroup(ctx context.Context, params *CreateFutureInvoiceGroupParams) (*CreateFutureInvoiceGroupCreated, error) {
+func (a *Client) CreateFutureInvoiceGroup(ctx context.Context, params *CreateFutureInvoiceGroupParams, opts ...ClientOption) (*CreateFutureInvoiceGroupCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateFutureInvoiceGroupParams()
@@ -457,6 +484,9 @@ func (a *Client) CreateFutureInvoiceGroup(ctx context.Context, params *CreateFut
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -468,7 +498,7 @@ func (a *Client) CreateFutureInvoiceGroup(ctx context.Context, params *CreateFut
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createFutureInvoiceGroup",
 		Method:             "GET",
 		PathPattern:        location,
@@ -480,7 +510,12 @@ func (a *Client) CreateFutureInvoiceGroup(ctx context.Context, params *CreateFut
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -491,7 +526,7 @@ func (a *Client) CreateFutureInvoiceGroup(ctx context.Context, params *CreateFut
 /*
   CreateInstantPayment triggers a payment for invoice
 */
-func (a *Client) CreateInstantPayment(ctx context.Context, params *CreateInstantPaymentParams) (*CreateInstantPaymentCreated, *CreateInstantPaymentNoContent, error) {
+func (a *Client) CreateInstantPayment(ctx context.Context, params *CreateInstantPaymentParams, opts ...ClientOption) (*CreateInstantPaymentCreated, *CreateInstantPaymentNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateInstantPaymentParams()
@@ -530,6 +565,9 @@ func (a *Client) CreateInstantPayment(ctx context.Context, params *CreateInstant
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -550,7 +588,7 @@ func (a *Client) CreateInstantPayment(ctx context.Context, params *CreateInstant
 /*
   CreateInvoiceCustomFields adds custom fields to invoice
 */
-func (a *Client) CreateInvoiceCustomFields(ctx context.Context, params *CreateInvoiceCustomFieldsParams) (*CreateInvoiceCustomFieldsCreated, error) {
+func (a *Client) CreateInvoiceCustomFields(ctx context.Context, params *CreateInvoiceCustomFieldsParams, opts ...ClientOption) (*CreateInvoiceCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateInvoiceCustomFieldsParams()
@@ -592,6 +630,9 @@ func (a *Client) CreateInvoiceCustomFields(ctx context.Context, params *CreateIn
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -603,7 +644,7 @@ func (a *Client) CreateInvoiceCustomFields(ctx context.Context, params *CreateIn
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createInvoiceCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -615,7 +656,12 @@ func (a *Client) CreateInvoiceCustomFields(ctx context.Context, params *CreateIn
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -626,7 +672,7 @@ func (a *Client) CreateInvoiceCustomFields(ctx context.Context, params *CreateIn
 /*
   CreateInvoiceTags adds tags to invoice
 */
-func (a *Client) CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTagsParams) (*CreateInvoiceTagsCreated, error) {
+func (a *Client) CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTagsParams, opts ...ClientOption) (*CreateInvoiceTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateInvoiceTagsParams()
@@ -668,6 +714,9 @@ func (a *Client) CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTag
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -679,7 +728,7 @@ func (a *Client) CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTag
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createInvoiceTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -691,7 +740,12 @@ func (a *Client) CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTag
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -702,7 +756,7 @@ func (a *Client) CreateInvoiceTags(ctx context.Context, params *CreateInvoiceTag
 /*
   CreateMigrationInvoice creates a migration invoice
 */
-func (a *Client) CreateMigrationInvoice(ctx context.Context, params *CreateMigrationInvoiceParams) (*CreateMigrationInvoiceCreated, error) {
+func (a *Client) CreateMigrationInvoice(ctx context.Context, params *CreateMigrationInvoiceParams, opts ...ClientOption) (*CreateMigrationInvoiceCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateMigrationInvoiceParams()
@@ -744,6 +798,9 @@ func (a *Client) CreateMigrationInvoice(ctx context.Context, params *CreateMigra
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -755,7 +812,7 @@ func (a *Client) CreateMigrationInvoice(ctx context.Context, params *CreateMigra
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createMigrationInvoice",
 		Method:             "GET",
 		PathPattern:        location,
@@ -767,7 +824,12 @@ func (a *Client) CreateMigrationInvoice(ctx context.Context, params *CreateMigra
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -778,7 +840,7 @@ func (a *Client) CreateMigrationInvoice(ctx context.Context, params *CreateMigra
 /*
   CreateTaxItems creates tax items
 */
-func (a *Client) CreateTaxItems(ctx context.Context, params *CreateTaxItemsParams) (*CreateTaxItemsCreated, error) {
+func (a *Client) CreateTaxItems(ctx context.Context, params *CreateTaxItemsParams, opts ...ClientOption) (*CreateTaxItemsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateTaxItemsParams()
@@ -820,6 +882,9 @@ func (a *Client) CreateTaxItems(ctx context.Context, params *CreateTaxItemsParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -831,7 +896,7 @@ func (a *Client) CreateTaxItems(ctx context.Context, params *CreateTaxItemsParam
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createTaxItems",
 		Method:             "GET",
 		PathPattern:        location,
@@ -843,7 +908,12 @@ func (a *Client) CreateTaxItems(ctx context.Context, params *CreateTaxItemsParam
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -854,7 +924,7 @@ func (a *Client) CreateTaxItems(ctx context.Context, params *CreateTaxItemsParam
 /*
   DeleteCBA deletes a c b a item
 */
-func (a *Client) DeleteCBA(ctx context.Context, params *DeleteCBAParams) (*DeleteCBANoContent, error) {
+func (a *Client) DeleteCBA(ctx context.Context, params *DeleteCBAParams, opts ...ClientOption) (*DeleteCBANoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteCBAParams()
@@ -893,6 +963,9 @@ func (a *Client) DeleteCBA(ctx context.Context, params *DeleteCBAParams) (*Delet
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -912,7 +985,7 @@ func (a *Client) DeleteCBA(ctx context.Context, params *DeleteCBAParams) (*Delet
 /*
   DeleteInvoiceCustomFields removes custom fields from invoice
 */
-func (a *Client) DeleteInvoiceCustomFields(ctx context.Context, params *DeleteInvoiceCustomFieldsParams) (*DeleteInvoiceCustomFieldsNoContent, error) {
+func (a *Client) DeleteInvoiceCustomFields(ctx context.Context, params *DeleteInvoiceCustomFieldsParams, opts ...ClientOption) (*DeleteInvoiceCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteInvoiceCustomFieldsParams()
@@ -951,6 +1024,9 @@ func (a *Client) DeleteInvoiceCustomFields(ctx context.Context, params *DeleteIn
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -970,7 +1046,7 @@ func (a *Client) DeleteInvoiceCustomFields(ctx context.Context, params *DeleteIn
 /*
   DeleteInvoiceTags removes tags from invoice
 */
-func (a *Client) DeleteInvoiceTags(ctx context.Context, params *DeleteInvoiceTagsParams) (*DeleteInvoiceTagsNoContent, error) {
+func (a *Client) DeleteInvoiceTags(ctx context.Context, params *DeleteInvoiceTagsParams, opts ...ClientOption) (*DeleteInvoiceTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteInvoiceTagsParams()
@@ -1009,6 +1085,9 @@ func (a *Client) DeleteInvoiceTags(ctx context.Context, params *DeleteInvoiceTag
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1028,7 +1107,7 @@ func (a *Client) DeleteInvoiceTags(ctx context.Context, params *DeleteInvoiceTag
 /*
   GenerateDryRunInvoice generates a dry run invoice
 */
-func (a *Client) GenerateDryRunInvoice(ctx context.Context, params *GenerateDryRunInvoiceParams) (*GenerateDryRunInvoiceOK, *GenerateDryRunInvoiceNoContent, error) {
+func (a *Client) GenerateDryRunInvoice(ctx context.Context, params *GenerateDryRunInvoiceParams, opts ...ClientOption) (*GenerateDryRunInvoiceOK, *GenerateDryRunInvoiceNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGenerateDryRunInvoiceParams()
@@ -1067,6 +1146,9 @@ func (a *Client) GenerateDryRunInvoice(ctx context.Context, params *GenerateDryR
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1087,7 +1169,7 @@ func (a *Client) GenerateDryRunInvoice(ctx context.Context, params *GenerateDryR
 /*
   GetCatalogTranslation retrieves the catalog translation for the tenant
 */
-func (a *Client) GetCatalogTranslation(ctx context.Context, params *GetCatalogTranslationParams) (*GetCatalogTranslationOK, error) {
+func (a *Client) GetCatalogTranslation(ctx context.Context, params *GetCatalogTranslationParams, opts ...ClientOption) (*GetCatalogTranslationOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCatalogTranslationParams()
@@ -1114,6 +1196,9 @@ func (a *Client) GetCatalogTranslation(ctx context.Context, params *GetCatalogTr
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1133,7 +1218,7 @@ func (a *Client) GetCatalogTranslation(ctx context.Context, params *GetCatalogTr
 /*
   GetInvoice retrieves an invoice by id
 */
-func (a *Client) GetInvoice(ctx context.Context, params *GetInvoiceParams) (*GetInvoiceOK, error) {
+func (a *Client) GetInvoice(ctx context.Context, params *GetInvoiceParams, opts ...ClientOption) (*GetInvoiceOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceParams()
@@ -1160,6 +1245,9 @@ func (a *Client) GetInvoice(ctx context.Context, params *GetInvoiceParams) (*Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1179,7 +1267,7 @@ func (a *Client) GetInvoice(ctx context.Context, params *GetInvoiceParams) (*Get
 /*
   GetInvoiceAsHTML renders an invoice as HTML
 */
-func (a *Client) GetInvoiceAsHTML(ctx context.Context, params *GetInvoiceAsHTMLParams) (*GetInvoiceAsHTMLOK, error) {
+func (a *Client) GetInvoiceAsHTML(ctx context.Context, params *GetInvoiceAsHTMLParams, opts ...ClientOption) (*GetInvoiceAsHTMLOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceAsHTMLParams()
@@ -1206,6 +1294,9 @@ func (a *Client) GetInvoiceAsHTML(ctx context.Context, params *GetInvoiceAsHTMLP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1225,7 +1316,7 @@ func (a *Client) GetInvoiceAsHTML(ctx context.Context, params *GetInvoiceAsHTMLP
 /*
   GetInvoiceAuditLogsWithHistory retrieves invoice audit logs with history by id
 */
-func (a *Client) GetInvoiceAuditLogsWithHistory(ctx context.Context, params *GetInvoiceAuditLogsWithHistoryParams) (*GetInvoiceAuditLogsWithHistoryOK, error) {
+func (a *Client) GetInvoiceAuditLogsWithHistory(ctx context.Context, params *GetInvoiceAuditLogsWithHistoryParams, opts ...ClientOption) (*GetInvoiceAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceAuditLogsWithHistoryParams()
@@ -1252,6 +1343,9 @@ func (a *Client) GetInvoiceAuditLogsWithHistory(ctx context.Context, params *Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1271,7 +1365,7 @@ func (a *Client) GetInvoiceAuditLogsWithHistory(ctx context.Context, params *Get
 /*
   GetInvoiceByItemID retrieves an invoice by invoice item id
 */
-func (a *Client) GetInvoiceByItemID(ctx context.Context, params *GetInvoiceByItemIDParams) (*GetInvoiceByItemIDOK, error) {
+func (a *Client) GetInvoiceByItemID(ctx context.Context, params *GetInvoiceByItemIDParams, opts ...ClientOption) (*GetInvoiceByItemIDOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceByItemIDParams()
@@ -1298,6 +1392,9 @@ func (a *Client) GetInvoiceByItemID(ctx context.Context, params *GetInvoiceByIte
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1317,7 +1414,7 @@ func (a *Client) GetInvoiceByItemID(ctx context.Context, params *GetInvoiceByIte
 /*
   GetInvoiceByNumber retrieves an invoice by number
 */
-func (a *Client) GetInvoiceByNumber(ctx context.Context, params *GetInvoiceByNumberParams) (*GetInvoiceByNumberOK, error) {
+func (a *Client) GetInvoiceByNumber(ctx context.Context, params *GetInvoiceByNumberParams, opts ...ClientOption) (*GetInvoiceByNumberOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceByNumberParams()
@@ -1344,6 +1441,9 @@ func (a *Client) GetInvoiceByNumber(ctx context.Context, params *GetInvoiceByNum
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1363,7 +1463,7 @@ func (a *Client) GetInvoiceByNumber(ctx context.Context, params *GetInvoiceByNum
 /*
   GetInvoiceCustomFields retrieves invoice custom fields
 */
-func (a *Client) GetInvoiceCustomFields(ctx context.Context, params *GetInvoiceCustomFieldsParams) (*GetInvoiceCustomFieldsOK, error) {
+func (a *Client) GetInvoiceCustomFields(ctx context.Context, params *GetInvoiceCustomFieldsParams, opts ...ClientOption) (*GetInvoiceCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceCustomFieldsParams()
@@ -1390,6 +1490,9 @@ func (a *Client) GetInvoiceCustomFields(ctx context.Context, params *GetInvoiceC
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1409,7 +1512,7 @@ func (a *Client) GetInvoiceCustomFields(ctx context.Context, params *GetInvoiceC
 /*
   GetInvoiceMPTemplate retrieves the manual pay invoice template for the tenant
 */
-func (a *Client) GetInvoiceMPTemplate(ctx context.Context, params *GetInvoiceMPTemplateParams) (*GetInvoiceMPTemplateOK, error) {
+func (a *Client) GetInvoiceMPTemplate(ctx context.Context, params *GetInvoiceMPTemplateParams, opts ...ClientOption) (*GetInvoiceMPTemplateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceMPTemplateParams()
@@ -1436,6 +1539,9 @@ func (a *Client) GetInvoiceMPTemplate(ctx context.Context, params *GetInvoiceMPT
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1455,7 +1561,7 @@ func (a *Client) GetInvoiceMPTemplate(ctx context.Context, params *GetInvoiceMPT
 /*
   GetInvoiceTags retrieves invoice tags
 */
-func (a *Client) GetInvoiceTags(ctx context.Context, params *GetInvoiceTagsParams) (*GetInvoiceTagsOK, error) {
+func (a *Client) GetInvoiceTags(ctx context.Context, params *GetInvoiceTagsParams, opts ...ClientOption) (*GetInvoiceTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceTagsParams()
@@ -1482,6 +1588,9 @@ func (a *Client) GetInvoiceTags(ctx context.Context, params *GetInvoiceTagsParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1501,7 +1610,7 @@ func (a *Client) GetInvoiceTags(ctx context.Context, params *GetInvoiceTagsParam
 /*
   GetInvoiceTemplate retrieves the invoice template for the tenant
 */
-func (a *Client) GetInvoiceTemplate(ctx context.Context, params *GetInvoiceTemplateParams) (*GetInvoiceTemplateOK, error) {
+func (a *Client) GetInvoiceTemplate(ctx context.Context, params *GetInvoiceTemplateParams, opts ...ClientOption) (*GetInvoiceTemplateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceTemplateParams()
@@ -1528,6 +1637,9 @@ func (a *Client) GetInvoiceTemplate(ctx context.Context, params *GetInvoiceTempl
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1547,7 +1659,7 @@ func (a *Client) GetInvoiceTemplate(ctx context.Context, params *GetInvoiceTempl
 /*
   GetInvoiceTranslation retrieves the invoice translation for the tenant
 */
-func (a *Client) GetInvoiceTranslation(ctx context.Context, params *GetInvoiceTranslationParams) (*GetInvoiceTranslationOK, error) {
+func (a *Client) GetInvoiceTranslation(ctx context.Context, params *GetInvoiceTranslationParams, opts ...ClientOption) (*GetInvoiceTranslationOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceTranslationParams()
@@ -1574,6 +1686,9 @@ func (a *Client) GetInvoiceTranslation(ctx context.Context, params *GetInvoiceTr
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1593,7 +1708,7 @@ func (a *Client) GetInvoiceTranslation(ctx context.Context, params *GetInvoiceTr
 /*
   GetInvoices lists invoices
 */
-func (a *Client) GetInvoices(ctx context.Context, params *GetInvoicesParams) (*GetInvoicesOK, error) {
+func (a *Client) GetInvoices(ctx context.Context, params *GetInvoicesParams, opts ...ClientOption) (*GetInvoicesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicesParams()
@@ -1620,6 +1735,9 @@ func (a *Client) GetInvoices(ctx context.Context, params *GetInvoicesParams) (*G
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1639,7 +1757,7 @@ func (a *Client) GetInvoices(ctx context.Context, params *GetInvoicesParams) (*G
 /*
   GetInvoicesGroup retrieves a set of invoices by group id
 */
-func (a *Client) GetInvoicesGroup(ctx context.Context, params *GetInvoicesGroupParams) (*GetInvoicesGroupOK, error) {
+func (a *Client) GetInvoicesGroup(ctx context.Context, params *GetInvoicesGroupParams, opts ...ClientOption) (*GetInvoicesGroupOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicesGroupParams()
@@ -1666,6 +1784,9 @@ func (a *Client) GetInvoicesGroup(ctx context.Context, params *GetInvoicesGroupP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1685,7 +1806,7 @@ func (a *Client) GetInvoicesGroup(ctx context.Context, params *GetInvoicesGroupP
 /*
   GetPaymentsForInvoice retrieves payments associated with an invoice
 */
-func (a *Client) GetPaymentsForInvoice(ctx context.Context, params *GetPaymentsForInvoiceParams) (*GetPaymentsForInvoiceOK, error) {
+func (a *Client) GetPaymentsForInvoice(ctx context.Context, params *GetPaymentsForInvoiceParams, opts ...ClientOption) (*GetPaymentsForInvoiceOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentsForInvoiceParams()
@@ -1712,6 +1833,9 @@ func (a *Client) GetPaymentsForInvoice(ctx context.Context, params *GetPaymentsF
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1731,7 +1855,7 @@ func (a *Client) GetPaymentsForInvoice(ctx context.Context, params *GetPaymentsF
 /*
   ModifyInvoiceCustomFields modifies custom fields to invoice
 */
-func (a *Client) ModifyInvoiceCustomFields(ctx context.Context, params *ModifyInvoiceCustomFieldsParams) (*ModifyInvoiceCustomFieldsNoContent, error) {
+func (a *Client) ModifyInvoiceCustomFields(ctx context.Context, params *ModifyInvoiceCustomFieldsParams, opts ...ClientOption) (*ModifyInvoiceCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyInvoiceCustomFieldsParams()
@@ -1770,6 +1894,9 @@ func (a *Client) ModifyInvoiceCustomFields(ctx context.Context, params *ModifyIn
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1789,7 +1916,7 @@ func (a *Client) ModifyInvoiceCustomFields(ctx context.Context, params *ModifyIn
 /*
   SearchInvoices searches invoices
 */
-func (a *Client) SearchInvoices(ctx context.Context, params *SearchInvoicesParams) (*SearchInvoicesOK, error) {
+func (a *Client) SearchInvoices(ctx context.Context, params *SearchInvoicesParams, opts ...ClientOption) (*SearchInvoicesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchInvoicesParams()
@@ -1816,6 +1943,9 @@ func (a *Client) SearchInvoices(ctx context.Context, params *SearchInvoicesParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1835,7 +1965,7 @@ func (a *Client) SearchInvoices(ctx context.Context, params *SearchInvoicesParam
 /*
   UploadCatalogTranslation uploads the catalog translation for the tenant
 */
-func (a *Client) UploadCatalogTranslation(ctx context.Context, params *UploadCatalogTranslationParams) (*UploadCatalogTranslationCreated, error) {
+func (a *Client) UploadCatalogTranslation(ctx context.Context, params *UploadCatalogTranslationParams, opts ...ClientOption) (*UploadCatalogTranslationCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadCatalogTranslationParams()
@@ -1877,6 +2007,9 @@ func (a *Client) UploadCatalogTranslation(ctx context.Context, params *UploadCat
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1888,7 +2021,7 @@ func (a *Client) UploadCatalogTranslation(ctx context.Context, params *UploadCat
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadCatalogTranslation",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1900,7 +2033,12 @@ func (a *Client) UploadCatalogTranslation(ctx context.Context, params *UploadCat
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -1911,7 +2049,7 @@ func (a *Client) UploadCatalogTranslation(ctx context.Context, params *UploadCat
 /*
   UploadInvoiceMPTemplate uploads the manual pay invoice template for the tenant
 */
-func (a *Client) UploadInvoiceMPTemplate(ctx context.Context, params *UploadInvoiceMPTemplateParams) (*UploadInvoiceMPTemplateOK, error) {
+func (a *Client) UploadInvoiceMPTemplate(ctx context.Context, params *UploadInvoiceMPTemplateParams, opts ...ClientOption) (*UploadInvoiceMPTemplateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadInvoiceMPTemplateParams()
@@ -1950,6 +2088,9 @@ func (a *Client) UploadInvoiceMPTemplate(ctx context.Context, params *UploadInvo
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1969,7 +2110,7 @@ func (a *Client) UploadInvoiceMPTemplate(ctx context.Context, params *UploadInvo
 /*
   UploadInvoiceTemplate uploads the invoice template for the tenant
 */
-func (a *Client) UploadInvoiceTemplate(ctx context.Context, params *UploadInvoiceTemplateParams) (*UploadInvoiceTemplateCreated, error) {
+func (a *Client) UploadInvoiceTemplate(ctx context.Context, params *UploadInvoiceTemplateParams, opts ...ClientOption) (*UploadInvoiceTemplateCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadInvoiceTemplateParams()
@@ -2011,6 +2152,9 @@ func (a *Client) UploadInvoiceTemplate(ctx context.Context, params *UploadInvoic
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2022,7 +2166,7 @@ func (a *Client) UploadInvoiceTemplate(ctx context.Context, params *UploadInvoic
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadInvoiceTemplate",
 		Method:             "GET",
 		PathPattern:        location,
@@ -2034,7 +2178,12 @@ func (a *Client) UploadInvoiceTemplate(ctx context.Context, params *UploadInvoic
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -2045,7 +2194,7 @@ func (a *Client) UploadInvoiceTemplate(ctx context.Context, params *UploadInvoic
 /*
   UploadInvoiceTranslation uploads the invoice translation for the tenant
 */
-func (a *Client) UploadInvoiceTranslation(ctx context.Context, params *UploadInvoiceTranslationParams) (*UploadInvoiceTranslationCreated, error) {
+func (a *Client) UploadInvoiceTranslation(ctx context.Context, params *UploadInvoiceTranslationParams, opts ...ClientOption) (*UploadInvoiceTranslationCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadInvoiceTranslationParams()
@@ -2087,6 +2236,9 @@ func (a *Client) UploadInvoiceTranslation(ctx context.Context, params *UploadInv
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -2098,7 +2250,7 @@ func (a *Client) UploadInvoiceTranslation(ctx context.Context, params *UploadInv
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadInvoiceTranslation",
 		Method:             "GET",
 		PathPattern:        location,
@@ -2110,7 +2262,12 @@ func (a *Client) UploadInvoiceTranslation(ctx context.Context, params *UploadInv
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -2121,7 +2278,7 @@ func (a *Client) UploadInvoiceTranslation(ctx context.Context, params *UploadInv
 /*
   VoidInvoice performs the action of voiding an invoice
 */
-func (a *Client) VoidInvoice(ctx context.Context, params *VoidInvoiceParams) (*VoidInvoiceNoContent, error) {
+func (a *Client) VoidInvoice(ctx context.Context, params *VoidInvoiceParams, opts ...ClientOption) (*VoidInvoiceNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewVoidInvoiceParams()
@@ -2160,6 +2317,9 @@ func (a *Client) VoidInvoice(ctx context.Context, params *VoidInvoiceParams) (*V
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
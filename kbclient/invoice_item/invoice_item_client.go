@@ -53,21 +53,21 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateInvoiceItemCustomFields(ctx context.Context, params *CreateInvoiceItemCustomFieldsParams) (*CreateInvoiceItemCustomFieldsCreated, error)
+	CreateInvoiceItemCustomFields(ctx context.Context, params *CreateInvoiceItemCustomFieldsParams, opts ...ClientOption) (*CreateInvoiceItemCustomFieldsCreated, error)
 
-	CreateInvoiceItemTags(ctx context.Context, params *CreateInvoiceItemTagsParams) (*CreateInvoiceItemTagsCreated, error)
+	CreateInvoiceItemTags(ctx context.Context, params *CreateInvoiceItemTagsParams, opts ...ClientOption) (*CreateInvoiceItemTagsCreated, error)
 
-	DeleteInvoiceItemCustomFields(ctx context.Context, params *DeleteInvoiceItemCustomFieldsParams) (*DeleteInvoiceItemCustomFieldsNoContent, error)
+	DeleteInvoiceItemCustomFields(ctx context.Context, params *DeleteInvoiceItemCustomFieldsParams, opts ...ClientOption) (*DeleteInvoiceItemCustomFieldsNoContent, error)
 
-	DeleteInvoiceItemTags(ctx context.Context, params *DeleteInvoiceItemTagsParams) (*DeleteInvoiceItemTagsNoContent, error)
+	DeleteInvoiceItemTags(ctx context.Context, params *DeleteInvoiceItemTagsParams, opts ...ClientOption) (*DeleteInvoiceItemTagsNoContent, error)
 
-	GetInvoiceItemAuditLogsWithHistory(ctx context.Context, params *GetInvoiceItemAuditLogsWithHistoryParams) (*GetInvoiceItemAuditLogsWithHistoryOK, error)
+	GetInvoiceItemAuditLogsWithHistory(ctx context.Context, params *GetInvoiceItemAuditLogsWithHistoryParams, opts ...ClientOption) (*GetInvoiceItemAuditLogsWithHistoryOK, error)
 
-	GetInvoiceItemCustomFields(ctx context.Context, params *GetInvoiceItemCustomFieldsParams) (*GetInvoiceItemCustomFieldsOK, error)
+	GetInvoiceItemCustomFields(ctx context.Context, params *GetInvoiceItemCustomFieldsParams, opts ...ClientOption) (*GetInvoiceItemCustomFieldsOK, error)
 
-	GetInvoiceItemTags(ctx context.Context, params *GetInvoiceItemTagsParams) (*GetInvoiceItemTagsOK, error)
+	GetInvoiceItemTags(ctx context.Context, params *GetInvoiceItemTagsParams, opts ...ClientOption) (*GetInvoiceItemTagsOK, error)
 
-	ModifyInvoiceItemCustomFields(ctx context.Context, params *ModifyInvoiceItemCustomFieldsParams) (*ModifyInvoiceItemCustomFieldsNoContent, error)
+	ModifyInvoiceItemCustomFields(ctx context.Context, params *ModifyInvoiceItemCustomFieldsParams, opts ...ClientOption) (*ModifyInvoiceItemCustomFieldsNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -75,7 +75,7 @@ type ClientService interface {
 /*
   CreateInvoiceItemCustomFields adds custom fields to invoice item
 */
-func (a *Client) CreateInvoiceItemCustomFields(ctx context.Context, params *CreateInvoiceItemCustomFieldsParams) (*CreateInvoiceItemCustomFieldsCreated, error) {
+func (a *Client) CreateInvoiceItemCustomFields(ctx context.Context, params *CreateInvoiceItemCustomFieldsParams, opts ...ClientOption) (*CreateInvoiceItemCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateInvoiceItemCustomFieldsParams()
@@ -117,6 +117,9 @@ func (a *Client) CreateInvoiceItemCustomFields(ctx context.Context, params *Crea
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -128,7 +131,7 @@ func (a *Client) CreateInvoiceItemCustomFields(ctx context.Context, params *Crea
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createInvoiceItemCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -140,7 +143,12 @@ func (a *Client) CreateInvoiceItemCustomFields(ctx context.Context, params *Crea
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +159,7 @@ func (a *Client) CreateInvoiceItemCustomFields(ctx context.Context, params *Crea
 /*
   CreateInvoiceItemTags adds tags to invoice item
 */
-func (a *Client) CreateInvoiceItemTags(ctx context.Context, params *CreateInvoiceItemTagsParams) (*CreateInvoiceItemTagsCreated, error) {
+func (a *Client) CreateInvoiceItemTags(ctx context.Context, params *CreateInvoiceItemTagsParams, opts ...ClientOption) (*CreateInvoiceItemTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateInvoiceItemTagsParams()
@@ -193,6 +201,9 @@ func (a *Client) CreateInvoiceItemTags(ctx context.Context, params *CreateInvoic
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -204,7 +215,7 @@ func (a *Client) CreateInvoiceItemTags(ctx context.Context, params *CreateInvoic
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createInvoiceItemTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -216,7 +227,12 @@ func (a *Client) CreateInvoiceItemTags(ctx context.Context, params *CreateInvoic
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +243,7 @@ func (a *Client) CreateInvoiceItemTags(ctx context.Context, params *CreateInvoic
 /*
   DeleteInvoiceItemCustomFields removes custom fields from invoice item
 */
-func (a *Client) DeleteInvoiceItemCustomFields(ctx context.Context, params *DeleteInvoiceItemCustomFieldsParams) (*DeleteInvoiceItemCustomFieldsNoContent, error) {
+func (a *Client) DeleteInvoiceItemCustomFields(ctx context.Context, params *DeleteInvoiceItemCustomFieldsParams, opts ...ClientOption) (*DeleteInvoiceItemCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteInvoiceItemCustomFieldsParams()
@@ -266,6 +282,9 @@ func (a *Client) DeleteInvoiceItemCustomFields(ctx context.Context, params *Dele
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -285,7 +304,7 @@ func (a *Client) DeleteInvoiceItemCustomFields(ctx context.Context, params *Dele
 /*
   DeleteInvoiceItemTags removes tags from invoice item
 */
-func (a *Client) DeleteInvoiceItemTags(ctx context.Context, params *DeleteInvoiceItemTagsParams) (*DeleteInvoiceItemTagsNoContent, error) {
+func (a *Client) DeleteInvoiceItemTags(ctx context.Context, params *DeleteInvoiceItemTagsParams, opts ...ClientOption) (*DeleteInvoiceItemTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteInvoiceItemTagsParams()
@@ -324,6 +343,9 @@ func (a *Client) DeleteInvoiceItemTags(ctx context.Context, params *DeleteInvoic
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -343,7 +365,7 @@ func (a *Client) DeleteInvoiceItemTags(ctx context.Context, params *DeleteInvoic
 /*
   GetInvoiceItemAuditLogsWithHistory retrieves invoice item audit logs with history by id
 */
-func (a *Client) GetInvoiceItemAuditLogsWithHistory(ctx context.Context, params *GetInvoiceItemAuditLogsWithHistoryParams) (*GetInvoiceItemAuditLogsWithHistoryOK, error) {
+func (a *Client) GetInvoiceItemAuditLogsWithHistory(ctx context.Context, params *GetInvoiceItemAuditLogsWithHistoryParams, opts ...ClientOption) (*GetInvoiceItemAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceItemAuditLogsWithHistoryParams()
@@ -370,6 +392,9 @@ func (a *Client) GetInvoiceItemAuditLogsWithHistory(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -389,7 +414,7 @@ func (a *Client) GetInvoiceItemAuditLogsWithHistory(ctx context.Context, params
 /*
   GetInvoiceItemCustomFields retrieves invoice item custom fields
 */
-func (a *Client) GetInvoiceItemCustomFields(ctx context.Context, params *GetInvoiceItemCustomFieldsParams) (*GetInvoiceItemCustomFieldsOK, error) {
+func (a *Client) GetInvoiceItemCustomFields(ctx context.Context, params *GetInvoiceItemCustomFieldsParams, opts ...ClientOption) (*GetInvoiceItemCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceItemCustomFieldsParams()
@@ -416,6 +441,9 @@ func (a *Client) GetInvoiceItemCustomFields(ctx context.Context, params *GetInvo
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -435,7 +463,7 @@ func (a *Client) GetInvoiceItemCustomFields(ctx context.Context, params *GetInvo
 /*
   GetInvoiceItemTags retrieves invoice item tags
 */
-func (a *Client) GetInvoiceItemTags(ctx context.Context, params *GetInvoiceItemTagsParams) (*GetInvoiceItemTagsOK, error) {
+func (a *Client) GetInvoiceItemTags(ctx context.Context, params *GetInvoiceItemTagsParams, opts ...ClientOption) (*GetInvoiceItemTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoiceItemTagsParams()
@@ -462,6 +490,9 @@ func (a *Client) GetInvoiceItemTags(ctx context.Context, params *GetInvoiceItemT
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -481,7 +512,7 @@ func (a *Client) GetInvoiceItemTags(ctx context.Context, params *GetInvoiceItemT
 /*
   ModifyInvoiceItemCustomFields modifies custom fields to invoice item
 */
-func (a *Client) ModifyInvoiceItemCustomFields(ctx context.Context, params *ModifyInvoiceItemCustomFieldsParams) (*ModifyInvoiceItemCustomFieldsNoContent, error) {
+func (a *Client) ModifyInvoiceItemCustomFields(ctx context.Context, params *ModifyInvoiceItemCustomFieldsParams, opts ...ClientOption) (*ModifyInvoiceItemCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyInvoiceItemCustomFieldsParams()
@@ -520,6 +551,9 @@ func (a *Client) ModifyInvoiceItemCustomFields(ctx context.Context, params *Modi
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
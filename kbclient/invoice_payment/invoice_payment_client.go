@@ -53,31 +53,31 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CompleteInvoicePaymentTransaction(ctx context.Context, params *CompleteInvoicePaymentTransactionParams) (*CompleteInvoicePaymentTransactionNoContent, error)
+	CompleteInvoicePaymentTransaction(ctx context.Context, params *CompleteInvoicePaymentTransactionParams, opts ...ClientOption) (*CompleteInvoicePaymentTransactionNoContent, error)
 
-	CreateChargeback(ctx context.Context, params *CreateChargebackParams) (*CreateChargebackCreated, error)
+	CreateChargeback(ctx context.Context, params *CreateChargebackParams, opts ...ClientOption) (*CreateChargebackCreated, error)
 
-	CreateChargebackReversal(ctx context.Context, params *CreateChargebackReversalParams) (*CreateChargebackReversalCreated, error)
+	CreateChargebackReversal(ctx context.Context, params *CreateChargebackReversalParams, opts ...ClientOption) (*CreateChargebackReversalCreated, error)
 
-	CreateInvoicePaymentCustomFields(ctx context.Context, params *CreateInvoicePaymentCustomFieldsParams) (*CreateInvoicePaymentCustomFieldsCreated, error)
+	CreateInvoicePaymentCustomFields(ctx context.Context, params *CreateInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*CreateInvoicePaymentCustomFieldsCreated, error)
 
-	CreateInvoicePaymentTags(ctx context.Context, params *CreateInvoicePaymentTagsParams) (*CreateInvoicePaymentTagsCreated, error)
+	CreateInvoicePaymentTags(ctx context.Context, params *CreateInvoicePaymentTagsParams, opts ...ClientOption) (*CreateInvoicePaymentTagsCreated, error)
 
-	CreateRefundWithAdjustments(ctx context.Context, params *CreateRefundWithAdjustmentsParams) (*CreateRefundWithAdjustmentsCreated, error)
+	CreateRefundWithAdjustments(ctx context.Context, params *CreateRefundWithAdjustmentsParams, opts ...ClientOption) (*CreateRefundWithAdjustmentsCreated, error)
 
-	DeleteInvoicePaymentCustomFields(ctx context.Context, params *DeleteInvoicePaymentCustomFieldsParams) (*DeleteInvoicePaymentCustomFieldsNoContent, error)
+	DeleteInvoicePaymentCustomFields(ctx context.Context, params *DeleteInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*DeleteInvoicePaymentCustomFieldsNoContent, error)
 
-	DeleteInvoicePaymentTags(ctx context.Context, params *DeleteInvoicePaymentTagsParams) (*DeleteInvoicePaymentTagsNoContent, error)
+	DeleteInvoicePaymentTags(ctx context.Context, params *DeleteInvoicePaymentTagsParams, opts ...ClientOption) (*DeleteInvoicePaymentTagsNoContent, error)
 
-	GetInvoicePayment(ctx context.Context, params *GetInvoicePaymentParams) (*GetInvoicePaymentOK, error)
+	GetInvoicePayment(ctx context.Context, params *GetInvoicePaymentParams, opts ...ClientOption) (*GetInvoicePaymentOK, error)
 
-	GetInvoicePaymentAuditLogsWithHistory(ctx context.Context, params *GetInvoicePaymentAuditLogsWithHistoryParams) (*GetInvoicePaymentAuditLogsWithHistoryOK, error)
+	GetInvoicePaymentAuditLogsWithHistory(ctx context.Context, params *GetInvoicePaymentAuditLogsWithHistoryParams, opts ...ClientOption) (*GetInvoicePaymentAuditLogsWithHistoryOK, error)
 
-	GetInvoicePaymentCustomFields(ctx context.Context, params *GetInvoicePaymentCustomFieldsParams) (*GetInvoicePaymentCustomFieldsOK, error)
+	GetInvoicePaymentCustomFields(ctx context.Context, params *GetInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*GetInvoicePaymentCustomFieldsOK, error)
 
-	GetInvoicePaymentTags(ctx context.Context, params *GetInvoicePaymentTagsParams) (*GetInvoicePaymentTagsOK, error)
+	GetInvoicePaymentTags(ctx context.Context, params *GetInvoicePaymentTagsParams, opts ...ClientOption) (*GetInvoicePaymentTagsOK, error)
 
-	ModifyInvoicePaymentCustomFields(ctx context.Context, params *ModifyInvoicePaymentCustomFieldsParams) (*ModifyInvoicePaymentCustomFieldsNoContent, error)
+	ModifyInvoicePaymentCustomFields(ctx context.Context, params *ModifyInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*ModifyInvoicePaymentCustomFieldsNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -85,7 +85,7 @@ type ClientService interface {
 /*
   CompleteInvoicePaymentTransaction completes an existing transaction
 */
-func (a *Client) CompleteInvoicePaymentTransaction(ctx context.Context, params *CompleteInvoicePaymentTransactionParams) (*CompleteInvoicePaymentTransactionNoContent, error) {
+func (a *Client) CompleteInvoicePaymentTransaction(ctx context.Context, params *CompleteInvoicePaymentTransactionParams, opts ...ClientOption) (*CompleteInvoicePaymentTransactionNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCompleteInvoicePaymentTransactionParams()
@@ -124,6 +124,9 @@ func (a *Client) CompleteInvoicePaymentTransaction(ctx context.Context, params *
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -143,7 +146,7 @@ func (a *Client) CompleteInvoicePaymentTransaction(ctx context.Context, params *
 /*
   CreateChargeback records a chargeback
 */
-func (a *Client) CreateChargeback(ctx context.Context, params *CreateChargebackParams) (*CreateChargebackCreated, error) {
+func (a *Client) CreateChargeback(ctx context.Context, params *CreateChargebackParams, opts ...ClientOption) (*CreateChargebackCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateChargebackParams()
@@ -185,6 +188,9 @@ func (a *Client) CreateChargeback(ctx context.Context, params *CreateChargebackP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -196,7 +202,7 @@ func (a *Client) CreateChargeback(ctx context.Context, params *CreateChargebackP
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createChargeback",
 		Method:             "GET",
 		PathPattern:        location,
@@ -208,7 +214,12 @@ func (a *Client) CreateChargeback(ctx context.Context, params *CreateChargebackP
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +230,7 @@ func (a *Client) CreateChargeback(ctx context.Context, params *CreateChargebackP
 /*
   CreateChargebackReversal records a chargeback reversal
 */
-func (a *Client) CreateChargebackReversal(ctx context.Context, params *CreateChargebackReversalParams) (*CreateChargebackReversalCreated, error) {
+func (a *Client) CreateChargebackReversal(ctx context.Context, params *CreateChargebackReversalParams, opts ...ClientOption) (*CreateChargebackReversalCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateChargebackReversalParams()
@@ -261,6 +272,9 @@ func (a *Client) CreateChargebackReversal(ctx context.Context, params *CreateCha
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -272,7 +286,7 @@ func (a *Client) CreateChargebackReversal(ctx context.Context, params *CreateCha
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createChargebackReversal",
 		Method:             "GET",
 		PathPattern:        location,
@@ -284,7 +298,12 @@ func (a *Client) CreateChargebackReversal(ctx context.Context, params *CreateCha
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +314,7 @@ func (a *Client) CreateChargebackReversal(ctx context.Context, params *CreateCha
 /*
   CreateInvoicePaymentCustomFields adds custom fields to payment
 */
-func (a *Client) CreateInvoicePaymentCustomFields(ctx context.Context, params *CreateInvoicePaymentCustomFieldsParams) (*CreateInvoicePaymentCustomFieldsCreated, error) {
+func (a *Client) CreateInvoicePaymentCustomFields(ctx context.Context, params *CreateInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*CreateInvoicePaymentCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateInvoicePaymentCustomFieldsParams()
@@ -337,6 +356,9 @@ func (a *Client) CreateInvoicePaymentCustomFields(ctx context.Context, params *C
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -348,7 +370,7 @@ func (a *Client) CreateInvoicePaymentCustomFields(ctx context.Context, params *C
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createInvoicePaymentCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -360,7 +382,12 @@ func (a *Client) CreateInvoicePaymentCustomFields(ctx context.Context, params *C
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +398,7 @@ func (a *Client) CreateInvoicePaymentCustomFields(ctx context.Context, params *C
 /*
   CreateInvoicePaymentTags adds tags to payment
 */
-func (a *Client) CreateInvoicePaymentTags(ctx context.Context, params *CreateInvoicePaymentTagsParams) (*CreateInvoicePaymentTagsCreated, error) {
+func (a *Client) CreateInvoicePaymentTags(ctx context.Context, params *CreateInvoicePaymentTagsParams, opts ...ClientOption) (*CreateInvoicePaymentTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateInvoicePaymentTagsParams()
@@ -413,6 +440,9 @@ func (a *Client) CreateInvoicePaymentTags(ctx context.Context, params *CreateInv
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -424,7 +454,7 @@ func (a *Client) CreateInvoicePaymentTags(ctx context.Context, params *CreateInv
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createInvoicePaymentTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -436,7 +466,12 @@ func (a *Client) CreateInvoicePaymentTags(ctx context.Context, params *CreateInv
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -447,7 +482,7 @@ func (a *Client) CreateInvoicePaymentTags(ctx context.Context, params *CreateInv
 /*
   CreateRefundWithAdjustments refunds a payment and adjust the invoice if needed
 */
-func (a *Client) CreateRefundWithAdjustments(ctx context.Context, params *CreateRefundWithAdjustmentsParams) (*CreateRefundWithAdjustmentsCreated, error) {
+func (a *Client) CreateRefundWithAdjustments(ctx context.Context, params *CreateRefundWithAdjustmentsParams, opts ...ClientOption) (*CreateRefundWithAdjustmentsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateRefundWithAdjustmentsParams()
@@ -489,6 +524,9 @@ func (a *Client) CreateRefundWithAdjustments(ctx context.Context, params *Create
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -500,7 +538,7 @@ func (a *Client) CreateRefundWithAdjustments(ctx context.Context, params *Create
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createRefundWithAdjustments",
 		Method:             "GET",
 		PathPattern:        location,
@@ -512,7 +550,12 @@ func (a *Client) CreateRefundWithAdjustments(ctx context.Context, params *Create
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -523,7 +566,7 @@ func (a *Client) CreateRefundWithAdjustments(ctx context.Context, params *Create
 /*
   DeleteInvoicePaymentCustomFields removes custom fields from payment
 */
-func (a *Client) DeleteInvoicePaymentCustomFields(ctx context.Context, params *DeleteInvoicePaymentCustomFieldsParams) (*DeleteInvoicePaymentCustomFieldsNoContent, error) {
+func (a *Client) DeleteInvoicePaymentCustomFields(ctx context.Context, params *DeleteInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*DeleteInvoicePaymentCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteInvoicePaymentCustomFieldsParams()
@@ -562,6 +605,9 @@ func (a *Client) DeleteInvoicePaymentCustomFields(ctx context.Context, params *D
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -581,7 +627,7 @@ func (a *Client) DeleteInvoicePaymentCustomFields(ctx context.Context, params *D
 /*
   DeleteInvoicePaymentTags removes tags from payment
 */
-func (a *Client) DeleteInvoicePaymentTags(ctx context.Context, params *DeleteInvoicePaymentTagsParams) (*DeleteInvoicePaymentTagsNoContent, error) {
+func (a *Client) DeleteInvoicePaymentTags(ctx context.Context, params *DeleteInvoicePaymentTagsParams, opts ...ClientOption) (*DeleteInvoicePaymentTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteInvoicePaymentTagsParams()
@@ -620,6 +666,9 @@ func (a *Client) DeleteInvoicePaymentTags(ctx context.Context, params *DeleteInv
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -639,7 +688,7 @@ func (a *Client) DeleteInvoicePaymentTags(ctx context.Context, params *DeleteInv
 /*
   GetInvoicePayment retrieves a payment by id
 */
-func (a *Client) GetInvoicePayment(ctx context.Context, params *GetInvoicePaymentParams) (*GetInvoicePaymentOK, error) {
+func (a *Client) GetInvoicePayment(ctx context.Context, params *GetInvoicePaymentParams, opts ...ClientOption) (*GetInvoicePaymentOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicePaymentParams()
@@ -666,6 +715,9 @@ func (a *Client) GetInvoicePayment(ctx context.Context, params *GetInvoicePaymen
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -685,7 +737,7 @@ func (a *Client) GetInvoicePayment(ctx context.Context, params *GetInvoicePaymen
 /*
   GetInvoicePaymentAuditLogsWithHistory retrieves invoice payment audit logs with history by id
 */
-func (a *Client) GetInvoicePaymentAuditLogsWithHistory(ctx context.Context, params *GetInvoicePaymentAuditLogsWithHistoryParams) (*GetInvoicePaymentAuditLogsWithHistoryOK, error) {
+func (a *Client) GetInvoicePaymentAuditLogsWithHistory(ctx context.Context, params *GetInvoicePaymentAuditLogsWithHistoryParams, opts ...ClientOption) (*GetInvoicePaymentAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicePaymentAuditLogsWithHistoryParams()
@@ -712,6 +764,9 @@ func (a *Client) GetInvoicePaymentAuditLogsWithHistory(ctx context.Context, para
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -731,7 +786,7 @@ func (a *Client) GetInvoicePaymentAuditLogsWithHistory(ctx context.Context, para
 /*
   GetInvoicePaymentCustomFields retrieves payment custom fields
 */
-func (a *Client) GetInvoicePaymentCustomFields(ctx context.Context, params *GetInvoicePaymentCustomFieldsParams) (*GetInvoicePaymentCustomFieldsOK, error) {
+func (a *Client) GetInvoicePaymentCustomFields(ctx context.Context, params *GetInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*GetInvoicePaymentCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicePaymentCustomFieldsParams()
@@ -758,6 +813,9 @@ func (a *Client) GetInvoicePaymentCustomFields(ctx context.Context, params *GetI
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -777,7 +835,7 @@ func (a *Client) GetInvoicePaymentCustomFields(ctx context.Context, params *GetI
 /*
   GetInvoicePaymentTags retrieves payment tags
 */
-func (a *Client) GetInvoicePaymentTags(ctx context.Context, params *GetInvoicePaymentTagsParams) (*GetInvoicePaymentTagsOK, error) {
+func (a *Client) GetInvoicePaymentTags(ctx context.Context, params *GetInvoicePaymentTagsParams, opts ...ClientOption) (*GetInvoicePaymentTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetInvoicePaymentTagsParams()
@@ -804,6 +862,9 @@ func (a *Client) GetInvoicePaymentTags(ctx context.Context, params *GetInvoicePa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -823,7 +884,7 @@ func (a *Client) GetInvoicePaymentTags(ctx context.Context, params *GetInvoicePa
 /*
   ModifyInvoicePaymentCustomFields modifies custom fields to payment
 */
-func (a *Client) ModifyInvoicePaymentCustomFields(ctx context.Context, params *ModifyInvoicePaymentCustomFieldsParams) (*ModifyInvoicePaymentCustomFieldsNoContent, error) {
+func (a *Client) ModifyInvoicePaymentCustomFields(ctx context.Context, params *ModifyInvoicePaymentCustomFieldsParams, opts ...ClientOption) (*ModifyInvoicePaymentCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyInvoicePaymentCustomFieldsParams()
@@ -862,6 +923,9 @@ func (a *Client) ModifyInvoicePaymentCustomFields(ctx context.Context, params *M
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
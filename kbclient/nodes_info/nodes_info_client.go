@@ -52,9 +52,9 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetNodesInfo(ctx context.Context, params *GetNodesInfoParams) (*GetNodesInfoOK, error)
+	GetNodesInfo(ctx context.Context, params *GetNodesInfoParams, opts ...ClientOption) (*GetNodesInfoOK, error)
 
-	TriggerNodeCommand(ctx context.Context, params *TriggerNodeCommandParams) (*TriggerNodeCommandAccepted, error)
+	TriggerNodeCommand(ctx context.Context, params *TriggerNodeCommandParams, opts ...ClientOption) (*TriggerNodeCommandAccepted, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -62,7 +62,7 @@ type ClientService interface {
 /*
   GetNodesInfo retrieves all the nodes infos
 */
-func (a *Client) GetNodesInfo(ctx context.Context, params *GetNodesInfoParams) (*GetNodesInfoOK, error) {
+func (a *Client) GetNodesInfo(ctx context.Context, params *GetNodesInfoParams, opts ...ClientOption) (*GetNodesInfoOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetNodesInfoParams()
@@ -89,6 +89,9 @@ func (a *Client) GetNodesInfo(ctx context.Context, params *GetNodesInfoParams) (
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -108,7 +111,7 @@ func (a *Client) GetNodesInfo(ctx context.Context, params *GetNodesInfoParams) (
 /*
   TriggerNodeCommand triggers a node command
 */
-func (a *Client) TriggerNodeCommand(ctx context.Context, params *TriggerNodeCommandParams) (*TriggerNodeCommandAccepted, error) {
+func (a *Client) TriggerNodeCommand(ctx context.Context, params *TriggerNodeCommandParams, opts ...ClientOption) (*TriggerNodeCommandAccepted, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewTriggerNodeCommandParams()
@@ -147,6 +150,9 @@ func (a *Client) TriggerNodeCommand(ctx context.Context, params *TriggerNodeComm
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -53,13 +53,13 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetOverdueConfigJSON(ctx context.Context, params *GetOverdueConfigJSONParams) (*GetOverdueConfigJSONOK, error)
+	GetOverdueConfigJSON(ctx context.Context, params *GetOverdueConfigJSONParams, opts ...ClientOption) (*GetOverdueConfigJSONOK, error)
 
-	GetOverdueConfigXML(ctx context.Context, params *GetOverdueConfigXMLParams) (*GetOverdueConfigXMLOK, error)
+	GetOverdueConfigXML(ctx context.Context, params *GetOverdueConfigXMLParams, opts ...ClientOption) (*GetOverdueConfigXMLOK, error)
 
-	UploadOverdueConfigJSON(ctx context.Context, params *UploadOverdueConfigJSONParams) (*UploadOverdueConfigJSONCreated, error)
+	UploadOverdueConfigJSON(ctx context.Context, params *UploadOverdueConfigJSONParams, opts ...ClientOption) (*UploadOverdueConfigJSONCreated, error)
 
-	UploadOverdueConfigXML(ctx context.Context, params *UploadOverdueConfigXMLParams) (*UploadOverdueConfigXMLCreated, error)
+	UploadOverdueConfigXML(ctx context.Context, params *UploadOverdueConfigXMLParams, opts ...ClientOption) (*UploadOverdueConfigXMLCreated, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -67,7 +67,7 @@ type ClientService interface {
 /*
   GetOverdueConfigJSON retrieves the overdue config as JSON
 */
-func (a *Client) GetOverdueConfigJSON(ctx context.Context, params *GetOverdueConfigJSONParams) (*GetOverdueConfigJSONOK, error) {
+func (a *Client) GetOverdueConfigJSON(ctx context.Context, params *GetOverdueConfigJSONParams, opts ...ClientOption) (*GetOverdueConfigJSONOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetOverdueConfigJSONParams()
@@ -94,6 +94,9 @@ func (a *Client) GetOverdueConfigJSON(ctx context.Context, params *GetOverdueCon
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -113,7 +116,7 @@ func (a *Client) GetOverdueConfigJSON(ctx context.Context, params *GetOverdueCon
 /*
   GetOverdueConfigXML retrieves the overdue config as XML
 */
-func (a *Client) GetOverdueConfigXML(ctx context.Context, params *GetOverdueConfigXMLParams) (*GetOverdueConfigXMLOK, error) {
+func (a *Client) GetOverdueConfigXML(ctx context.Context, params *GetOverdueConfigXMLParams, opts ...ClientOption) (*GetOverdueConfigXMLOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetOverdueConfigXMLParams()
@@ -140,6 +143,9 @@ func (a *Client) GetOverdueConfigXML(ctx context.Context, params *GetOverdueConf
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -159,7 +165,7 @@ func (a *Client) GetOverdueConfigXML(ctx context.Context, params *GetOverdueConf
 /*
   UploadOverdueConfigJSON uploads the full overdue config as JSON
 */
-func (a *Client) UploadOverdueConfigJSON(ctx context.Context, params *UploadOverdueConfigJSONParams) (*UploadOverdueConfigJSONCreated, error) {
+func (a *Client) UploadOverdueConfigJSON(ctx context.Context, params *UploadOverdueConfigJSONParams, opts ...ClientOption) (*UploadOverdueConfigJSONCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadOverdueConfigJSONParams()
@@ -201,6 +207,9 @@ func (a *Client) UploadOverdueConfigJSON(ctx context.Context, params *UploadOver
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -212,7 +221,7 @@ func (a *Client) UploadOverdueConfigJSON(ctx context.Context, params *UploadOver
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadOverdueConfigJson",
 		Method:             "GET",
 		PathPattern:        location,
@@ -224,7 +233,12 @@ func (a *Client) UploadOverdueConfigJSON(ctx context.Context, params *UploadOver
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +249,7 @@ func (a *Client) UploadOverdueConfigJSON(ctx context.Context, params *UploadOver
 /*
   UploadOverdueConfigXML uploads the full overdue config as XML
 */
-func (a *Client) UploadOverdueConfigXML(ctx context.Context, params *UploadOverdueConfigXMLParams) (*UploadOverdueConfigXMLCreated, error) {
+func (a *Client) UploadOverdueConfigXML(ctx context.Context, params *UploadOverdueConfigXMLParams, opts ...ClientOption) (*UploadOverdueConfigXMLCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadOverdueConfigXMLParams()
@@ -277,6 +291,9 @@ func (a *Client) UploadOverdueConfigXML(ctx context.Context, params *UploadOverd
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -288,7 +305,7 @@ func (a *Client) UploadOverdueConfigXML(ctx context.Context, params *UploadOverd
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadOverdueConfigXml",
 		Method:             "GET",
 		PathPattern:        location,
@@ -300,7 +317,12 @@ func (a *Client) UploadOverdueConfigXML(ctx context.Context, params *UploadOverd
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -53,61 +53,61 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CancelScheduledPaymentTransactionByExternalKey(ctx context.Context, params *CancelScheduledPaymentTransactionByExternalKeyParams) (*CancelScheduledPaymentTransactionByExternalKeyNoContent, error)
+	CancelScheduledPaymentTransactionByExternalKey(ctx context.Context, params *CancelScheduledPaymentTransactionByExternalKeyParams, opts ...ClientOption) (*CancelScheduledPaymentTransactionByExternalKeyNoContent, error)
 
-	CancelScheduledPaymentTransactionByID(ctx context.Context, params *CancelScheduledPaymentTransactionByIDParams) (*CancelScheduledPaymentTransactionByIDNoContent, error)
+	CancelScheduledPaymentTransactionByID(ctx context.Context, params *CancelScheduledPaymentTransactionByIDParams, opts ...ClientOption) (*CancelScheduledPaymentTransactionByIDNoContent, error)
 
-	CaptureAuthorization(ctx context.Context, params *CaptureAuthorizationParams) (*CaptureAuthorizationCreated, error)
+	CaptureAuthorization(ctx context.Context, params *CaptureAuthorizationParams, opts ...ClientOption) (*CaptureAuthorizationCreated, error)
 
-	CaptureAuthorizationByExternalKey(ctx context.Context, params *CaptureAuthorizationByExternalKeyParams) (*CaptureAuthorizationByExternalKeyCreated, error)
+	CaptureAuthorizationByExternalKey(ctx context.Context, params *CaptureAuthorizationByExternalKeyParams, opts ...ClientOption) (*CaptureAuthorizationByExternalKeyCreated, error)
 
-	ChargebackPayment(ctx context.Context, params *ChargebackPaymentParams) (*ChargebackPaymentCreated, error)
+	ChargebackPayment(ctx context.Context, params *ChargebackPaymentParams, opts ...ClientOption) (*ChargebackPaymentCreated, error)
 
-	ChargebackPaymentByExternalKey(ctx context.Context, params *ChargebackPaymentByExternalKeyParams) (*ChargebackPaymentByExternalKeyCreated, error)
+	ChargebackPaymentByExternalKey(ctx context.Context, params *ChargebackPaymentByExternalKeyParams, opts ...ClientOption) (*ChargebackPaymentByExternalKeyCreated, error)
 
-	ChargebackReversalPayment(ctx context.Context, params *ChargebackReversalPaymentParams) (*ChargebackReversalPaymentCreated, error)
+	ChargebackReversalPayment(ctx context.Context, params *ChargebackReversalPaymentParams, opts ...ClientOption) (*ChargebackReversalPaymentCreated, error)
 
-	ChargebackReversalPaymentByExternalKey(ctx context.Context, params *ChargebackReversalPaymentByExternalKeyParams) (*ChargebackReversalPaymentByExternalKeyCreated, error)
+	ChargebackReversalPaymentByExternalKey(ctx context.Context, params *ChargebackReversalPaymentByExternalKeyParams, opts ...ClientOption) (*ChargebackReversalPaymentByExternalKeyCreated, error)
 
-	CompleteTransaction(ctx context.Context, params *CompleteTransactionParams) (*CompleteTransactionNoContent, error)
+	CompleteTransaction(ctx context.Context, params *CompleteTransactionParams, opts ...ClientOption) (*CompleteTransactionNoContent, error)
 
-	CompleteTransactionByExternalKey(ctx context.Context, params *CompleteTransactionByExternalKeyParams) (*CompleteTransactionByExternalKeyNoContent, error)
+	CompleteTransactionByExternalKey(ctx context.Context, params *CompleteTransactionByExternalKeyParams, opts ...ClientOption) (*CompleteTransactionByExternalKeyNoContent, error)
 
-	CreateComboPayment(ctx context.Context, params *CreateComboPaymentParams) (*CreateComboPaymentCreated, error)
+	CreateComboPayment(ctx context.Context, params *CreateComboPaymentParams, opts ...ClientOption) (*CreateComboPaymentCreated, error)
 
-	CreatePaymentCustomFields(ctx context.Context, params *CreatePaymentCustomFieldsParams) (*CreatePaymentCustomFieldsCreated, error)
+	CreatePaymentCustomFields(ctx context.Context, params *CreatePaymentCustomFieldsParams, opts ...ClientOption) (*CreatePaymentCustomFieldsCreated, error)
 
-	CreatePaymentTags(ctx context.Context, params *CreatePaymentTagsParams) (*CreatePaymentTagsCreated, error)
+	CreatePaymentTags(ctx context.Context, params *CreatePaymentTagsParams, opts ...ClientOption) (*CreatePaymentTagsCreated, error)
 
-	DeletePaymentCustomFields(ctx context.Context, params *DeletePaymentCustomFieldsParams) (*DeletePaymentCustomFieldsNoContent, error)
+	DeletePaymentCustomFields(ctx context.Context, params *DeletePaymentCustomFieldsParams, opts ...ClientOption) (*DeletePaymentCustomFieldsNoContent, error)
 
-	DeletePaymentTags(ctx context.Context, params *DeletePaymentTagsParams) (*DeletePaymentTagsNoContent, error)
+	DeletePaymentTags(ctx context.Context, params *DeletePaymentTagsParams, opts ...ClientOption) (*DeletePaymentTagsNoContent, error)
 
-	GetPayment(ctx context.Context, params *GetPaymentParams) (*GetPaymentOK, error)
+	GetPayment(ctx context.Context, params *GetPaymentParams, opts ...ClientOption) (*GetPaymentOK, error)
 
-	GetPaymentAttemptAuditLogsWithHistory(ctx context.Context, params *GetPaymentAttemptAuditLogsWithHistoryParams) (*GetPaymentAttemptAuditLogsWithHistoryOK, error)
+	GetPaymentAttemptAuditLogsWithHistory(ctx context.Context, params *GetPaymentAttemptAuditLogsWithHistoryParams, opts ...ClientOption) (*GetPaymentAttemptAuditLogsWithHistoryOK, error)
 
-	GetPaymentAuditLogsWithHistory(ctx context.Context, params *GetPaymentAuditLogsWithHistoryParams) (*GetPaymentAuditLogsWithHistoryOK, error)
+	GetPaymentAuditLogsWithHistory(ctx context.Context, params *GetPaymentAuditLogsWithHistoryParams, opts ...ClientOption) (*GetPaymentAuditLogsWithHistoryOK, error)
 
-	GetPaymentByExternalKey(ctx context.Context, params *GetPaymentByExternalKeyParams) (*GetPaymentByExternalKeyOK, error)
+	GetPaymentByExternalKey(ctx context.Context, params *GetPaymentByExternalKeyParams, opts ...ClientOption) (*GetPaymentByExternalKeyOK, error)
 
-	GetPaymentCustomFields(ctx context.Context, params *GetPaymentCustomFieldsParams) (*GetPaymentCustomFieldsOK, error)
+	GetPaymentCustomFields(ctx context.Context, params *GetPaymentCustomFieldsParams, opts ...ClientOption) (*GetPaymentCustomFieldsOK, error)
 
-	GetPaymentTags(ctx context.Context, params *GetPaymentTagsParams) (*GetPaymentTagsOK, error)
+	GetPaymentTags(ctx context.Context, params *GetPaymentTagsParams, opts ...ClientOption) (*GetPaymentTagsOK, error)
 
-	GetPayments(ctx context.Context, params *GetPaymentsParams) (*GetPaymentsOK, error)
+	GetPayments(ctx context.Context, params *GetPaymentsParams, opts ...ClientOption) (*GetPaymentsOK, error)
 
-	ModifyPaymentCustomFields(ctx context.Context, params *ModifyPaymentCustomFieldsParams) (*ModifyPaymentCustomFieldsNoContent, error)
+	ModifyPaymentCustomFields(ctx context.Context, params *ModifyPaymentCustomFieldsParams, opts ...ClientOption) (*ModifyPaymentCustomFieldsNoContent, error)
 
-	RefundPayment(ctx context.Context, params *RefundPaymentParams) (*RefundPaymentCreated, error)
+	RefundPayment(ctx context.Context, params *RefundPaymentParams, opts ...ClientOption) (*RefundPaymentCreated, error)
 
-	RefundPaymentByExternalKey(ctx context.Context, params *RefundPaymentByExternalKeyParams) (*RefundPaymentByExternalKeyCreated, error)
+	RefundPaymentByExternalKey(ctx context.Context, params *RefundPaymentByExternalKeyParams, opts ...ClientOption) (*RefundPaymentByExternalKeyCreated, error)
 
-	SearchPayments(ctx context.Context, params *SearchPaymentsParams) (*SearchPaymentsOK, error)
+	SearchPayments(ctx context.Context, params *SearchPaymentsParams, opts ...ClientOption) (*SearchPaymentsOK, error)
 
-	VoidPayment(ctx context.Context, params *VoidPaymentParams) (*VoidPaymentNoContent, error)
+	VoidPayment(ctx context.Context, params *VoidPaymentParams, opts ...ClientOption) (*VoidPaymentNoContent, error)
 
-	VoidPaymentByExternalKey(ctx context.Context, params *VoidPaymentByExternalKeyParams) (*VoidPaymentByExternalKeyNoContent, error)
+	VoidPaymentByExternalKey(ctx context.Context, params *VoidPaymentByExternalKeyParams, opts ...ClientOption) (*VoidPaymentByExternalKeyNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -115,7 +115,7 @@ type ClientService interface {
 /*
   CancelScheduledPaymentTransactionByExternalKey cancels a scheduled payment attempt retry
 */
-func (a *Client) CancelScheduledPaymentTransactionByExternalKey(ctx context.Context, params *CancelScheduledPaymentTransactionByExternalKeyParams) (*CancelScheduledPaymentTransactionByExternalKeyNoContent, error) {
+func (a *Client) CancelScheduledPaymentTransactionByExternalKey(ctx context.Context, params *CancelScheduledPaymentTransactionByExternalKeyParams, opts ...ClientOption) (*CancelScheduledPaymentTransactionByExternalKeyNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCancelScheduledPaymentTransactionByExternalKeyParams()
@@ -154,6 +154,9 @@ func (a *Client) CancelScheduledPaymentTransactionByExternalKey(ctx context.Cont
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -173,7 +176,7 @@ func (a *Client) CancelScheduledPaymentTransactionByExternalKey(ctx context.Cont
 /*
   CancelScheduledPaymentTransactionByID cancels a scheduled payment attempt retry
 */
-func (a *Client) CancelScheduledPaymentTransactionByID(ctx context.Context, params *CancelScheduledPaymentTransactionByIDParams) (*CancelScheduledPaymentTransactionByIDNoContent, error) {
+func (a *Client) CancelScheduledPaymentTransactionByID(ctx context.Context, params *CancelScheduledPaymentTransactionByIDParams, opts ...ClientOption) (*CancelScheduledPaymentTransactionByIDNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCancelScheduledPaymentTransactionByIDParams()
@@ -212,6 +215,9 @@ func (a *Client) CancelScheduledPaymentTransactionByID(ctx context.Context, para
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -231,7 +237,7 @@ func (a *Client) CancelScheduledPaymentTransactionByID(ctx context.Context, para
 /*
   CaptureAuthorization captures an existing authorization
 */
-func (a *Client) CaptureAuthorization(ctx context.Context, params *CaptureAuthorizationParams) (*CaptureAuthorizationCreated, error) {
+func (a *Client) CaptureAuthorization(ctx context.Context, params *CaptureAuthorizationParams, opts ...ClientOption) (*CaptureAuthorizationCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCaptureAuthorizationParams()
@@ -273,6 +279,9 @@ func (a *Client) CaptureAuthorization(ctx context.Context, params *CaptureAuthor
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -284,7 +293,7 @@ func (a *Client) CaptureAuthorization(ctx context.Context, params *CaptureAuthor
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "captureAuthorization",
 		Method:             "GET",
 		PathPattern:        location,
@@ -296,7 +305,12 @@ func (a *Client) CaptureAuthorization(ctx context.Context, params *CaptureAuthor
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +321,7 @@ func (a *Client) CaptureAuthorization(ctx context.Context, params *CaptureAuthor
 /*
   CaptureAuthorizationByExternalKey captures an existing authorization
 */
-func (a *Client) CaptureAuthorizationByExternalKey(ctx context.Context, params *CaptureAuthorizationByExternalKeyParams) (*CaptureAuthorizationByExternalKeyCreated, error) {
+func (a *Client) CaptureAuthorizationByExternalKey(ctx context.Context, params *CaptureAuthorizationByExternalKeyParams, opts ...ClientOption) (*CaptureAuthorizationByExternalKeyCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCaptureAuthorizationByExternalKeyParams()
@@ -349,6 +363,9 @@ func (a *Client) CaptureAuthorizationByExternalKey(ctx context.Context, params *
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -360,7 +377,7 @@ func (a *Client) CaptureAuthorizationByExternalKey(ctx context.Context, params *
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "captureAuthorizationByExternalKey",
 		Method:             "GET",
 		PathPattern:        location,
@@ -372,7 +389,12 @@ func (a *Client) CaptureAuthorizationByExternalKey(ctx context.Context, params *
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -383,7 +405,7 @@ func (a *Client) CaptureAuthorizationByExternalKey(ctx context.Context, params *
 /*
   ChargebackPayment records a chargeback
 */
-func (a *Client) ChargebackPayment(ctx context.Context, params *ChargebackPaymentParams) (*ChargebackPaymentCreated, error) {
+func (a *Client) ChargebackPayment(ctx context.Context, params *ChargebackPaymentParams, opts ...ClientOption) (*ChargebackPaymentCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewChargebackPaymentParams()
@@ -425,6 +447,9 @@ func (a *Client) ChargebackPayment(ctx context.Context, params *ChargebackPaymen
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -436,7 +461,7 @@ func (a *Client) ChargebackPayment(ctx context.Context, params *ChargebackPaymen
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "chargebackPayment",
 		Method:             "GET",
 		PathPattern:        location,
@@ -448,7 +473,12 @@ func (a *Client) ChargebackPayment(ctx context.Context, params *ChargebackPaymen
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -459,7 +489,7 @@ func (a *Client) ChargebackPayment(ctx context.Context, params *ChargebackPaymen
 /*
   ChargebackPaymentByExternalKey records a chargeback
 */
-func (a *Client) ChargebackPaymentByExternalKey(ctx context.Context, params *ChargebackPaymentByExternalKeyParams) (*ChargebackPaymentByExternalKeyCreated, error) {
+func (a *Client) ChargebackPaymentByExternalKey(ctx context.Context, params *ChargebackPaymentByExternalKeyParams, opts ...ClientOption) (*ChargebackPaymentByExternalKeyCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewChargebackPaymentByExternalKeyParams()
@@ -501,6 +531,9 @@ func (a *Client) ChargebackPaymentByExternalKey(ctx context.Context, params *Cha
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -512,7 +545,7 @@ func (a *Client) ChargebackPaymentByExternalKey(ctx context.Context, params *Cha
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "chargebackPaymentByExternalKey",
 		Method:             "GET",
 		PathPattern:        location,
@@ -524,7 +557,12 @@ func (a *Client) ChargebackPaymentByExternalKey(ctx context.Context, params *Cha
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -535,7 +573,7 @@ func (a *Client) ChargebackPaymentByExternalKey(ctx context.Context, params *Cha
 /*
   ChargebackReversalPayment records a chargeback reversal
 */
-func (a *Client) ChargebackReversalPayment(ctx context.Context, params *ChargebackReversalPaymentParams) (*ChargebackReversalPaymentCreated, error) {
+func (a *Client) ChargebackReversalPayment(ctx context.Context, params *ChargebackReversalPaymentParams, opts ...ClientOption) (*ChargebackReversalPaymentCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewChargebackReversalPaymentParams()
@@ -577,6 +615,9 @@ func (a *Client) ChargebackReversalPayment(ctx context.Context, params *Chargeba
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -588,7 +629,7 @@ func (a *Client) ChargebackReversalPayment(ctx context.Context, params *Chargeba
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "chargebackReversalPayment",
 		Method:             "GET",
 		PathPattern:        location,
@@ -600,7 +641,12 @@ func (a *Client) ChargebackReversalPayment(ctx context.Context, params *Chargeba
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -611,7 +657,7 @@ func (a *Client) ChargebackReversalPayment(ctx context.Context, params *Chargeba
 /*
   ChargebackReversalPaymentByExternalKey records a chargeback reversal
 */
-func (a *Client) ChargebackReversalPaymentByExternalKey(ctx context.Context, params *ChargebackReversalPaymentByExternalKeyParams) (*ChargebackReversalPaymentByExternalKeyCreated, error) {
+func (a *Client) ChargebackReversalPaymentByExternalKey(ctx context.Context, params *ChargebackReversalPaymentByExternalKeyParams, opts ...ClientOption) (*ChargebackReversalPaymentByExternalKeyCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewChargebackReversalPaymentByExternalKeyParams()
@@ -653,6 +699,9 @@ func (a *Client) ChargebackReversalPaymentByExternalKey(ctx context.Context, par
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -664,7 +713,7 @@ func (a *Client) ChargebackReversalPaymentByExternalKey(ctx context.Context, par
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "chargebackReversalPaymentByExternalKey",
 		Method:             "GET",
 		PathPattern:        location,
@@ -676,7 +725,12 @@ func (a *Client) ChargebackReversalPaymentByExternalKey(ctx context.Context, par
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -687,7 +741,7 @@ func (a *Client) ChargebackReversalPaymentByExternalKey(ctx context.Context, par
 /*
   CompleteTransaction completes an existing transaction
 */
-func (a *Client) CompleteTransaction(ctx context.Context, params *CompleteTransactionParams) (*CompleteTransactionNoContent, error) {
+func (a *Client) CompleteTransaction(ctx context.Context, params *CompleteTransactionParams, opts ...ClientOption) (*CompleteTransactionNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCompleteTransactionParams()
@@ -726,6 +780,9 @@ func (a *Client) CompleteTransaction(ctx context.Context, params *CompleteTransa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -745,7 +802,7 @@ func (a *Client) CompleteTransaction(ctx context.Context, params *CompleteTransa
 /*
   CompleteTransactionByExternalKey completes an existing transaction
 */
-func (a *Client) CompleteTransactionByExternalKey(ctx context.Context, params *CompleteTransactionByExternalKeyParams) (*CompleteTransactionByExternalKeyNoContent, error) {
+func (a *Client) CompleteTransactionByExternalKey(ctx context.Context, params *CompleteTransactionByExternalKeyParams, opts ...ClientOption) (*CompleteTransactionByExternalKeyNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCompleteTransactionByExternalKeyParams()
@@ -784,6 +841,9 @@ func (a *Client) CompleteTransactionByExternalKey(ctx context.Context, params *C
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -803,7 +863,7 @@ func (a *Client) CompleteTransactionByExternalKey(ctx context.Context, params *C
 /*
   CreateComboPayment combos api to create a new payment transaction on a existing or not account
 */
-func (a *Client) CreateComboPayment(ctx context.Context, params *CreateComboPaymentParams) (*CreateComboPaymentCreated, error) {
+func (a *Client) CreateComboPayment(ctx context.Context, params *CreateComboPaymentParams, opts ...ClientOption) (*CreateComboPaymentCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateComboPaymentParams()
@@ -845,6 +905,9 @@ func (a *Client) CreateComboPayment(ctx context.Context, params *CreateComboPaym
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -856,7 +919,7 @@ func (a *Client) CreateComboPayment(ctx context.Context, params *CreateComboPaym
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createComboPayment",
 		Method:             "GET",
 		PathPattern:        location,
@@ -868,7 +931,12 @@ func (a *Client) CreateComboPayment(ctx context.Context, params *CreateComboPaym
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -879,7 +947,7 @@ func (a *Client) CreateComboPayment(ctx context.Context, params *CreateComboPaym
 /*
   CreatePaymentCustomFields adds custom fields to payment
 */
-func (a *Client) CreatePaymentCustomFields(ctx context.Context, params *CreatePaymentCustomFieldsParams) (*CreatePaymentCustomFieldsCreated, error) {
+func (a *Client) CreatePaymentCustomFields(ctx context.Context, params *CreatePaymentCustomFieldsParams, opts ...ClientOption) (*CreatePaymentCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreatePaymentCustomFieldsParams()
@@ -921,6 +989,9 @@ func (a *Client) CreatePaymentCustomFields(ctx context.Context, params *CreatePa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -932,7 +1003,7 @@ func (a *Client) CreatePaymentCustomFields(ctx context.Context, params *CreatePa
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createPaymentCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -944,7 +1015,12 @@ func (a *Client) CreatePaymentCustomFields(ctx context.Context, params *CreatePa
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -955,7 +1031,7 @@ func (a *Client) CreatePaymentCustomFields(ctx context.Context, params *CreatePa
 /*
   CreatePaymentTags adds tags to payment payment
 */
-func (a *Client) CreatePaymentTags(ctx context.Context, params *CreatePaymentTagsParams) (*CreatePaymentTagsCreated, error) {
+func (a *Client) CreatePaymentTags(ctx context.Context, params *CreatePaymentTagsParams, opts ...ClientOption) (*CreatePaymentTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreatePaymentTagsParams()
@@ -997,6 +1073,9 @@ func (a *Client) CreatePaymentTags(ctx context.Context, params *CreatePaymentTag
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1008,7 +1087,7 @@ func (a *Client) CreatePaymentTags(ctx context.Context, params *CreatePaymentTag
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createPaymentTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1020,7 +1099,12 @@ func (a *Client) CreatePaymentTags(ctx context.Context, params *CreatePaymentTag
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -1031,7 +1115,7 @@ func (a *Client) CreatePaymentTags(ctx context.Context, params *CreatePaymentTag
 /*
   DeletePaymentCustomFields removes custom fields from payment payment
 */
-func (a *Client) DeletePaymentCustomFields(ctx context.Context, params *DeletePaymentCustomFieldsParams) (*DeletePaymentCustomFieldsNoContent, error) {
+func (a *Client) DeletePaymentCustomFields(ctx context.Context, params *DeletePaymentCustomFieldsParams, opts ...ClientOption) (*DeletePaymentCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePaymentCustomFieldsParams()
@@ -1070,6 +1154,9 @@ func (a *Client) DeletePaymentCustomFields(ctx context.Context, params *DeletePa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1089,7 +1176,7 @@ func (a *Client) DeletePaymentCustomFields(ctx context.Context, params *DeletePa
 /*
   DeletePaymentTags removes tags from payment payment
 */
-func (a *Client) DeletePaymentTags(ctx context.Context, params *DeletePaymentTagsParams) (*DeletePaymentTagsNoContent, error) {
+func (a *Client) DeletePaymentTags(ctx context.Context, params *DeletePaymentTagsParams, opts ...ClientOption) (*DeletePaymentTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePaymentTagsParams()
@@ -1128,6 +1215,9 @@ func (a *Client) DeletePaymentTags(ctx context.Context, params *DeletePaymentTag
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1147,7 +1237,7 @@ func (a *Client) DeletePaymentTags(ctx context.Context, params *DeletePaymentTag
 /*
   GetPayment retrieves a payment by id
 */
-func (a *Client) GetPayment(ctx context.Context, params *GetPaymentParams) (*GetPaymentOK, error) {
+func (a *Client) GetPayment(ctx context.Context, params *GetPaymentParams, opts ...ClientOption) (*GetPaymentOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentParams()
@@ -1174,6 +1264,9 @@ func (a *Client) GetPayment(ctx context.Context, params *GetPaymentParams) (*Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1193,7 +1286,7 @@ func (a *Client) GetPayment(ctx context.Context, params *GetPaymentParams) (*Get
 /*
   GetPaymentAttemptAuditLogsWithHistory retrieves payment attempt audit logs with history by id
 */
-func (a *Client) GetPaymentAttemptAuditLogsWithHistory(ctx context.Context, params *GetPaymentAttemptAuditLogsWithHistoryParams) (*GetPaymentAttemptAuditLogsWithHistoryOK, error) {
+func (a *Client) GetPaymentAttemptAuditLogsWithHistory(ctx context.Context, params *GetPaymentAttemptAuditLogsWithHistoryParams, opts ...ClientOption) (*GetPaymentAttemptAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentAttemptAuditLogsWithHistoryParams()
@@ -1220,6 +1313,9 @@ func (a *Client) GetPaymentAttemptAuditLogsWithHistory(ctx context.Context, para
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1239,7 +1335,7 @@ func (a *Client) GetPaymentAttemptAuditLogsWithHistory(ctx context.Context, para
 /*
   GetPaymentAuditLogsWithHistory retrieves payment audit logs with history by id
 */
-func (a *Client) GetPaymentAuditLogsWithHistory(ctx context.Context, params *GetPaymentAuditLogsWithHistoryParams) (*GetPaymentAuditLogsWithHistoryOK, error) {
+func (a *Client) GetPaymentAuditLogsWithHistory(ctx context.Context, params *GetPaymentAuditLogsWithHistoryParams, opts ...ClientOption) (*GetPaymentAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentAuditLogsWithHistoryParams()
@@ -1266,6 +1362,9 @@ func (a *Client) GetPaymentAuditLogsWithHistory(ctx context.Context, params *Get
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1285,7 +1384,7 @@ func (a *Client) GetPaymentAuditLogsWithHistory(ctx context.Context, params *Get
 /*
   GetPaymentByExternalKey retrieves a payment by external key
 */
-func (a *Client) GetPaymentByExternalKey(ctx context.Context, params *GetPaymentByExternalKeyParams) (*GetPaymentByExternalKeyOK, error) {
+func (a *Client) GetPaymentByExternalKey(ctx context.Context, params *GetPaymentByExternalKeyParams, opts ...ClientOption) (*GetPaymentByExternalKeyOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentByExternalKeyParams()
@@ -1312,6 +1411,9 @@ func (a *Client) GetPaymentByExternalKey(ctx context.Context, params *GetPayment
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1331,7 +1433,7 @@ func (a *Client) GetPaymentByExternalKey(ctx context.Context, params *GetPayment
 /*
   GetPaymentCustomFields retrieves payment custom fields
 */
-func (a *Client) GetPaymentCustomFields(ctx context.Context, params *GetPaymentCustomFieldsParams) (*GetPaymentCustomFieldsOK, error) {
+func (a *Client) GetPaymentCustomFields(ctx context.Context, params *GetPaymentCustomFieldsParams, opts ...ClientOption) (*GetPaymentCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentCustomFieldsParams()
@@ -1358,6 +1460,9 @@ func (a *Client) GetPaymentCustomFields(ctx context.Context, params *GetPaymentC
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1377,7 +1482,7 @@ func (a *Client) GetPaymentCustomFields(ctx context.Context, params *GetPaymentC
 /*
   GetPaymentTags retrieves payment payment tags
 */
-func (a *Client) GetPaymentTags(ctx context.Context, params *GetPaymentTagsParams) (*GetPaymentTagsOK, error) {
+func (a *Client) GetPaymentTags(ctx context.Context, params *GetPaymentTagsParams, opts ...ClientOption) (*GetPaymentTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentTagsParams()
@@ -1404,6 +1509,9 @@ func (a *Client) GetPaymentTags(ctx context.Context, params *GetPaymentTagsParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1423,7 +1531,7 @@ func (a *Client) GetPaymentTags(ctx context.Context, params *GetPaymentTagsParam
 /*
   GetPayments gets payments
 */
-func (a *Client) GetPayments(ctx context.Context, params *GetPaymentsParams) (*GetPaymentsOK, error) {
+func (a *Client) GetPayments(ctx context.Context, params *GetPaymentsParams, opts ...ClientOption) (*GetPaymentsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentsParams()
@@ -1450,6 +1558,9 @@ func (a *Client) GetPayments(ctx context.Context, params *GetPaymentsParams) (*G
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1469,7 +1580,7 @@ func (a *Client) GetPayments(ctx context.Context, params *GetPaymentsParams) (*G
 /*
   ModifyPaymentCustomFields modifies custom fields to payment
 */
-func (a *Client) ModifyPaymentCustomFields(ctx context.Context, params *ModifyPaymentCustomFieldsParams) (*ModifyPaymentCustomFieldsNoContent, error) {
+func (a *Client) ModifyPaymentCustomFields(ctx context.Context, params *ModifyPaymentCustomFieldsParams, opts ...ClientOption) (*ModifyPaymentCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyPaymentCustomFieldsParams()
@@ -1508,6 +1619,9 @@ func (a *Client) ModifyPaymentCustomFields(ctx context.Context, params *ModifyPa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1527,7 +1641,7 @@ func (a *Client) ModifyPaymentCustomFields(ctx context.Context, params *ModifyPa
 /*
   RefundPayment refunds an existing payment
 */
-func (a *Client) RefundPayment(ctx context.Context, params *RefundPaymentParams) (*RefundPaymentCreated, error) {
+func (a *Client) RefundPayment(ctx context.Context, params *RefundPaymentParams, opts ...ClientOption) (*RefundPaymentCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRefundPaymentParams()
@@ -1569,6 +1683,9 @@ func (a *Client) RefundPayment(ctx context.Context, params *RefundPaymentParams)
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1580,7 +1697,7 @@ func (a *Client) RefundPayment(ctx context.Context, params *RefundPaymentParams)
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "refundPayment",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1592,7 +1709,12 @@ func (a *Client) RefundPayment(ctx context.Context, params *RefundPaymentParams)
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -1603,7 +1725,7 @@ func (a *Client) RefundPayment(ctx context.Context, params *RefundPaymentParams)
 /*
   RefundPaymentByExternalKey refunds an existing payment
 */
-func (a *Client) RefundPaymentByExternalKey(ctx context.Context, params *RefundPaymentByExternalKeyParams) (*RefundPaymentByExternalKeyCreated, error) {
+func (a *Client) RefundPaymentByExternalKey(ctx context.Context, params *RefundPaymentByExternalKeyParams, opts ...ClientOption) (*RefundPaymentByExternalKeyCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRefundPaymentByExternalKeyParams()
@@ -1645,6 +1767,9 @@ func (a *Client) RefundPaymentByExternalKey(ctx context.Context, params *RefundP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1656,7 +1781,7 @@ func (a *Client) RefundPaymentByExternalKey(ctx context.Context, params *RefundP
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "refundPaymentByExternalKey",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1668,7 +1793,12 @@ func (a *Client) RefundPaymentByExternalKey(ctx context.Context, params *RefundP
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -1679,7 +1809,7 @@ func (a *Client) RefundPaymentByExternalKey(ctx context.Context, params *RefundP
 /*
   SearchPayments searches payments
 */
-func (a *Client) SearchPayments(ctx context.Context, params *SearchPaymentsParams) (*SearchPaymentsOK, error) {
+func (a *Client) SearchPayments(ctx context.Context, params *SearchPaymentsParams, opts ...ClientOption) (*SearchPaymentsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchPaymentsParams()
@@ -1706,6 +1836,9 @@ func (a *Client) SearchPayments(ctx context.Context, params *SearchPaymentsParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1725,7 +1858,7 @@ func (a *Client) SearchPayments(ctx context.Context, params *SearchPaymentsParam
 /*
   VoidPayment voids an existing payment
 */
-func (a *Client) VoidPayment(ctx context.Context, params *VoidPaymentParams) (*VoidPaymentNoContent, error) {
+func (a *Client) VoidPayment(ctx context.Context, params *VoidPaymentParams, opts ...ClientOption) (*VoidPaymentNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewVoidPaymentParams()
@@ -1764,6 +1897,9 @@ func (a *Client) VoidPayment(ctx context.Context, params *VoidPaymentParams) (*V
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1783,7 +1919,7 @@ func (a *Client) VoidPayment(ctx context.Context, params *VoidPaymentParams) (*V
 /*
   VoidPaymentByExternalKey voids an existing payment
 */
-func (a *Client) VoidPaymentByExternalKey(ctx context.Context, params *VoidPaymentByExternalKeyParams) (*VoidPaymentByExternalKeyNoContent, error) {
+func (a *Client) VoidPaymentByExternalKey(ctx context.Context, params *VoidPaymentByExternalKeyParams, opts ...ClientOption) (*VoidPaymentByExternalKeyNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewVoidPaymentByExternalKeyParams()
@@ -1822,6 +1958,9 @@ func (a *Client) VoidPaymentByExternalKey(ctx context.Context, params *VoidPayme
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -52,11 +52,11 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	BuildComboFormDescriptor(ctx context.Context, params *BuildComboFormDescriptorParams) (*BuildComboFormDescriptorOK, error)
+	BuildComboFormDescriptor(ctx context.Context, params *BuildComboFormDescriptorParams, opts ...ClientOption) (*BuildComboFormDescriptorOK, error)
 
-	BuildFormDescriptor(ctx context.Context, params *BuildFormDescriptorParams) (*BuildFormDescriptorOK, error)
+	BuildFormDescriptor(ctx context.Context, params *BuildFormDescriptorParams, opts ...ClientOption) (*BuildFormDescriptorOK, error)
 
-	ProcessNotification(ctx context.Context, params *ProcessNotificationParams) (*ProcessNotificationOK, error)
+	ProcessNotification(ctx context.Context, params *ProcessNotificationParams, opts ...ClientOption) (*ProcessNotificationOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -64,7 +64,7 @@ type ClientService interface {
 /*
   BuildComboFormDescriptor combos API to generate form data to redirect the customer to the gateway
 */
-func (a *Client) BuildComboFormDescriptor(ctx context.Context, params *BuildComboFormDescriptorParams) (*BuildComboFormDescriptorOK, error) {
+func (a *Client) BuildComboFormDescriptor(ctx context.Context, params *BuildComboFormDescriptorParams, opts ...ClientOption) (*BuildComboFormDescriptorOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewBuildComboFormDescriptorParams()
@@ -103,6 +103,9 @@ func (a *Client) BuildComboFormDescriptor(ctx context.Context, params *BuildComb
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -122,7 +125,7 @@ func (a *Client) BuildComboFormDescriptor(ctx context.Context, params *BuildComb
 /*
   BuildFormDescriptor generates form data to redirect the customer to the gateway
 */
-func (a *Client) BuildFormDescriptor(ctx context.Context, params *BuildFormDescriptorParams) (*BuildFormDescriptorOK, error) {
+func (a *Client) BuildFormDescriptor(ctx context.Context, params *BuildFormDescriptorParams, opts ...ClientOption) (*BuildFormDescriptorOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewBuildFormDescriptorParams()
@@ -161,6 +164,9 @@ func (a *Client) BuildFormDescriptor(ctx context.Context, params *BuildFormDescr
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -182,7 +188,7 @@ func (a *Client) BuildFormDescriptor(ctx context.Context, params *BuildFormDescr
 
   The response is built by the appropriate plugin
 */
-func (a *Client) ProcessNotification(ctx context.Context, params *ProcessNotificationParams) (*ProcessNotificationOK, error) {
+func (a *Client) ProcessNotification(ctx context.Context, params *ProcessNotificationParams, opts ...ClientOption) (*ProcessNotificationOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewProcessNotificationParams()
@@ -221,6 +227,9 @@ func (a *Client) ProcessNotification(ctx context.Context, params *ProcessNotific
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -53,25 +53,25 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreatePaymentMethodCustomFields(ctx context.Context, params *CreatePaymentMethodCustomFieldsParams) (*CreatePaymentMethodCustomFieldsCreated, error)
+	CreatePaymentMethodCustomFields(ctx context.Context, params *CreatePaymentMethodCustomFieldsParams, opts ...ClientOption) (*CreatePaymentMethodCustomFieldsCreated, error)
 
-	DeletePaymentMethod(ctx context.Context, params *DeletePaymentMethodParams) (*DeletePaymentMethodNoContent, error)
+	DeletePaymentMethod(ctx context.Context, params *DeletePaymentMethodParams, opts ...ClientOption) (*DeletePaymentMethodNoContent, error)
 
-	DeletePaymentMethodCustomFields(ctx context.Context, params *DeletePaymentMethodCustomFieldsParams) (*DeletePaymentMethodCustomFieldsNoContent, error)
+	DeletePaymentMethodCustomFields(ctx context.Context, params *DeletePaymentMethodCustomFieldsParams, opts ...ClientOption) (*DeletePaymentMethodCustomFieldsNoContent, error)
 
-	GetPaymentMethod(ctx context.Context, params *GetPaymentMethodParams) (*GetPaymentMethodOK, error)
+	GetPaymentMethod(ctx context.Context, params *GetPaymentMethodParams, opts ...ClientOption) (*GetPaymentMethodOK, error)
 
-	GetPaymentMethodAuditLogsWithHistory(ctx context.Context, params *GetPaymentMethodAuditLogsWithHistoryParams) (*GetPaymentMethodAuditLogsWithHistoryOK, error)
+	GetPaymentMethodAuditLogsWithHistory(ctx context.Context, params *GetPaymentMethodAuditLogsWithHistoryParams, opts ...ClientOption) (*GetPaymentMethodAuditLogsWithHistoryOK, error)
 
-	GetPaymentMethodByKey(ctx context.Context, params *GetPaymentMethodByKeyParams) (*GetPaymentMethodByKeyOK, error)
+	GetPaymentMethodByKey(ctx context.Context, params *GetPaymentMethodByKeyParams, opts ...ClientOption) (*GetPaymentMethodByKeyOK, error)
 
-	GetPaymentMethodCustomFields(ctx context.Context, params *GetPaymentMethodCustomFieldsParams) (*GetPaymentMethodCustomFieldsOK, error)
+	GetPaymentMethodCustomFields(ctx context.Context, params *GetPaymentMethodCustomFieldsParams, opts ...ClientOption) (*GetPaymentMethodCustomFieldsOK, error)
 
-	GetPaymentMethods(ctx context.Context, params *GetPaymentMethodsParams) (*GetPaymentMethodsOK, error)
+	GetPaymentMethods(ctx context.Context, params *GetPaymentMethodsParams, opts ...ClientOption) (*GetPaymentMethodsOK, error)
 
-	ModifyPaymentMethodCustomFields(ctx context.Context, params *ModifyPaymentMethodCustomFieldsParams) (*ModifyPaymentMethodCustomFieldsNoContent, error)
+	ModifyPaymentMethodCustomFields(ctx context.Context, params *ModifyPaymentMethodCustomFieldsParams, opts ...ClientOption) (*ModifyPaymentMethodCustomFieldsNoContent, error)
 
-	SearchPaymentMethods(ctx context.Context, params *SearchPaymentMethodsParams) (*SearchPaymentMethodsOK, error)
+	SearchPaymentMethods(ctx context.Context, params *SearchPaymentMethodsParams, opts ...ClientOption) (*SearchPaymentMethodsOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -79,7 +79,7 @@ type ClientService interface {
 /*
   CreatePaymentMethodCustomFields adds custom fields to payment method
 */
-func (a *Client) CreatePaymentMethodCustomFields(ctx context.Context, params *CreatePaymentMethodCustomFieldsParams) (*CreatePaymentMethodCustomFieldsCreated, error) {
+func (a *Client) CreatePaymentMethodCustomFields(ctx context.Context, params *CreatePaymentMethodCustomFieldsParams, opts ...ClientOption) (*CreatePaymentMethodCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreatePaymentMethodCustomFieldsParams()
@@ -121,6 +121,9 @@ func (a *Client) CreatePaymentMethodCustomFields(ctx context.Context, params *Cr
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -132,7 +135,7 @@ func (a *Client) CreatePaymentMethodCustomFields(ctx context.Context, params *Cr
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createPaymentMethodCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -144,7 +147,12 @@ func (a *Client) CreatePaymentMethodCustomFields(ctx context.Context, params *Cr
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +163,7 @@ func (a *Client) CreatePaymentMethodCustomFields(ctx context.Context, params *Cr
 /*
   DeletePaymentMethod deletes a payment method
 */
-func (a *Client) DeletePaymentMethod(ctx context.Context, params *DeletePaymentMethodParams) (*DeletePaymentMethodNoContent, error) {
+func (a *Client) DeletePaymentMethod(ctx context.Context, params *DeletePaymentMethodParams, opts ...ClientOption) (*DeletePaymentMethodNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePaymentMethodParams()
@@ -194,6 +202,9 @@ func (a *Client) DeletePaymentMethod(ctx context.Context, params *DeletePaymentM
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -213,7 +224,7 @@ func (a *Client) DeletePaymentMethod(ctx context.Context, params *DeletePaymentM
 /*
   DeletePaymentMethodCustomFields removes custom fields from payment method
 */
-func (a *Client) DeletePaymentMethodCustomFields(ctx context.Context, params *DeletePaymentMethodCustomFieldsParams) (*DeletePaymentMethodCustomFieldsNoContent, error) {
+func (a *Client) DeletePaymentMethodCustomFields(ctx context.Context, params *DeletePaymentMethodCustomFieldsParams, opts ...ClientOption) (*DeletePaymentMethodCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePaymentMethodCustomFieldsParams()
@@ -252,6 +263,9 @@ func (a *Client) DeletePaymentMethodCustomFields(ctx context.Context, params *De
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -271,7 +285,7 @@ func (a *Client) DeletePaymentMethodCustomFields(ctx context.Context, params *De
 /*
   GetPaymentMethod retrieves a payment method by id
 */
-func (a *Client) GetPaymentMethod(ctx context.Context, params *GetPaymentMethodParams) (*GetPaymentMethodOK, error) {
+func (a *Client) GetPaymentMethod(ctx context.Context, params *GetPaymentMethodParams, opts ...ClientOption) (*GetPaymentMethodOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentMethodParams()
@@ -298,6 +312,9 @@ func (a *Client) GetPaymentMethod(ctx context.Context, params *GetPaymentMethodP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -317,7 +334,7 @@ func (a *Client) GetPaymentMethod(ctx context.Context, params *GetPaymentMethodP
 /*
   GetPaymentMethodAuditLogsWithHistory retrieves payment method audit logs with history by id
 */
-func (a *Client) GetPaymentMethodAuditLogsWithHistory(ctx context.Context, params *GetPaymentMethodAuditLogsWithHistoryParams) (*GetPaymentMethodAuditLogsWithHistoryOK, error) {
+func (a *Client) GetPaymentMethodAuditLogsWithHistory(ctx context.Context, params *GetPaymentMethodAuditLogsWithHistoryParams, opts ...ClientOption) (*GetPaymentMethodAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentMethodAuditLogsWithHistoryParams()
@@ -344,6 +361,9 @@ func (a *Client) GetPaymentMethodAuditLogsWithHistory(ctx context.Context, param
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -363,7 +383,7 @@ func (a *Client) GetPaymentMethodAuditLogsWithHistory(ctx context.Context, param
 /*
   GetPaymentMethodByKey retrieves a payment method by external key
 */
-func (a *Client) GetPaymentMethodByKey(ctx context.Context, params *GetPaymentMethodByKeyParams) (*GetPaymentMethodByKeyOK, error) {
+func (a *Client) GetPaymentMethodByKey(ctx context.Context, params *GetPaymentMethodByKeyParams, opts ...ClientOption) (*GetPaymentMethodByKeyOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentMethodByKeyParams()
@@ -390,6 +410,9 @@ func (a *Client) GetPaymentMethodByKey(ctx context.Context, params *GetPaymentMe
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -409,7 +432,7 @@ func (a *Client) GetPaymentMethodByKey(ctx context.Context, params *GetPaymentMe
 /*
   GetPaymentMethodCustomFields retrieves payment method custom fields
 */
-func (a *Client) GetPaymentMethodCustomFields(ctx context.Context, params *GetPaymentMethodCustomFieldsParams) (*GetPaymentMethodCustomFieldsOK, error) {
+func (a *Client) GetPaymentMethodCustomFields(ctx context.Context, params *GetPaymentMethodCustomFieldsParams, opts ...ClientOption) (*GetPaymentMethodCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentMethodCustomFieldsParams()
@@ -436,6 +459,9 @@ func (a *Client) GetPaymentMethodCustomFields(ctx context.Context, params *GetPa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -455,7 +481,7 @@ func (a *Client) GetPaymentMethodCustomFields(ctx context.Context, params *GetPa
 /*
   GetPaymentMethods lists payment methods
 */
-func (a *Client) GetPaymentMethods(ctx context.Context, params *GetPaymentMethodsParams) (*GetPaymentMethodsOK, error) {
+func (a *Client) GetPaymentMethods(ctx context.Context, params *GetPaymentMethodsParams, opts ...ClientOption) (*GetPaymentMethodsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentMethodsParams()
@@ -482,6 +508,9 @@ func (a *Client) GetPaymentMethods(ctx context.Context, params *GetPaymentMethod
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -501,7 +530,7 @@ func (a *Client) GetPaymentMethods(ctx context.Context, params *GetPaymentMethod
 /*
   ModifyPaymentMethodCustomFields modifies custom fields to payment method
 */
-func (a *Client) ModifyPaymentMethodCustomFields(ctx context.Context, params *ModifyPaymentMethodCustomFieldsParams) (*ModifyPaymentMethodCustomFieldsNoContent, error) {
+func (a *Client) ModifyPaymentMethodCustomFields(ctx context.Context, params *ModifyPaymentMethodCustomFieldsParams, opts ...ClientOption) (*ModifyPaymentMethodCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyPaymentMethodCustomFieldsParams()
@@ -540,6 +569,9 @@ func (a *Client) ModifyPaymentMethodCustomFields(ctx context.Context, params *Mo
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -559,7 +591,7 @@ func (a *Client) ModifyPaymentMethodCustomFields(ctx context.Context, params *Mo
 /*
   SearchPaymentMethods searches payment methods
 */
-func (a *Client) SearchPaymentMethods(ctx context.Context, params *SearchPaymentMethodsParams) (*SearchPaymentMethodsOK, error) {
+func (a *Client) SearchPaymentMethods(ctx context.Context, params *SearchPaymentMethodsParams, opts ...ClientOption) (*SearchPaymentMethodsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchPaymentMethodsParams()
@@ -586,6 +618,9 @@ func (a *Client) SearchPaymentMethods(ctx context.Context, params *SearchPayment
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -53,27 +53,27 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateTransactionCustomFields(ctx context.Context, params *CreateTransactionCustomFieldsParams) (*CreateTransactionCustomFieldsCreated, error)
+	CreateTransactionCustomFields(ctx context.Context, params *CreateTransactionCustomFieldsParams, opts ...ClientOption) (*CreateTransactionCustomFieldsCreated, error)
 
-	CreateTransactionTags(ctx context.Context, params *CreateTransactionTagsParams) (*CreateTransactionTagsCreated, error)
+	CreateTransactionTags(ctx context.Context, params *CreateTransactionTagsParams, opts ...ClientOption) (*CreateTransactionTagsCreated, error)
 
-	DeleteTransactionCustomFields(ctx context.Context, params *DeleteTransactionCustomFieldsParams) (*DeleteTransactionCustomFieldsNoContent, error)
+	DeleteTransactionCustomFields(ctx context.Context, params *DeleteTransactionCustomFieldsParams, opts ...ClientOption) (*DeleteTransactionCustomFieldsNoContent, error)
 
-	DeleteTransactionTags(ctx context.Context, params *DeleteTransactionTagsParams) (*DeleteTransactionTagsNoContent, error)
+	DeleteTransactionTags(ctx context.Context, params *DeleteTransactionTagsParams, opts ...ClientOption) (*DeleteTransactionTagsNoContent, error)
 
-	GetPaymentByTransactionExternalKey(ctx context.Context, params *GetPaymentByTransactionExternalKeyParams) (*GetPaymentByTransactionExternalKeyOK, error)
+	GetPaymentByTransactionExternalKey(ctx context.Context, params *GetPaymentByTransactionExternalKeyParams, opts ...ClientOption) (*GetPaymentByTransactionExternalKeyOK, error)
 
-	GetPaymentByTransactionID(ctx context.Context, params *GetPaymentByTransactionIDParams) (*GetPaymentByTransactionIDOK, error)
+	GetPaymentByTransactionID(ctx context.Context, params *GetPaymentByTransactionIDParams, opts ...ClientOption) (*GetPaymentByTransactionIDOK, error)
 
-	GetTransactionAuditLogsWithHistory(ctx context.Context, params *GetTransactionAuditLogsWithHistoryParams) (*GetTransactionAuditLogsWithHistoryOK, error)
+	GetTransactionAuditLogsWithHistory(ctx context.Context, params *GetTransactionAuditLogsWithHistoryParams, opts ...ClientOption) (*GetTransactionAuditLogsWithHistoryOK, error)
 
-	GetTransactionCustomFields(ctx context.Context, params *GetTransactionCustomFieldsParams) (*GetTransactionCustomFieldsOK, error)
+	GetTransactionCustomFields(ctx context.Context, params *GetTransactionCustomFieldsParams, opts ...ClientOption) (*GetTransactionCustomFieldsOK, error)
 
-	GetTransactionTags(ctx context.Context, params *GetTransactionTagsParams) (*GetTransactionTagsOK, error)
+	GetTransactionTags(ctx context.Context, params *GetTransactionTagsParams, opts ...ClientOption) (*GetTransactionTagsOK, error)
 
-	ModifyTransactionCustomFields(ctx context.Context, params *ModifyTransactionCustomFieldsParams) (*ModifyTransactionCustomFieldsNoContent, error)
+	ModifyTransactionCustomFields(ctx context.Context, params *ModifyTransactionCustomFieldsParams, opts ...ClientOption) (*ModifyTransactionCustomFieldsNoContent, error)
 
-	NotifyStateChanged(ctx context.Context, params *NotifyStateChangedParams) (*NotifyStateChangedCreated, error)
+	NotifyStateChanged(ctx context.Context, params *NotifyStateChangedParams, opts ...ClientOption) (*NotifyStateChangedCreated, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -81,7 +81,7 @@ type ClientService interface {
 /*
   CreateTransactionCustomFields adds custom fields to payment transaction
 */
-func (a *Client) CreateTransactionCustomFields(ctx context.Context, params *CreateTransactionCustomFieldsParams) (*CreateTransactionCustomFieldsCreated, error) {
+func (a *Client) CreateTransactionCustomFields(ctx context.Context, params *CreateTransactionCustomFieldsParams, opts ...ClientOption) (*CreateTransactionCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateTransactionCustomFieldsParams()
@@ -123,6 +123,9 @@ func (a *Client) CreateTransactionCustomFields(ctx context.Context, params *Crea
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -134,7 +137,7 @@ func (a *Client) CreateTransactionCustomFields(ctx context.Context, params *Crea
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createTransactionCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -146,7 +149,12 @@ func (a *Client) CreateTransactionCustomFields(ctx context.Context, params *Crea
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +165,7 @@ func (a *Client) CreateTransactionCustomFields(ctx context.Context, params *Crea
 /*
   CreateTransactionTags adds tags to payment transaction
 */
-func (a *Client) CreateTransactionTags(ctx context.Context, params *CreateTransactionTagsParams) (*CreateTransactionTagsCreated, error) {
+func (a *Client) CreateTransactionTags(ctx context.Context, params *CreateTransactionTagsParams, opts ...ClientOption) (*CreateTransactionTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateTransactionTagsParams()
@@ -199,6 +207,9 @@ func (a *Client) CreateTransactionTags(ctx context.Context, params *CreateTransa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -210,7 +221,7 @@ func (a *Client) CreateTransactionTags(ctx context.Context, params *CreateTransa
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createTransactionTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -222,7 +233,12 @@ func (a *Client) CreateTransactionTags(ctx context.Context, params *CreateTransa
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -233,7 +249,7 @@ func (a *Client) CreateTransactionTags(ctx context.Context, params *CreateTransa
 /*
   DeleteTransactionCustomFields removes custom fields from payment transaction
 */
-func (a *Client) DeleteTransactionCustomFields(ctx context.Context, params *DeleteTransactionCustomFieldsParams) (*DeleteTransactionCustomFieldsNoContent, error) {
+func (a *Client) DeleteTransactionCustomFields(ctx context.Context, params *DeleteTransactionCustomFieldsParams, opts ...ClientOption) (*DeleteTransactionCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteTransactionCustomFieldsParams()
@@ -272,6 +288,9 @@ func (a *Client) DeleteTransactionCustomFields(ctx context.Context, params *Dele
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -291,7 +310,7 @@ func (a *Client) DeleteTransactionCustomFields(ctx context.Context, params *Dele
 /*
   DeleteTransactionTags removes tags from payment transaction
 */
-func (a *Client) DeleteTransactionTags(ctx context.Context, params *DeleteTransactionTagsParams) (*DeleteTransactionTagsNoContent, error) {
+func (a *Client) DeleteTransactionTags(ctx context.Context, params *DeleteTransactionTagsParams, opts ...ClientOption) (*DeleteTransactionTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteTransactionTagsParams()
@@ -330,6 +349,9 @@ func (a *Client) DeleteTransactionTags(ctx context.Context, params *DeleteTransa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -349,7 +371,7 @@ func (a *Client) DeleteTransactionTags(ctx context.Context, params *DeleteTransa
 /*
   GetPaymentByTransactionExternalKey retrieves a payment by transaction external key
 */
-func (a *Client) GetPaymentByTransactionExternalKey(ctx context.Context, params *GetPaymentByTransactionExternalKeyParams) (*GetPaymentByTransactionExternalKeyOK, error) {
+func (a *Client) GetPaymentByTransactionExternalKey(ctx context.Context, params *GetPaymentByTransactionExternalKeyParams, opts ...ClientOption) (*GetPaymentByTransactionExternalKeyOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentByTransactionExternalKeyParams()
@@ -376,6 +398,9 @@ func (a *Client) GetPaymentByTransactionExternalKey(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -395,7 +420,7 @@ func (a *Client) GetPaymentByTransactionExternalKey(ctx context.Context, params
 /*
   GetPaymentByTransactionID retrieves a payment by transaction id
 */
-func (a *Client) GetPaymentByTransactionID(ctx context.Context, params *GetPaymentByTransactionIDParams) (*GetPaymentByTransactionIDOK, error) {
+func (a *Client) GetPaymentByTransactionID(ctx context.Context, params *GetPaymentByTransactionIDParams, opts ...ClientOption) (*GetPaymentByTransactionIDOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPaymentByTransactionIDParams()
@@ -422,6 +447,9 @@ func (a *Client) GetPaymentByTransactionID(ctx context.Context, params *GetPayme
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -441,7 +469,7 @@ func (a *Client) GetPaymentByTransactionID(ctx context.Context, params *GetPayme
 /*
   GetTransactionAuditLogsWithHistory retrieves payment transaction audit logs with history by id
 */
-func (a *Client) GetTransactionAuditLogsWithHistory(ctx context.Context, params *GetTransactionAuditLogsWithHistoryParams) (*GetTransactionAuditLogsWithHistoryOK, error) {
+func (a *Client) GetTransactionAuditLogsWithHistory(ctx context.Context, params *GetTransactionAuditLogsWithHistoryParams, opts ...ClientOption) (*GetTransactionAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTransactionAuditLogsWithHistoryParams()
@@ -468,6 +496,9 @@ func (a *Client) GetTransactionAuditLogsWithHistory(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -487,7 +518,7 @@ func (a *Client) GetTransactionAuditLogsWithHistory(ctx context.Context, params
 /*
   GetTransactionCustomFields retrieves payment transaction custom fields
 */
-func (a *Client) GetTransactionCustomFields(ctx context.Context, params *GetTransactionCustomFieldsParams) (*GetTransactionCustomFieldsOK, error) {
+func (a *Client) GetTransactionCustomFields(ctx context.Context, params *GetTransactionCustomFieldsParams, opts ...ClientOption) (*GetTransactionCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTransactionCustomFieldsParams()
@@ -514,6 +545,9 @@ func (a *Client) GetTransactionCustomFields(ctx context.Context, params *GetTran
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -533,7 +567,7 @@ func (a *Client) GetTransactionCustomFields(ctx context.Context, params *GetTran
 /*
   GetTransactionTags retrieves payment transaction tags
 */
-func (a *Client) GetTransactionTags(ctx context.Context, params *GetTransactionTagsParams) (*GetTransactionTagsOK, error) {
+func (a *Client) GetTransactionTags(ctx context.Context, params *GetTransactionTagsParams, opts ...ClientOption) (*GetTransactionTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTransactionTagsParams()
@@ -560,6 +594,9 @@ func (a *Client) GetTransactionTags(ctx context.Context, params *GetTransactionT
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -579,7 +616,7 @@ func (a *Client) GetTransactionTags(ctx context.Context, params *GetTransactionT
 /*
   ModifyTransactionCustomFields modifies custom fields to payment transaction
 */
-func (a *Client) ModifyTransactionCustomFields(ctx context.Context, params *ModifyTransactionCustomFieldsParams) (*ModifyTransactionCustomFieldsNoContent, error) {
+func (a *Client) ModifyTransactionCustomFields(ctx context.Context, params *ModifyTransactionCustomFieldsParams, opts ...ClientOption) (*ModifyTransactionCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifyTransactionCustomFieldsParams()
@@ -618,6 +655,9 @@ func (a *Client) ModifyTransactionCustomFields(ctx context.Context, params *Modi
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -637,7 +677,7 @@ func (a *Client) ModifyTransactionCustomFields(ctx context.Context, params *Modi
 /*
   NotifyStateChanged marks a pending payment transaction as succeeded or failed
 */
-func (a *Client) NotifyStateChanged(ctx context.Context, params *NotifyStateChangedParams) (*NotifyStateChangedCreated, error) {
+func (a *Client) NotifyStateChanged(ctx context.Context, params *NotifyStateChangedParams, opts ...ClientOption) (*NotifyStateChangedCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewNotifyStateChangedParams()
@@ -679,6 +719,9 @@ func (a *Client) NotifyStateChanged(ctx context.Context, params *NotifyStateChan
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -690,7 +733,7 @@ func (a *Client) NotifyStateChanged(ctx context.Context, params *NotifyStateChan
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "notifyStateChanged",
 		Method:             "GET",
 		PathPattern:        location,
@@ -702,7 +745,12 @@ func (a *Client) NotifyStateChanged(ctx context.Context, params *NotifyStateChan
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +52,7 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetPluginsInfo(ctx context.Context, params *GetPluginsInfoParams) (*GetPluginsInfoOK, error)
+	GetPluginsInfo(ctx context.Context, params *GetPluginsInfoParams, opts ...ClientOption) (*GetPluginsInfoOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -60,7 +60,7 @@ type ClientService interface {
 /*
   GetPluginsInfo retrieves the list of registered plugins
 */
-func (a *Client) GetPluginsInfo(ctx context.Context, params *GetPluginsInfoParams) (*GetPluginsInfoOK, error) {
+func (a *Client) GetPluginsInfo(ctx context.Context, params *GetPluginsInfoParams, opts ...ClientOption) (*GetPluginsInfoOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPluginsInfoParams()
@@ -87,6 +87,9 @@ func (a *Client) GetPluginsInfo(ctx context.Context, params *GetPluginsInfoParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
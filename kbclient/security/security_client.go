@@ -53,25 +53,25 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	AddRoleDefinition(ctx context.Context, params *AddRoleDefinitionParams) (*AddRoleDefinitionCreated, error)
+	AddRoleDefinition(ctx context.Context, params *AddRoleDefinitionParams, opts ...ClientOption) (*AddRoleDefinitionCreated, error)
 
-	AddUserRoles(ctx context.Context, params *AddUserRolesParams) (*AddUserRolesCreated, error)
+	AddUserRoles(ctx context.Context, params *AddUserRolesParams, opts ...ClientOption) (*AddUserRolesCreated, error)
 
-	GetCurrentUserPermissions(ctx context.Context, params *GetCurrentUserPermissionsParams) (*GetCurrentUserPermissionsOK, error)
+	GetCurrentUserPermissions(ctx context.Context, params *GetCurrentUserPermissionsParams, opts ...ClientOption) (*GetCurrentUserPermissionsOK, error)
 
-	GetCurrentUserSubject(ctx context.Context, params *GetCurrentUserSubjectParams) (*GetCurrentUserSubjectOK, error)
+	GetCurrentUserSubject(ctx context.Context, params *GetCurrentUserSubjectParams, opts ...ClientOption) (*GetCurrentUserSubjectOK, error)
 
-	GetRoleDefinition(ctx context.Context, params *GetRoleDefinitionParams) (*GetRoleDefinitionOK, error)
+	GetRoleDefinition(ctx context.Context, params *GetRoleDefinitionParams, opts ...ClientOption) (*GetRoleDefinitionOK, error)
 
-	GetUserRoles(ctx context.Context, params *GetUserRolesParams) (*GetUserRolesOK, error)
+	GetUserRoles(ctx context.Context, params *GetUserRolesParams, opts ...ClientOption) (*GetUserRolesOK, error)
 
-	InvalidateUser(ctx context.Context, params *InvalidateUserParams) (*InvalidateUserNoContent, error)
+	InvalidateUser(ctx context.Context, params *InvalidateUserParams, opts ...ClientOption) (*InvalidateUserNoContent, error)
 
-	UpdateRoleDefinition(ctx context.Context, params *UpdateRoleDefinitionParams) (*UpdateRoleDefinitionNoContent, error)
+	UpdateRoleDefinition(ctx context.Context, params *UpdateRoleDefinitionParams, opts ...ClientOption) (*UpdateRoleDefinitionNoContent, error)
 
-	UpdateUserPassword(ctx context.Context, params *UpdateUserPasswordParams) (*UpdateUserPasswordNoContent, error)
+	UpdateUserPassword(ctx context.Context, params *UpdateUserPasswordParams, opts ...ClientOption) (*UpdateUserPasswordNoContent, error)
 
-	UpdateUserRoles(ctx context.Context, params *UpdateUserRolesParams) (*UpdateUserRolesNoContent, error)
+	UpdateUserRoles(ctx context.Context, params *UpdateUserRolesParams, opts ...ClientOption) (*UpdateUserRolesNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -79,7 +79,7 @@ type ClientService interface {
 /*
   AddRoleDefinition adds a new role definition
 */
-func (a *Client) AddRoleDefinition(ctx context.Context, params *AddRoleDefinitionParams) (*AddRoleDefinitionCreated, error) {
+func (a *Client) AddRoleDefinition(ctx context.Context, params *AddRoleDefinitionParams, opts ...ClientOption) (*AddRoleDefinitionCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAddRoleDefinitionParams()
@@ -121,6 +121,9 @@ func (a *Client) AddRoleDefinition(ctx context.Context, params *AddRoleDefinitio
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -132,7 +135,7 @@ func (a *Client) AddRoleDefinition(ctx context.Context, params *AddRoleDefinitio
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "addRoleDefinition",
 		Method:             "GET",
 		PathPattern:        location,
@@ -144,7 +147,12 @@ func (a *Client) AddRoleDefinition(ctx context.Context, params *AddRoleDefinitio
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +163,7 @@ func (a *Client) AddRoleDefinition(ctx context.Context, params *AddRoleDefinitio
 /*
   AddUserRoles adds a new user with roles to make api requests
 */
-func (a *Client) AddUserRoles(ctx context.Context, params *AddUserRolesParams) (*AddUserRolesCreated, error) {
+func (a *Client) AddUserRoles(ctx context.Context, params *AddUserRolesParams, opts ...ClientOption) (*AddUserRolesCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAddUserRolesParams()
@@ -197,6 +205,9 @@ func (a *Client) AddUserRoles(ctx context.Context, params *AddUserRolesParams) (
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -208,7 +219,7 @@ func (a *Client) AddUserRoles(ctx context.Context, params *AddUserRolesParams) (
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "addUserRoles",
 		Method:             "GET",
 		PathPattern:        location,
@@ -220,7 +231,12 @@ func (a *Client) AddUserRoles(ctx context.Context, params *AddUserRolesParams) (
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +247,7 @@ func (a *Client) AddUserRoles(ctx context.Context, params *AddUserRolesParams) (
 /*
   GetCurrentUserPermissions lists user permissions
 */
-func (a *Client) GetCurrentUserPermissions(ctx context.Context, params *GetCurrentUserPermissionsParams) (*GetCurrentUserPermissionsOK, error) {
+func (a *Client) GetCurrentUserPermissions(ctx context.Context, params *GetCurrentUserPermissionsParams, opts ...ClientOption) (*GetCurrentUserPermissionsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCurrentUserPermissionsParams()
@@ -258,6 +274,9 @@ func (a *Client) GetCurrentUserPermissions(ctx context.Context, params *GetCurre
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -277,7 +296,7 @@ func (a *Client) GetCurrentUserPermissions(ctx context.Context, params *GetCurre
 /*
   GetCurrentUserSubject gets user information
 */
-func (a *Client) GetCurrentUserSubject(ctx context.Context, params *GetCurrentUserSubjectParams) (*GetCurrentUserSubjectOK, error) {
+func (a *Client) GetCurrentUserSubject(ctx context.Context, params *GetCurrentUserSubjectParams, opts ...ClientOption) (*GetCurrentUserSubjectOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetCurrentUserSubjectParams()
@@ -304,6 +323,9 @@ func (a *Client) GetCurrentUserSubject(ctx context.Context, params *GetCurrentUs
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -323,7 +345,7 @@ func (a *Client) GetCurrentUserSubject(ctx context.Context, params *GetCurrentUs
 /*
   GetRoleDefinition gets role definition
 */
-func (a *Client) GetRoleDefinition(ctx context.Context, params *GetRoleDefinitionParams) (*GetRoleDefinitionOK, error) {
+func (a *Client) GetRoleDefinition(ctx context.Context, params *GetRoleDefinitionParams, opts ...ClientOption) (*GetRoleDefinitionOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetRoleDefinitionParams()
@@ -350,6 +372,9 @@ func (a *Client) GetRoleDefinition(ctx context.Context, params *GetRoleDefinitio
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -369,7 +394,7 @@ func (a *Client) GetRoleDefinition(ctx context.Context, params *GetRoleDefinitio
 /*
   GetUserRoles gets roles associated to a user
 */
-func (a *Client) GetUserRoles(ctx context.Context, params *GetUserRolesParams) (*GetUserRolesOK, error) {
+func (a *Client) GetUserRoles(ctx context.Context, params *GetUserRolesParams, opts ...ClientOption) (*GetUserRolesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetUserRolesParams()
@@ -396,6 +421,9 @@ func (a *Client) GetUserRoles(ctx context.Context, params *GetUserRolesParams) (
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -415,7 +443,7 @@ func (a *Client) GetUserRoles(ctx context.Context, params *GetUserRolesParams) (
 /*
   InvalidateUser invalidates an existing user
 */
-func (a *Client) InvalidateUser(ctx context.Context, params *InvalidateUserParams) (*InvalidateUserNoContent, error) {
+func (a *Client) InvalidateUser(ctx context.Context, params *InvalidateUserParams, opts ...ClientOption) (*InvalidateUserNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewInvalidateUserParams()
@@ -454,6 +482,9 @@ func (a *Client) InvalidateUser(ctx context.Context, params *InvalidateUserParam
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -473,7 +504,7 @@ func (a *Client) InvalidateUser(ctx context.Context, params *InvalidateUserParam
 /*
   UpdateRoleDefinition updates a new role definition
 */
-func (a *Client) UpdateRoleDefinition(ctx context.Context, params *UpdateRoleDefinitionParams) (*UpdateRoleDefinitionNoContent, error) {
+func (a *Client) UpdateRoleDefinition(ctx context.Context, params *UpdateRoleDefinitionParams, opts ...ClientOption) (*UpdateRoleDefinitionNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateRoleDefinitionParams()
@@ -512,6 +543,9 @@ func (a *Client) UpdateRoleDefinition(ctx context.Context, params *UpdateRoleDef
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -531,7 +565,7 @@ func (a *Client) UpdateRoleDefinition(ctx context.Context, params *UpdateRoleDef
 /*
   UpdateUserPassword updates a user password
 */
-func (a *Client) UpdateUserPassword(ctx context.Context, params *UpdateUserPasswordParams) (*UpdateUserPasswordNoContent, error) {
+func (a *Client) UpdateUserPassword(ctx context.Context, params *UpdateUserPasswordParams, opts ...ClientOption) (*UpdateUserPasswordNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateUserPasswordParams()
@@ -570,6 +604,9 @@ func (a *Client) UpdateUserPassword(ctx context.Context, params *UpdateUserPassw
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -589,7 +626,7 @@ func (a *Client) UpdateUserPassword(ctx context.Context, params *UpdateUserPassw
 /*
   UpdateUserRoles updates roles associated to a user
 */
-func (a *Client) UpdateUserRoles(ctx context.Context, params *UpdateUserRolesParams) (*UpdateUserRolesNoContent, error) {
+func (a *Client) UpdateUserRoles(ctx context.Context, params *UpdateUserRolesParams, opts ...ClientOption) (*UpdateUserRolesNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateUserRolesParams()
@@ -628,6 +665,9 @@ func (a *Client) UpdateUserRoles(ctx context.Context, params *UpdateUserRolesPar
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -53,47 +53,47 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	AddSubscriptionBlockingState(ctx context.Context, params *AddSubscriptionBlockingStateParams) (*AddSubscriptionBlockingStateCreated, error)
+	AddSubscriptionBlockingState(ctx context.Context, params *AddSubscriptionBlockingStateParams, opts ...ClientOption) (*AddSubscriptionBlockingStateCreated, error)
 
-	CancelSubscriptionPlan(ctx context.Context, params *CancelSubscriptionPlanParams) (*CancelSubscriptionPlanNoContent, error)
+	CancelSubscriptionPlan(ctx context.Context, params *CancelSubscriptionPlanParams, opts ...ClientOption) (*CancelSubscriptionPlanNoContent, error)
 
-	ChangeSubscriptionPlan(ctx context.Context, params *ChangeSubscriptionPlanParams) (*ChangeSubscriptionPlanNoContent, error)
+	ChangeSubscriptionPlan(ctx context.Context, params *ChangeSubscriptionPlanParams, opts ...ClientOption) (*ChangeSubscriptionPlanNoContent, error)
 
-	CreateSubscription(ctx context.Context, params *CreateSubscriptionParams) (*CreateSubscriptionCreated, error)
+	CreateSubscription(ctx context.Context, params *CreateSubscriptionParams, opts ...ClientOption) (*CreateSubscriptionCreated, error)
 
-	CreateSubscriptionCustomFields(ctx context.Context, params *CreateSubscriptionCustomFieldsParams) (*CreateSubscriptionCustomFieldsCreated, error)
+	CreateSubscriptionCustomFields(ctx context.Context, params *CreateSubscriptionCustomFieldsParams, opts ...ClientOption) (*CreateSubscriptionCustomFieldsCreated, error)
 
-	CreateSubscriptionTags(ctx context.Context, params *CreateSubscriptionTagsParams) (*CreateSubscriptionTagsCreated, error)
+	CreateSubscriptionTags(ctx context.Context, params *CreateSubscriptionTagsParams, opts ...ClientOption) (*CreateSubscriptionTagsCreated, error)
 
-	CreateSubscriptionWithAddOns(ctx context.Context, params *CreateSubscriptionWithAddOnsParams) (*CreateSubscriptionWithAddOnsCreated, error)
+	CreateSubscriptionWithAddOns(ctx context.Context, params *CreateSubscriptionWithAddOnsParams, opts ...ClientOption) (*CreateSubscriptionWithAddOnsCreated, error)
 
-	CreateSubscriptionsWithAddOns(ctx context.Context, params *CreateSubscriptionsWithAddOnsParams) (*CreateSubscriptionsWithAddOnsCreated, error)
+	CreateSubscriptionsWithAddOns(ctx context.Context, params *CreateSubscriptionsWithAddOnsParams, opts ...ClientOption) (*CreateSubscriptionsWithAddOnsCreated, error)
 
-	DeleteSubscriptionCustomFields(ctx context.Context, params *DeleteSubscriptionCustomFieldsParams) (*DeleteSubscriptionCustomFieldsNoContent, error)
+	DeleteSubscriptionCustomFields(ctx context.Context, params *DeleteSubscriptionCustomFieldsParams, opts ...ClientOption) (*DeleteSubscriptionCustomFieldsNoContent, error)
 
-	DeleteSubscriptionTags(ctx context.Context, params *DeleteSubscriptionTagsParams) (*DeleteSubscriptionTagsNoContent, error)
+	DeleteSubscriptionTags(ctx context.Context, params *DeleteSubscriptionTagsParams, opts ...ClientOption) (*DeleteSubscriptionTagsNoContent, error)
 
-	GetSubscription(ctx context.Context, params *GetSubscriptionParams) (*GetSubscriptionOK, error)
+	GetSubscription(ctx context.Context, params *GetSubscriptionParams, opts ...ClientOption) (*GetSubscriptionOK, error)
 
-	GetSubscriptionAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionAuditLogsWithHistoryParams) (*GetSubscriptionAuditLogsWithHistoryOK, error)
+	GetSubscriptionAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionAuditLogsWithHistoryParams, opts ...ClientOption) (*GetSubscriptionAuditLogsWithHistoryOK, error)
 
-	GetSubscriptionByKey(ctx context.Context, params *GetSubscriptionByKeyParams) (*GetSubscriptionByKeyOK, error)
+	GetSubscriptionByKey(ctx context.Context, params *GetSubscriptionByKeyParams, opts ...ClientOption) (*GetSubscriptionByKeyOK, error)
 
-	GetSubscriptionCustomFields(ctx context.Context, params *GetSubscriptionCustomFieldsParams) (*GetSubscriptionCustomFieldsOK, error)
+	GetSubscriptionCustomFields(ctx context.Context, params *GetSubscriptionCustomFieldsParams, opts ...ClientOption) (*GetSubscriptionCustomFieldsOK, error)
 
-	GetSubscriptionEventAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionEventAuditLogsWithHistoryParams) (*GetSubscriptionEventAuditLogsWithHistoryOK, error)
+	GetSubscriptionEventAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionEventAuditLogsWithHistoryParams, opts ...ClientOption) (*GetSubscriptionEventAuditLogsWithHistoryOK, error)
 
-	GetSubscriptionTags(ctx context.Context, params *GetSubscriptionTagsParams) (*GetSubscriptionTagsOK, error)
+	GetSubscriptionTags(ctx context.Context, params *GetSubscriptionTagsParams, opts ...ClientOption) (*GetSubscriptionTagsOK, error)
 
-	ModifySubscriptionCustomFields(ctx context.Context, params *ModifySubscriptionCustomFieldsParams) (*ModifySubscriptionCustomFieldsNoContent, error)
+	ModifySubscriptionCustomFields(ctx context.Context, params *ModifySubscriptionCustomFieldsParams, opts ...ClientOption) (*ModifySubscriptionCustomFieldsNoContent, error)
 
-	UncancelSubscriptionPlan(ctx context.Context, params *UncancelSubscriptionPlanParams) (*UncancelSubscriptionPlanNoContent, error)
+	UncancelSubscriptionPlan(ctx context.Context, params *UncancelSubscriptionPlanParams, opts ...ClientOption) (*UncancelSubscriptionPlanNoContent, error)
 
-	UndoChangeSubscriptionPlan(ctx context.Context, params *UndoChangeSubscriptionPlanParams) (*UndoChangeSubscriptionPlanNoContent, error)
+	UndoChangeSubscriptionPlan(ctx context.Context, params *UndoChangeSubscriptionPlanParams, opts ...ClientOption) (*UndoChangeSubscriptionPlanNoContent, error)
 
-	UpdateSubscriptionBCD(ctx context.Context, params *UpdateSubscriptionBCDParams) (*UpdateSubscriptionBCDNoContent, error)
+	UpdateSubscriptionBCD(ctx context.Context, params *UpdateSubscriptionBCDParams, opts ...ClientOption) (*UpdateSubscriptionBCDNoContent, error)
 
-	UpdateSubscriptionQuantity(ctx context.Context, params *UpdateSubscriptionQuantityParams) (*UpdateSubscriptionQuantityNoContent, error)
+	UpdateSubscriptionQuantity(ctx context.Context, params *UpdateSubscriptionQuantityParams, opts ...ClientOption) (*UpdateSubscriptionQuantityNoContent, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -101,7 +101,7 @@ type ClientService interface {
 /*
   AddSubscriptionBlockingState blocks a subscription
 */
-func (a *Client) AddSubscriptionBlockingState(ctx context.Context, params *AddSubscriptionBlockingStateParams) (*AddSubscriptionBlockingStateCreated, error) {
+func (a *Client) AddSubscriptionBlockingState(ctx context.Context, params *AddSubscriptionBlockingStateParams, opts ...ClientOption) (*AddSubscriptionBlockingStateCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewAddSubscriptionBlockingStateParams()
@@ -143,6 +143,9 @@ func (a *Client) AddSubscriptionBlockingState(ctx context.Context, params *AddSu
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -154,7 +157,7 @@ func (a *Client) AddSubscriptionBlockingState(ctx context.Context, params *AddSu
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "addSubscriptionBlockingState",
 		Method:             "GET",
 		PathPattern:        location,
@@ -166,7 +169,12 @@ func (a *Client) AddSubscriptionBlockingState(ctx context.Context, params *AddSu
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +185,7 @@ func (a *Client) AddSubscriptionBlockingState(ctx context.Context, params *AddSu
 /*
   CancelSubscriptionPlan cancels an entitlement plan
 */
-func (a *Client) CancelSubscriptionPlan(ctx context.Context, params *CancelSubscriptionPlanParams) (*CancelSubscriptionPlanNoContent, error) {
+func (a *Client) CancelSubscriptionPlan(ctx context.Context, params *CancelSubscriptionPlanParams, opts ...ClientOption) (*CancelSubscriptionPlanNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCancelSubscriptionPlanParams()
@@ -216,6 +224,9 @@ func (a *Client) CancelSubscriptionPlan(ctx context.Context, params *CancelSubsc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -235,7 +246,7 @@ func (a *Client) CancelSubscriptionPlan(ctx context.Context, params *CancelSubsc
 /*
   ChangeSubscriptionPlan changes entitlement plan
 */
-func (a *Client) ChangeSubscriptionPlan(ctx context.Context, params *ChangeSubscriptionPlanParams) (*ChangeSubscriptionPlanNoContent, error) {
+func (a *Client) ChangeSubscriptionPlan(ctx context.Context, params *ChangeSubscriptionPlanParams, opts ...ClientOption) (*ChangeSubscriptionPlanNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewChangeSubscriptionPlanParams()
@@ -274,6 +285,9 @@ func (a *Client) ChangeSubscriptionPlan(ctx context.Context, params *ChangeSubsc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -293,7 +307,7 @@ func (a *Client) ChangeSubscriptionPlan(ctx context.Context, params *ChangeSubsc
 /*
   CreateSubscription creates an subscription
 */
-func (a *Client) CreateSubscription(ctx context.Context, params *CreateSubscriptionParams) (*CreateSubscriptionCreated, error) {
+func (a *Client) CreateSubscription(ctx context.Context, params *CreateSubscriptionParams, opts ...ClientOption) (*CreateSubscriptionCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateSubscriptionParams()
@@ -335,6 +349,9 @@ func (a *Client) CreateSubscription(ctx context.Context, params *CreateSubscript
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -346,7 +363,7 @@ func (a *Client) CreateSubscription(ctx context.Context, params *CreateSubscript
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createSubscription",
 		Method:             "GET",
 		PathPattern:        location,
@@ -358,7 +375,12 @@ func (a *Client) CreateSubscription(ctx context.Context, params *CreateSubscript
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -369,7 +391,7 @@ func (a *Client) CreateSubscription(ctx context.Context, params *CreateSubscript
 /*
   CreateSubscriptionCustomFields adds custom fields to subscription
 */
-func (a *Client) CreateSubscriptionCustomFields(ctx context.Context, params *CreateSubscriptionCustomFieldsParams) (*CreateSubscriptionCustomFieldsCreated, error) {
+func (a *Client) CreateSubscriptionCustomFields(ctx context.Context, params *CreateSubscriptionCustomFieldsParams, opts ...ClientOption) (*CreateSubscriptionCustomFieldsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateSubscriptionCustomFieldsParams()
@@ -411,6 +433,9 @@ func (a *Client) CreateSubscriptionCustomFields(ctx context.Context, params *Cre
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -422,7 +447,7 @@ func (a *Client) CreateSubscriptionCustomFields(ctx context.Context, params *Cre
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createSubscriptionCustomFields",
 		Method:             "GET",
 		PathPattern:        location,
@@ -434,7 +459,12 @@ func (a *Client) CreateSubscriptionCustomFields(ctx context.Context, params *Cre
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +475,7 @@ func (a *Client) CreateSubscriptionCustomFields(ctx context.Context, params *Cre
 /*
   CreateSubscriptionTags create subscription tags API
 */
-func (a *Client) CreateSubscriptionTags(ctx context.Context, params *CreateSubscriptionTagsParams) (*CreateSubscriptionTagsCreated, error) {
+func (a *Client) CreateSubscriptionTags(ctx context.Context, params *CreateSubscriptionTagsParams, opts ...ClientOption) (*CreateSubscriptionTagsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateSubscriptionTagsParams()
@@ -487,6 +517,9 @@ func (a *Client) CreateSubscriptionTags(ctx context.Context, params *CreateSubsc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -498,7 +531,7 @@ func (a *Client) CreateSubscriptionTags(ctx context.Context, params *CreateSubsc
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createSubscriptionTags",
 		Method:             "GET",
 		PathPattern:        location,
@@ -510,7 +543,12 @@ func (a *Client) CreateSubscriptionTags(ctx context.Context, params *CreateSubsc
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -521,7 +559,7 @@ func (a *Client) CreateSubscriptionTags(ctx context.Context, params *CreateSubsc
 /*
   CreateSubscriptionWithAddOns creates an entitlement with add on products
 */
-func (a *Client) CreateSubscriptionWithAddOns(ctx context.Context, params *CreateSubscriptionWithAddOnsParams) (*CreateSubscriptionWithAddOnsCreated, error) {
+func (a *Client) CreateSubscriptionWithAddOns(ctx context.Context, params *CreateSubscriptionWithAddOnsParams, opts ...ClientOption) (*CreateSubscriptionWithAddOnsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateSubscriptionWithAddOnsParams()
@@ -563,6 +601,9 @@ func (a *Client) CreateSubscriptionWithAddOns(ctx context.Context, params *Creat
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -574,7 +615,7 @@ func (a *Client) CreateSubscriptionWithAddOns(ctx context.Context, params *Creat
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createSubscriptionWithAddOns",
 		Method:             "GET",
 		PathPattern:        location,
@@ -586,7 +627,12 @@ func (a *Client) CreateSubscriptionWithAddOns(ctx context.Context, params *Creat
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -597,7 +643,7 @@ func (a *Client) CreateSubscriptionWithAddOns(ctx context.Context, params *Creat
 /*
   CreateSubscriptionsWithAddOns creates multiple entitlements with add on products
 */
-func (a *Client) CreateSubscriptionsWithAddOns(ctx context.Context, params *CreateSubscriptionsWithAddOnsParams) (*CreateSubscriptionsWithAddOnsCreated, error) {
+func (a *Client) CreateSubscriptionsWithAddOns(ctx context.Context, params *CreateSubscriptionsWithAddOnsParams, opts ...ClientOption) (*CreateSubscriptionsWithAddOnsCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateSubscriptionsWithAddOnsParams()
@@ -639,6 +685,9 @@ func (a *Client) CreateSubscriptionsWithAddOns(ctx context.Context, params *Crea
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -650,7 +699,7 @@ func (a *Client) CreateSubscriptionsWithAddOns(ctx context.Context, params *Crea
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createSubscriptionsWithAddOns",
 		Method:             "GET",
 		PathPattern:        location,
@@ -662,7 +711,12 @@ func (a *Client) CreateSubscriptionsWithAddOns(ctx context.Context, params *Crea
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -673,7 +727,7 @@ func (a *Client) CreateSubscriptionsWithAddOns(ctx context.Context, params *Crea
 /*
   DeleteSubscriptionCustomFields removes custom fields from subscription
 */
-func (a *Client) DeleteSubscriptionCustomFields(ctx context.Context, params *DeleteSubscriptionCustomFieldsParams) (*DeleteSubscriptionCustomFieldsNoContent, error) {
+func (a *Client) DeleteSubscriptionCustomFields(ctx context.Context, params *DeleteSubscriptionCustomFieldsParams, opts ...ClientOption) (*DeleteSubscriptionCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteSubscriptionCustomFieldsParams()
@@ -712,6 +766,9 @@ func (a *Client) DeleteSubscriptionCustomFields(ctx context.Context, params *Del
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -731,7 +788,7 @@ func (a *Client) DeleteSubscriptionCustomFields(ctx context.Context, params *Del
 /*
   DeleteSubscriptionTags removes tags from subscription
 */
-func (a *Client) DeleteSubscriptionTags(ctx context.Context, params *DeleteSubscriptionTagsParams) (*DeleteSubscriptionTagsNoContent, error) {
+func (a *Client) DeleteSubscriptionTags(ctx context.Context, params *DeleteSubscriptionTagsParams, opts ...ClientOption) (*DeleteSubscriptionTagsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteSubscriptionTagsParams()
@@ -770,6 +827,9 @@ func (a *Client) DeleteSubscriptionTags(ctx context.Context, params *DeleteSubsc
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -789,7 +849,7 @@ func (a *Client) DeleteSubscriptionTags(ctx context.Context, params *DeleteSubsc
 /*
   GetSubscription retrieves a subscription by id
 */
-func (a *Client) GetSubscription(ctx context.Context, params *GetSubscriptionParams) (*GetSubscriptionOK, error) {
+func (a *Client) GetSubscription(ctx context.Context, params *GetSubscriptionParams, opts ...ClientOption) (*GetSubscriptionOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetSubscriptionParams()
@@ -816,6 +876,9 @@ func (a *Client) GetSubscription(ctx context.Context, params *GetSubscriptionPar
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -835,7 +898,7 @@ func (a *Client) GetSubscription(ctx context.Context, params *GetSubscriptionPar
 /*
   GetSubscriptionAuditLogsWithHistory retrieves subscription audit logs with history by id
 */
-func (a *Client) GetSubscriptionAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionAuditLogsWithHistoryParams) (*GetSubscriptionAuditLogsWithHistoryOK, error) {
+func (a *Client) GetSubscriptionAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionAuditLogsWithHistoryParams, opts ...ClientOption) (*GetSubscriptionAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetSubscriptionAuditLogsWithHistoryParams()
@@ -862,6 +925,9 @@ func (a *Client) GetSubscriptionAuditLogsWithHistory(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -881,7 +947,7 @@ func (a *Client) GetSubscriptionAuditLogsWithHistory(ctx context.Context, params
 /*
   GetSubscriptionByKey retrieves a subscription by external key
 */
-func (a *Client) GetSubscriptionByKey(ctx context.Context, params *GetSubscriptionByKeyParams) (*GetSubscriptionByKeyOK, error) {
+func (a *Client) GetSubscriptionByKey(ctx context.Context, params *GetSubscriptionByKeyParams, opts ...ClientOption) (*GetSubscriptionByKeyOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetSubscriptionByKeyParams()
@@ -908,6 +974,9 @@ func (a *Client) GetSubscriptionByKey(ctx context.Context, params *GetSubscripti
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -927,7 +996,7 @@ func (a *Client) GetSubscriptionByKey(ctx context.Context, params *GetSubscripti
 /*
   GetSubscriptionCustomFields retrieves subscription custom fields
 */
-func (a *Client) GetSubscriptionCustomFields(ctx context.Context, params *GetSubscriptionCustomFieldsParams) (*GetSubscriptionCustomFieldsOK, error) {
+func (a *Client) GetSubscriptionCustomFields(ctx context.Context, params *GetSubscriptionCustomFieldsParams, opts ...ClientOption) (*GetSubscriptionCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetSubscriptionCustomFieldsParams()
@@ -954,6 +1023,9 @@ func (a *Client) GetSubscriptionCustomFields(ctx context.Context, params *GetSub
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -973,7 +1045,7 @@ func (a *Client) GetSubscriptionCustomFields(ctx context.Context, params *GetSub
 /*
   GetSubscriptionEventAuditLogsWithHistory retrieves subscription event audit logs with history by id
 */
-func (a *Client) GetSubscriptionEventAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionEventAuditLogsWithHistoryParams) (*GetSubscriptionEventAuditLogsWithHistoryOK, error) {
+func (a *Client) GetSubscriptionEventAuditLogsWithHistory(ctx context.Context, params *GetSubscriptionEventAuditLogsWithHistoryParams, opts ...ClientOption) (*GetSubscriptionEventAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetSubscriptionEventAuditLogsWithHistoryParams()
@@ -1000,6 +1072,9 @@ func (a *Client) GetSubscriptionEventAuditLogsWithHistory(ctx context.Context, p
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1019,7 +1094,7 @@ func (a *Client) GetSubscriptionEventAuditLogsWithHistory(ctx context.Context, p
 /*
   GetSubscriptionTags retrieves subscription tags
 */
-func (a *Client) GetSubscriptionTags(ctx context.Context, params *GetSubscriptionTagsParams) (*GetSubscriptionTagsOK, error) {
+func (a *Client) GetSubscriptionTags(ctx context.Context, params *GetSubscriptionTagsParams, opts ...ClientOption) (*GetSubscriptionTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetSubscriptionTagsParams()
@@ -1046,6 +1121,9 @@ func (a *Client) GetSubscriptionTags(ctx context.Context, params *GetSubscriptio
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1065,7 +1143,7 @@ func (a *Client) GetSubscriptionTags(ctx context.Context, params *GetSubscriptio
 /*
   ModifySubscriptionCustomFields modifies custom fields to subscription
 */
-func (a *Client) ModifySubscriptionCustomFields(ctx context.Context, params *ModifySubscriptionCustomFieldsParams) (*ModifySubscriptionCustomFieldsNoContent, error) {
+func (a *Client) ModifySubscriptionCustomFields(ctx context.Context, params *ModifySubscriptionCustomFieldsParams, opts ...ClientOption) (*ModifySubscriptionCustomFieldsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewModifySubscriptionCustomFieldsParams()
@@ -1104,6 +1182,9 @@ func (a *Client) ModifySubscriptionCustomFields(ctx context.Context, params *Mod
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1123,7 +1204,7 @@ func (a *Client) ModifySubscriptionCustomFields(ctx context.Context, params *Mod
 /*
   UncancelSubscriptionPlan uns cancel an entitlement
 */
-func (a *Client) UncancelSubscriptionPlan(ctx context.Context, params *UncancelSubscriptionPlanParams) (*UncancelSubscriptionPlanNoContent, error) {
+func (a *Client) UncancelSubscriptionPlan(ctx context.Context, params *UncancelSubscriptionPlanParams, opts ...ClientOption) (*UncancelSubscriptionPlanNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUncancelSubscriptionPlanParams()
@@ -1162,6 +1243,9 @@ func (a *Client) UncancelSubscriptionPlan(ctx context.Context, params *UncancelS
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1181,7 +1265,7 @@ func (a *Client) UncancelSubscriptionPlan(ctx context.Context, params *UncancelS
 /*
   UndoChangeSubscriptionPlan undos a pending change plan on an entitlement
 */
-func (a *Client) UndoChangeSubscriptionPlan(ctx context.Context, params *UndoChangeSubscriptionPlanParams) (*UndoChangeSubscriptionPlanNoContent, error) {
+func (a *Client) UndoChangeSubscriptionPlan(ctx context.Context, params *UndoChangeSubscriptionPlanParams, opts ...ClientOption) (*UndoChangeSubscriptionPlanNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUndoChangeSubscriptionPlanParams()
@@ -1220,6 +1304,9 @@ func (a *Client) UndoChangeSubscriptionPlan(ctx context.Context, params *UndoCha
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1239,7 +1326,7 @@ func (a *Client) UndoChangeSubscriptionPlan(ctx context.Context, params *UndoCha
 /*
   UpdateSubscriptionBCD updates the b c d associated to a subscription
 */
-func (a *Client) UpdateSubscriptionBCD(ctx context.Context, params *UpdateSubscriptionBCDParams) (*UpdateSubscriptionBCDNoContent, error) {
+func (a *Client) UpdateSubscriptionBCD(ctx context.Context, params *UpdateSubscriptionBCDParams, opts ...ClientOption) (*UpdateSubscriptionBCDNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateSubscriptionBCDParams()
@@ -1278,6 +1365,9 @@ func (a *Client) UpdateSubscriptionBCD(ctx context.Context, params *UpdateSubscr
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1297,7 +1387,7 @@ func (a *Client) UpdateSubscriptionBCD(ctx context.Context, params *UpdateSubscr
 /*
   UpdateSubscriptionQuantity updates the quantity associated to a subscription
 */
-func (a *Client) UpdateSubscriptionQuantity(ctx context.Context, params *UpdateSubscriptionQuantityParams) (*UpdateSubscriptionQuantityNoContent, error) {
+func (a *Client) UpdateSubscriptionQuantity(ctx context.Context, params *UpdateSubscriptionQuantityParams, opts ...ClientOption) (*UpdateSubscriptionQuantityNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateSubscriptionQuantityParams()
@@ -1336,6 +1426,9 @@ func (a *Client) UpdateSubscriptionQuantity(ctx context.Context, params *UpdateS
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -53,13 +53,13 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateTNCustomField(ctx context.Context, params *CreateTNCustomFieldParams) (*CreateTNCustomFieldCreated, error)
+	CreateTNCustomField(ctx context.Context, params *CreateTNCustomFieldParams, opts ...ClientOption) (*CreateTNCustomFieldCreated, error)
 
-	DeleteTNCustomField(ctx context.Context, params *DeleteTNCustomFieldParams) (*DeleteTNCustomFieldOK, error)
+	DeleteTNCustomField(ctx context.Context, params *DeleteTNCustomFieldParams, opts ...ClientOption) (*DeleteTNCustomFieldOK, error)
 
-	GetTNCustomFields(ctx context.Context, params *GetTNCustomFieldsParams) (*GetTNCustomFieldsOK, error)
+	GetTNCustomFields(ctx context.Context, params *GetTNCustomFieldsParams, opts ...ClientOption) (*GetTNCustomFieldsOK, error)
 
-	UpdateTNCustomField(ctx context.Context, params *UpdateTNCustomFieldParams) (*UpdateTNCustomFieldOK, error)
+	UpdateTNCustomField(ctx context.Context, params *UpdateTNCustomFieldParams, opts ...ClientOption) (*UpdateTNCustomFieldOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -69,7 +69,7 @@ type ClientService interface {
 
   Create a new TN Custom Field
 */
-func (a *Client) CreateTNCustomField(ctx context.Context, params *CreateTNCustomFieldParams) (*CreateTNCustomFieldCreated, error) {
+func (a *Client) CreateTNCustomField(ctx context.Context, params *CreateTNCustomFieldParams, opts ...ClientOption) (*CreateTNCustomFieldCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateTNCustomFieldParams()
@@ -99,6 +99,9 @@ func (a *Client) CreateTNCustomField(ctx context.Context, params *CreateTNCustom
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -110,7 +113,7 @@ func (a *Client) CreateTNCustomField(ctx context.Context, params *CreateTNCustom
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createTNCustomField",
 		Method:             "GET",
 		PathPattern:        location,
@@ -122,7 +125,12 @@ func (a *Client) CreateTNCustomField(ctx context.Context, params *CreateTNCustom
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +143,7 @@ func (a *Client) CreateTNCustomField(ctx context.Context, params *CreateTNCustom
 
   Delete a TN Custom Field
 */
-func (a *Client) DeleteTNCustomField(ctx context.Context, params *DeleteTNCustomFieldParams) (*DeleteTNCustomFieldOK, error) {
+func (a *Client) DeleteTNCustomField(ctx context.Context, params *DeleteTNCustomFieldParams, opts ...ClientOption) (*DeleteTNCustomFieldOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteTNCustomFieldParams()
@@ -162,6 +170,9 @@ func (a *Client) DeleteTNCustomField(ctx context.Context, params *DeleteTNCustom
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -183,7 +194,7 @@ func (a *Client) DeleteTNCustomField(ctx context.Context, params *DeleteTNCustom
 
   Get the custom fields with the option to filter out by object type, id, and/or account ID
 */
-func (a *Client) GetTNCustomFields(ctx context.Context, params *GetTNCustomFieldsParams) (*GetTNCustomFieldsOK, error) {
+func (a *Client) GetTNCustomFields(ctx context.Context, params *GetTNCustomFieldsParams, opts ...ClientOption) (*GetTNCustomFieldsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTNCustomFieldsParams()
@@ -210,6 +221,9 @@ func (a *Client) GetTNCustomFields(ctx context.Context, params *GetTNCustomField
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -231,7 +245,7 @@ func (a *Client) GetTNCustomFields(ctx context.Context, params *GetTNCustomField
 
   Updates a TN Custom Field
 */
-func (a *Client) UpdateTNCustomField(ctx context.Context, params *UpdateTNCustomFieldParams) (*UpdateTNCustomFieldOK, error) {
+func (a *Client) UpdateTNCustomField(ctx context.Context, params *UpdateTNCustomFieldParams, opts ...ClientOption) (*UpdateTNCustomFieldOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateTNCustomFieldParams()
@@ -258,6 +272,9 @@ func (a *Client) UpdateTNCustomField(ctx context.Context, params *UpdateTNCustom
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
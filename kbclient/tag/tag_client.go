@@ -52,11 +52,11 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetTagAuditLogsWithHistory(ctx context.Context, params *GetTagAuditLogsWithHistoryParams) (*GetTagAuditLogsWithHistoryOK, error)
+	GetTagAuditLogsWithHistory(ctx context.Context, params *GetTagAuditLogsWithHistoryParams, opts ...ClientOption) (*GetTagAuditLogsWithHistoryOK, error)
 
-	GetTags(ctx context.Context, params *GetTagsParams) (*GetTagsOK, error)
+	GetTags(ctx context.Context, params *GetTagsParams, opts ...ClientOption) (*GetTagsOK, error)
 
-	SearchTags(ctx context.Context, params *SearchTagsParams) (*SearchTagsOK, error)
+	SearchTags(ctx context.Context, params *SearchTagsParams, opts ...ClientOption) (*SearchTagsOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -64,7 +64,7 @@ type ClientService interface {
 /*
   GetTagAuditLogsWithHistory retrieves tag audit logs with history by id
 */
-func (a *Client) GetTagAuditLogsWithHistory(ctx context.Context, params *GetTagAuditLogsWithHistoryParams) (*GetTagAuditLogsWithHistoryOK, error) {
+func (a *Client) GetTagAuditLogsWithHistory(ctx context.Context, params *GetTagAuditLogsWithHistoryParams, opts ...ClientOption) (*GetTagAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTagAuditLogsWithHistoryParams()
@@ -91,6 +91,9 @@ func (a *Client) GetTagAuditLogsWithHistory(ctx context.Context, params *GetTagA
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -110,7 +113,7 @@ func (a *Client) GetTagAuditLogsWithHistory(ctx context.Context, params *GetTagA
 /*
   GetTags lists tags
 */
-func (a *Client) GetTags(ctx context.Context, params *GetTagsParams) (*GetTagsOK, error) {
+func (a *Client) GetTags(ctx context.Context, params *GetTagsParams, opts ...ClientOption) (*GetTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTagsParams()
@@ -137,6 +140,9 @@ func (a *Client) GetTags(ctx context.Context, params *GetTagsParams) (*GetTagsOK
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -156,7 +162,7 @@ func (a *Client) GetTags(ctx context.Context, params *GetTagsParams) (*GetTagsOK
 /*
   SearchTags searches tags
 */
-func (a *Client) SearchTags(ctx context.Context, params *SearchTagsParams) (*SearchTagsOK, error) {
+func (a *Client) SearchTags(ctx context.Context, params *SearchTagsParams, opts ...ClientOption) (*SearchTagsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewSearchTagsParams()
@@ -183,6 +189,9 @@ func (a *Client) SearchTags(ctx context.Context, params *SearchTagsParams) (*Sea
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -53,15 +53,15 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateTagDefinition(ctx context.Context, params *CreateTagDefinitionParams) (*CreateTagDefinitionCreated, error)
+	CreateTagDefinition(ctx context.Context, params *CreateTagDefinitionParams, opts ...ClientOption) (*CreateTagDefinitionCreated, error)
 
-	DeleteTagDefinition(ctx context.Context, params *DeleteTagDefinitionParams) (*DeleteTagDefinitionNoContent, error)
+	DeleteTagDefinition(ctx context.Context, params *DeleteTagDefinitionParams, opts ...ClientOption) (*DeleteTagDefinitionNoContent, error)
 
-	GetTagDefinition(ctx context.Context, params *GetTagDefinitionParams) (*GetTagDefinitionOK, error)
+	GetTagDefinition(ctx context.Context, params *GetTagDefinitionParams, opts ...ClientOption) (*GetTagDefinitionOK, error)
 
-	GetTagDefinitionAuditLogsWithHistory(ctx context.Context, params *GetTagDefinitionAuditLogsWithHistoryParams) (*GetTagDefinitionAuditLogsWithHistoryOK, error)
+	GetTagDefinitionAuditLogsWithHistory(ctx context.Context, params *GetTagDefinitionAuditLogsWithHistoryParams, opts ...ClientOption) (*GetTagDefinitionAuditLogsWithHistoryOK, error)
 
-	GetTagDefinitions(ctx context.Context, params *GetTagDefinitionsParams) (*GetTagDefinitionsOK, error)
+	GetTagDefinitions(ctx context.Context, params *GetTagDefinitionsParams, opts ...ClientOption) (*GetTagDefinitionsOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -69,7 +69,7 @@ type ClientService interface {
 /*
   CreateTagDefinition creates a tag definition
 */
-func (a *Client) CreateTagDefinition(ctx context.Context, params *CreateTagDefinitionParams) (*CreateTagDefinitionCreated, error) {
+func (a *Client) CreateTagDefinition(ctx context.Context, params *CreateTagDefinitionParams, opts ...ClientOption) (*CreateTagDefinitionCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateTagDefinitionParams()
@@ -111,6 +111,9 @@ func (a *Client) CreateTagDefinition(ctx context.Context, params *CreateTagDefin
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -122,7 +125,7 @@ func (a *Client) CreateTagDefinition(ctx context.Context, params *CreateTagDefin
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createTagDefinition",
 		Method:             "GET",
 		PathPattern:        location,
@@ -134,7 +137,12 @@ func (a *Client) CreateTagDefinition(ctx context.Context, params *CreateTagDefin
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +153,7 @@ func (a *Client) CreateTagDefinition(ctx context.Context, params *CreateTagDefin
 /*
   DeleteTagDefinition deletes a tag definition
 */
-func (a *Client) DeleteTagDefinition(ctx context.Context, params *DeleteTagDefinitionParams) (*DeleteTagDefinitionNoContent, error) {
+func (a *Client) DeleteTagDefinition(ctx context.Context, params *DeleteTagDefinitionParams, opts ...ClientOption) (*DeleteTagDefinitionNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteTagDefinitionParams()
@@ -184,6 +192,9 @@ func (a *Client) DeleteTagDefinition(ctx context.Context, params *DeleteTagDefin
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -203,7 +214,7 @@ func (a *Client) DeleteTagDefinition(ctx context.Context, params *DeleteTagDefin
 /*
   GetTagDefinition retrieves a tag definition
 */
-func (a *Client) GetTagDefinition(ctx context.Context, params *GetTagDefinitionParams) (*GetTagDefinitionOK, error) {
+func (a *Client) GetTagDefinition(ctx context.Context, params *GetTagDefinitionParams, opts ...ClientOption) (*GetTagDefinitionOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTagDefinitionParams()
@@ -230,6 +241,9 @@ func (a *Client) GetTagDefinition(ctx context.Context, params *GetTagDefinitionP
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -249,7 +263,7 @@ func (a *Client) GetTagDefinition(ctx context.Context, params *GetTagDefinitionP
 /*
   GetTagDefinitionAuditLogsWithHistory retrieves tag definition audit logs with history by id
 */
-func (a *Client) GetTagDefinitionAuditLogsWithHistory(ctx context.Context, params *GetTagDefinitionAuditLogsWithHistoryParams) (*GetTagDefinitionAuditLogsWithHistoryOK, error) {
+func (a *Client) GetTagDefinitionAuditLogsWithHistory(ctx context.Context, params *GetTagDefinitionAuditLogsWithHistoryParams, opts ...ClientOption) (*GetTagDefinitionAuditLogsWithHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTagDefinitionAuditLogsWithHistoryParams()
@@ -276,6 +290,9 @@ func (a *Client) GetTagDefinitionAuditLogsWithHistory(ctx context.Context, param
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -295,7 +312,7 @@ func (a *Client) GetTagDefinitionAuditLogsWithHistory(ctx context.Context, param
 /*
   GetTagDefinitions lists tag definitions
 */
-func (a *Client) GetTagDefinitions(ctx context.Context, params *GetTagDefinitionsParams) (*GetTagDefinitionsOK, error) {
+func (a *Client) GetTagDefinitions(ctx context.Context, params *GetTagDefinitionsParams, opts ...ClientOption) (*GetTagDefinitionsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTagDefinitionsParams()
@@ -322,6 +339,9 @@ func (a *Client) GetTagDefinitions(ctx context.Context, params *GetTagDefinition
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -53,43 +53,43 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateTenant(ctx context.Context, params *CreateTenantParams) (*CreateTenantCreated, error)
+	CreateTenant(ctx context.Context, params *CreateTenantParams, opts ...ClientOption) (*CreateTenantCreated, error)
 
-	DeletePerTenantConfiguration(ctx context.Context, params *DeletePerTenantConfigurationParams) (*DeletePerTenantConfigurationNoContent, error)
+	DeletePerTenantConfiguration(ctx context.Context, params *DeletePerTenantConfigurationParams, opts ...ClientOption) (*DeletePerTenantConfigurationNoContent, error)
 
-	DeletePluginConfiguration(ctx context.Context, params *DeletePluginConfigurationParams) (*DeletePluginConfigurationNoContent, error)
+	DeletePluginConfiguration(ctx context.Context, params *DeletePluginConfigurationParams, opts ...ClientOption) (*DeletePluginConfigurationNoContent, error)
 
-	DeletePluginPaymentStateMachineConfig(ctx context.Context, params *DeletePluginPaymentStateMachineConfigParams) (*DeletePluginPaymentStateMachineConfigNoContent, error)
+	DeletePluginPaymentStateMachineConfig(ctx context.Context, params *DeletePluginPaymentStateMachineConfigParams, opts ...ClientOption) (*DeletePluginPaymentStateMachineConfigNoContent, error)
 
-	DeletePushNotificationCallbacks(ctx context.Context, params *DeletePushNotificationCallbacksParams) (*DeletePushNotificationCallbacksNoContent, error)
+	DeletePushNotificationCallbacks(ctx context.Context, params *DeletePushNotificationCallbacksParams, opts ...ClientOption) (*DeletePushNotificationCallbacksNoContent, error)
 
-	DeleteUserKeyValue(ctx context.Context, params *DeleteUserKeyValueParams) (*DeleteUserKeyValueNoContent, error)
+	DeleteUserKeyValue(ctx context.Context, params *DeleteUserKeyValueParams, opts ...ClientOption) (*DeleteUserKeyValueNoContent, error)
 
-	GetAllPluginConfiguration(ctx context.Context, params *GetAllPluginConfigurationParams) (*GetAllPluginConfigurationOK, error)
+	GetAllPluginConfiguration(ctx context.Context, params *GetAllPluginConfigurationParams, opts ...ClientOption) (*GetAllPluginConfigurationOK, error)
 
-	GetPerTenantConfiguration(ctx context.Context, params *GetPerTenantConfigurationParams) (*GetPerTenantConfigurationOK, error)
+	GetPerTenantConfiguration(ctx context.Context, params *GetPerTenantConfigurationParams, opts ...ClientOption) (*GetPerTenantConfigurationOK, error)
 
-	GetPluginConfiguration(ctx context.Context, params *GetPluginConfigurationParams) (*GetPluginConfigurationOK, error)
+	GetPluginConfiguration(ctx context.Context, params *GetPluginConfigurationParams, opts ...ClientOption) (*GetPluginConfigurationOK, error)
 
-	GetPluginPaymentStateMachineConfig(ctx context.Context, params *GetPluginPaymentStateMachineConfigParams) (*GetPluginPaymentStateMachineConfigOK, error)
+	GetPluginPaymentStateMachineConfig(ctx context.Context, params *GetPluginPaymentStateMachineConfigParams, opts ...ClientOption) (*GetPluginPaymentStateMachineConfigOK, error)
 
-	GetPushNotificationCallbacks(ctx context.Context, params *GetPushNotificationCallbacksParams) (*GetPushNotificationCallbacksOK, error)
+	GetPushNotificationCallbacks(ctx context.Context, params *GetPushNotificationCallbacksParams, opts ...ClientOption) (*GetPushNotificationCallbacksOK, error)
 
-	GetTenant(ctx context.Context, params *GetTenantParams) (*GetTenantOK, error)
+	GetTenant(ctx context.Context, params *GetTenantParams, opts ...ClientOption) (*GetTenantOK, error)
 
-	GetTenantByAPIKey(ctx context.Context, params *GetTenantByAPIKeyParams) (*GetTenantByAPIKeyOK, error)
+	GetTenantByAPIKey(ctx context.Context, params *GetTenantByAPIKeyParams, opts ...ClientOption) (*GetTenantByAPIKeyOK, error)
 
-	GetUserKeyValue(ctx context.Context, params *GetUserKeyValueParams) (*GetUserKeyValueOK, error)
+	GetUserKeyValue(ctx context.Context, params *GetUserKeyValueParams, opts ...ClientOption) (*GetUserKeyValueOK, error)
 
-	InsertUserKeyValue(ctx context.Context, params *InsertUserKeyValueParams) (*InsertUserKeyValueCreated, error)
+	InsertUserKeyValue(ctx context.Context, params *InsertUserKeyValueParams, opts ...ClientOption) (*InsertUserKeyValueCreated, error)
 
-	RegisterPushNotificationCallback(ctx context.Context, params *RegisterPushNotificationCallbackParams) (*RegisterPushNotificationCallbackCreated, error)
+	RegisterPushNotificationCallback(ctx context.Context, params *RegisterPushNotificationCallbackParams, opts ...ClientOption) (*RegisterPushNotificationCallbackCreated, error)
 
-	UploadPerTenantConfiguration(ctx context.Context, params *UploadPerTenantConfigurationParams) (*UploadPerTenantConfigurationCreated, error)
+	UploadPerTenantConfiguration(ctx context.Context, params *UploadPerTenantConfigurationParams, opts ...ClientOption) (*UploadPerTenantConfigurationCreated, error)
 
-	UploadPluginConfiguration(ctx context.Context, params *UploadPluginConfigurationParams) (*UploadPluginConfigurationCreated, error)
+	UploadPluginConfiguration(ctx context.Context, params *UploadPluginConfigurationParams, opts ...ClientOption) (*UploadPluginConfigurationCreated, error)
 
-	UploadPluginPaymentStateMachineConfig(ctx context.Context, params *UploadPluginPaymentStateMachineConfigParams) (*UploadPluginPaymentStateMachineConfigCreated, error)
+	UploadPluginPaymentStateMachineConfig(ctx context.Context, params *UploadPluginPaymentStateMachineConfigParams, opts ...ClientOption) (*UploadPluginPaymentStateMachineConfigCreated, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -97,7 +97,7 @@ type ClientService interface {
 /*
   CreateTenant creates a tenant
 */
-func (a *Client) CreateTenant(ctx context.Context, params *CreateTenantParams) (*CreateTenantCreated, error) {
+func (a *Client) CreateTenant(ctx context.Context, params *CreateTenantParams, opts ...ClientOption) (*CreateTenantCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewCreateTenantParams()
@@ -139,6 +139,9 @@ func (a *Client) CreateTenant(ctx context.Context, params *CreateTenantParams) (
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -150,7 +153,7 @@ func (a *Client) CreateTenant(ctx context.Context, params *CreateTenantParams) (
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "createTenant",
 		Method:             "GET",
 		PathPattern:        location,
@@ -162,7 +165,12 @@ func (a *Client) CreateTenant(ctx context.Context, params *CreateTenantParams) (
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +181,7 @@ func (a *Client) CreateTenant(ctx context.Context, params *CreateTenantParams) (
 /*
   DeletePerTenantConfiguration deletes a per tenant configuration system properties
 */
-func (a *Client) DeletePerTenantConfiguration(ctx context.Context, params *DeletePerTenantConfigurationParams) (*DeletePerTenantConfigurationNoContent, error) {
+func (a *Client) DeletePerTenantConfiguration(ctx context.Context, params *DeletePerTenantConfigurationParams, opts ...ClientOption) (*DeletePerTenantConfigurationNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePerTenantConfigurationParams()
@@ -212,6 +220,9 @@ func (a *Client) DeletePerTenantConfiguration(ctx context.Context, params *Delet
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -231,7 +242,7 @@ func (a *Client) DeletePerTenantConfiguration(ctx context.Context, params *Delet
 /*
   DeletePluginConfiguration deletes a per tenant configuration for a plugin
 */
-func (a *Client) DeletePluginConfiguration(ctx context.Context, params *DeletePluginConfigurationParams) (*DeletePluginConfigurationNoContent, error) {
+func (a *Client) DeletePluginConfiguration(ctx context.Context, params *DeletePluginConfigurationParams, opts ...ClientOption) (*DeletePluginConfigurationNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePluginConfigurationParams()
@@ -270,6 +281,9 @@ func (a *Client) DeletePluginConfiguration(ctx context.Context, params *DeletePl
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -289,7 +303,7 @@ func (a *Client) DeletePluginConfiguration(ctx context.Context, params *DeletePl
 /*
   DeletePluginPaymentStateMachineConfig deletes a per tenant payment state machine for a plugin
 */
-func (a *Client) DeletePluginPaymentStateMachineConfig(ctx context.Context, params *DeletePluginPaymentStateMachineConfigParams) (*DeletePluginPaymentStateMachineConfigNoContent, error) {
+func (a *Client) DeletePluginPaymentStateMachineConfig(ctx context.Context, params *DeletePluginPaymentStateMachineConfigParams, opts ...ClientOption) (*DeletePluginPaymentStateMachineConfigNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePluginPaymentStateMachineConfigParams()
@@ -328,6 +342,9 @@ func (a *Client) DeletePluginPaymentStateMachineConfig(ctx context.Context, para
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -347,7 +364,7 @@ func (a *Client) DeletePluginPaymentStateMachineConfig(ctx context.Context, para
 /*
   DeletePushNotificationCallbacks deletes a push notification
 */
-func (a *Client) DeletePushNotificationCallbacks(ctx context.Context, params *DeletePushNotificationCallbacksParams) (*DeletePushNotificationCallbacksNoContent, error) {
+func (a *Client) DeletePushNotificationCallbacks(ctx context.Context, params *DeletePushNotificationCallbacksParams, opts ...ClientOption) (*DeletePushNotificationCallbacksNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeletePushNotificationCallbacksParams()
@@ -386,6 +403,9 @@ func (a *Client) DeletePushNotificationCallbacks(ctx context.Context, params *De
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -405,7 +425,7 @@ func (a *Client) DeletePushNotificationCallbacks(ctx context.Context, params *De
 /*
   DeleteUserKeyValue deletes a per tenant user key value
 */
-func (a *Client) DeleteUserKeyValue(ctx context.Context, params *DeleteUserKeyValueParams) (*DeleteUserKeyValueNoContent, error) {
+func (a *Client) DeleteUserKeyValue(ctx context.Context, params *DeleteUserKeyValueParams, opts ...ClientOption) (*DeleteUserKeyValueNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteUserKeyValueParams()
@@ -444,6 +464,9 @@ func (a *Client) DeleteUserKeyValue(ctx context.Context, params *DeleteUserKeyVa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -463,7 +486,7 @@ func (a *Client) DeleteUserKeyValue(ctx context.Context, params *DeleteUserKeyVa
 /*
   GetAllPluginConfiguration retrieves a per tenant key value based on key prefix
 */
-func (a *Client) GetAllPluginConfiguration(ctx context.Context, params *GetAllPluginConfigurationParams) (*GetAllPluginConfigurationOK, error) {
+func (a *Client) GetAllPluginConfiguration(ctx context.Context, params *GetAllPluginConfigurationParams, opts ...ClientOption) (*GetAllPluginConfigurationOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAllPluginConfigurationParams()
@@ -490,6 +513,9 @@ func (a *Client) GetAllPluginConfiguration(ctx context.Context, params *GetAllPl
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -509,7 +535,7 @@ func (a *Client) GetAllPluginConfiguration(ctx context.Context, params *GetAllPl
 /*
   GetPerTenantConfiguration retrieves a per tenant configuration system properties
 */
-func (a *Client) GetPerTenantConfiguration(ctx context.Context, params *GetPerTenantConfigurationParams) (*GetPerTenantConfigurationOK, error) {
+func (a *Client) GetPerTenantConfiguration(ctx context.Context, params *GetPerTenantConfigurationParams, opts ...ClientOption) (*GetPerTenantConfigurationOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPerTenantConfigurationParams()
@@ -536,6 +562,9 @@ func (a *Client) GetPerTenantConfiguration(ctx context.Context, params *GetPerTe
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -555,7 +584,7 @@ func (a *Client) GetPerTenantConfiguration(ctx context.Context, params *GetPerTe
 /*
   GetPluginConfiguration retrieves a per tenant configuration for a plugin
 */
-func (a *Client) GetPluginConfiguration(ctx context.Context, params *GetPluginConfigurationParams) (*GetPluginConfigurationOK, error) {
+func (a *Client) GetPluginConfiguration(ctx context.Context, params *GetPluginConfigurationParams, opts ...ClientOption) (*GetPluginConfigurationOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPluginConfigurationParams()
@@ -582,6 +611,9 @@ func (a *Client) GetPluginConfiguration(ctx context.Context, params *GetPluginCo
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -601,7 +633,7 @@ func (a *Client) GetPluginConfiguration(ctx context.Context, params *GetPluginCo
 /*
   GetPluginPaymentStateMachineConfig retrieves a per tenant payment state machine for a plugin
 */
-func (a *Client) GetPluginPaymentStateMachineConfig(ctx context.Context, params *GetPluginPaymentStateMachineConfigParams) (*GetPluginPaymentStateMachineConfigOK, error) {
+func (a *Client) GetPluginPaymentStateMachineConfig(ctx context.Context, params *GetPluginPaymentStateMachineConfigParams, opts ...ClientOption) (*GetPluginPaymentStateMachineConfigOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPluginPaymentStateMachineConfigParams()
@@ -628,6 +660,9 @@ func (a *Client) GetPluginPaymentStateMachineConfig(ctx context.Context, params
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -647,7 +682,7 @@ func (a *Client) GetPluginPaymentStateMachineConfig(ctx context.Context, params
 /*
   GetPushNotificationCallbacks retrieves a push notification
 */
-func (a *Client) GetPushNotificationCallbacks(ctx context.Context, params *GetPushNotificationCallbacksParams) (*GetPushNotificationCallbacksOK, error) {
+func (a *Client) GetPushNotificationCallbacks(ctx context.Context, params *GetPushNotificationCallbacksParams, opts ...ClientOption) (*GetPushNotificationCallbacksOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetPushNotificationCallbacksParams()
@@ -674,6 +709,9 @@ func (a *Client) GetPushNotificationCallbacks(ctx context.Context, params *GetPu
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -693,7 +731,7 @@ func (a *Client) GetPushNotificationCallbacks(ctx context.Context, params *GetPu
 /*
   GetTenant retrieves a tenant by id
 */
-func (a *Client) GetTenant(ctx context.Context, params *GetTenantParams) (*GetTenantOK, error) {
+func (a *Client) GetTenant(ctx context.Context, params *GetTenantParams, opts ...ClientOption) (*GetTenantOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTenantParams()
@@ -720,6 +758,9 @@ func (a *Client) GetTenant(ctx context.Context, params *GetTenantParams) (*GetTe
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -739,7 +780,7 @@ func (a *Client) GetTenant(ctx context.Context, params *GetTenantParams) (*GetTe
 /*
   GetTenantByAPIKey retrieves a tenant by its API key
 */
-func (a *Client) GetTenantByAPIKey(ctx context.Context, params *GetTenantByAPIKeyParams) (*GetTenantByAPIKeyOK, error) {
+func (a *Client) GetTenantByAPIKey(ctx context.Context, params *GetTenantByAPIKeyParams, opts ...ClientOption) (*GetTenantByAPIKeyOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetTenantByAPIKeyParams()
@@ -766,6 +807,9 @@ func (a *Client) GetTenantByAPIKey(ctx context.Context, params *GetTenantByAPIKe
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -785,7 +829,7 @@ func (a *Client) GetTenantByAPIKey(ctx context.Context, params *GetTenantByAPIKe
 /*
   GetUserKeyValue retrieves a per tenant user key value
 */
-func (a *Client) GetUserKeyValue(ctx context.Context, params *GetUserKeyValueParams) (*GetUserKeyValueOK, error) {
+func (a *Client) GetUserKeyValue(ctx context.Context, params *GetUserKeyValueParams, opts ...ClientOption) (*GetUserKeyValueOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetUserKeyValueParams()
@@ -812,6 +856,9 @@ func (a *Client) GetUserKeyValue(ctx context.Context, params *GetUserKeyValuePar
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -831,7 +878,7 @@ func (a *Client) GetUserKeyValue(ctx context.Context, params *GetUserKeyValuePar
 /*
   InsertUserKeyValue adds a per tenant user key value
 */
-func (a *Client) InsertUserKeyValue(ctx context.Context, params *InsertUserKeyValueParams) (*InsertUserKeyValueCreated, error) {
+func (a *Client) InsertUserKeyValue(ctx context.Context, params *InsertUserKeyValueParams, opts ...ClientOption) (*InsertUserKeyValueCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewInsertUserKeyValueParams()
@@ -873,6 +920,9 @@ func (a *Client) InsertUserKeyValue(ctx context.Context, params *InsertUserKeyVa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -884,7 +934,7 @@ func (a *Client) InsertUserKeyValue(ctx context.Context, params *InsertUserKeyVa
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "insertUserKeyValue",
 		Method:             "GET",
 		PathPattern:        location,
@@ -896,7 +946,12 @@ func (a *Client) InsertUserKeyValue(ctx context.Context, params *InsertUserKeyVa
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -907,7 +962,7 @@ func (a *Client) InsertUserKeyValue(ctx context.Context, params *InsertUserKeyVa
 /*
   RegisterPushNotificationCallback creates a push notification
 */
-func (a *Client) RegisterPushNotificationCallback(ctx context.Context, params *RegisterPushNotificationCallbackParams) (*RegisterPushNotificationCallbackCreated, error) {
+func (a *Client) RegisterPushNotificationCallback(ctx context.Context, params *RegisterPushNotificationCallbackParams, opts ...ClientOption) (*RegisterPushNotificationCallbackCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRegisterPushNotificationCallbackParams()
@@ -949,6 +1004,9 @@ func (a *Client) RegisterPushNotificationCallback(ctx context.Context, params *R
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -960,7 +1018,7 @@ func (a *Client) RegisterPushNotificationCallback(ctx context.Context, params *R
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "registerPushNotificationCallback",
 		Method:             "GET",
 		PathPattern:        location,
@@ -972,7 +1030,12 @@ func (a *Client) RegisterPushNotificationCallback(ctx context.Context, params *R
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -983,7 +1046,7 @@ func (a *Client) RegisterPushNotificationCallback(ctx context.Context, params *R
 /*
   UploadPerTenantConfiguration adds a per tenant configuration system properties
 */
-func (a *Client) UploadPerTenantConfiguration(ctx context.Context, params *UploadPerTenantConfigurationParams) (*UploadPerTenantConfigurationCreated, error) {
+func (a *Client) UploadPerTenantConfiguration(ctx context.Context, params *UploadPerTenantConfigurationParams, opts ...ClientOption) (*UploadPerTenantConfigurationCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadPerTenantConfigurationParams()
@@ -1025,6 +1088,9 @@ func (a *Client) UploadPerTenantConfiguration(ctx context.Context, params *Uploa
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1036,7 +1102,7 @@ func (a *Client) UploadPerTenantConfiguration(ctx context.Context, params *Uploa
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadPerTenantConfiguration",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1048,7 +1114,12 @@ func (a *Client) UploadPerTenantConfiguration(ctx context.Context, params *Uploa
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -1059,7 +1130,7 @@ func (a *Client) UploadPerTenantConfiguration(ctx context.Context, params *Uploa
 /*
   UploadPluginConfiguration adds a per tenant configuration for a plugin
 */
-func (a *Client) UploadPluginConfiguration(ctx context.Context, params *UploadPluginConfigurationParams) (*UploadPluginConfigurationCreated, error) {
+func (a *Client) UploadPluginConfiguration(ctx context.Context, params *UploadPluginConfigurationParams, opts ...ClientOption) (*UploadPluginConfigurationCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadPluginConfigurationParams()
@@ -1101,6 +1172,9 @@ func (a *Client) UploadPluginConfiguration(ctx context.Context, params *UploadPl
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1112,7 +1186,7 @@ func (a *Client) UploadPluginConfiguration(ctx context.Context, params *UploadPl
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadPluginConfiguration",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1124,7 +1198,12 @@ func (a *Client) UploadPluginConfiguration(ctx context.Context, params *UploadPl
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -1135,7 +1214,7 @@ func (a *Client) UploadPluginConfiguration(ctx context.Context, params *UploadPl
 /*
   UploadPluginPaymentStateMachineConfig adds a per tenant payment state machine for a plugin
 */
-func (a *Client) UploadPluginPaymentStateMachineConfig(ctx context.Context, params *UploadPluginPaymentStateMachineConfigParams) (*UploadPluginPaymentStateMachineConfigCreated, error) {
+func (a *Client) UploadPluginPaymentStateMachineConfig(ctx context.Context, params *UploadPluginPaymentStateMachineConfigParams, opts ...ClientOption) (*UploadPluginPaymentStateMachineConfigCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUploadPluginPaymentStateMachineConfigParams()
@@ -1177,6 +1256,9 @@ func (a *Client) UploadPluginPaymentStateMachineConfig(ctx context.Context, para
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -1188,7 +1270,7 @@ func (a *Client) UploadPluginPaymentStateMachineConfig(ctx context.Context, para
 		return createdResult, nil
 	}
 
-	getResult, err := a.transport.Submit(&runtime.ClientOperation{
+	getOp := &runtime.ClientOperation{
 		ID:                 "uploadPluginPaymentStateMachineConfig",
 		Method:             "GET",
 		PathPattern:        location,
@@ -1200,7 +1282,12 @@ func (a *Client) UploadPluginPaymentStateMachineConfig(ctx context.Context, para
 		AuthInfo:           a.authInfo,
 		Context:            getParams.Context,
 		Client:             getParams.HTTPClient,
-	})
+	}
+	for _, opt := range opts {
+		opt(getOp)
+	}
+
+	getResult, err := a.transport.Submit(getOp)
 	if err != nil {
 		return nil, err
 	}
@@ -52,11 +52,11 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetAllUsage(ctx context.Context, params *GetAllUsageParams) (*GetAllUsageOK, error)
+	GetAllUsage(ctx context.Context, params *GetAllUsageParams, opts ...ClientOption) (*GetAllUsageOK, error)
 
-	GetUsage(ctx context.Context, params *GetUsageParams) (*GetUsageOK, error)
+	GetUsage(ctx context.Context, params *GetUsageParams, opts ...ClientOption) (*GetUsageOK, error)
 
-	RecordUsage(ctx context.Context, params *RecordUsageParams) (*RecordUsageOK, error)
+	RecordUsage(ctx context.Context, params *RecordUsageParams, opts ...ClientOption) (*RecordUsageOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -64,7 +64,7 @@ type ClientService interface {
 /*
   GetAllUsage retrieves usage for a subscription
 */
-func (a *Client) GetAllUsage(ctx context.Context, params *GetAllUsageParams) (*GetAllUsageOK, error) {
+func (a *Client) GetAllUsage(ctx context.Context, params *GetAllUsageParams, opts ...ClientOption) (*GetAllUsageOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetAllUsageParams()
@@ -91,6 +91,9 @@ func (a *Client) GetAllUsage(ctx context.Context, params *GetAllUsageParams) (*G
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -110,7 +113,7 @@ func (a *Client) GetAllUsage(ctx context.Context, params *GetAllUsageParams) (*G
 /*
   GetUsage retrieves usage for a subscription and unit type
 */
-func (a *Client) GetUsage(ctx context.Context, params *GetUsageParams) (*GetUsageOK, error) {
+func (a *Client) GetUsage(ctx context.Context, params *GetUsageParams, opts ...ClientOption) (*GetUsageOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetUsageParams()
@@ -137,6 +140,9 @@ func (a *Client) GetUsage(ctx context.Context, params *GetUsageParams) (*GetUsag
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -156,7 +162,7 @@ func (a *Client) GetUsage(ctx context.Context, params *GetUsageParams) (*GetUsag
 /*
   RecordUsage records usage for a subscription
 */
-func (a *Client) RecordUsage(ctx context.Context, params *RecordUsageParams) (*RecordUsageOK, error) {
+func (a *Client) RecordUsage(ctx context.Context, params *RecordUsageParams, opts ...ClientOption) (*RecordUsageOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewRecordUsageParams()
@@ -195,6 +201,9 @@ func (a *Client) RecordUsage(ctx context.Context, params *RecordUsageParams) (*R
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
 	result, err := a.transport.Submit(op)
 	if err != nil {
@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
 )
 
 var defaultProperties = map[string]bool{
@@ -136,6 +138,8 @@ func loadPropertiesFromInput(obj interface{}, properties Properties, inputs Inpu
 		}
 	}
 
+	// Invalid and missing properties are all reported at once.
+	var fieldErrors []kbcommon.FieldError
 	suppliedProperties := map[string]bool{}
 	for _, inp := range inputs {
 		suppliedProperties[inp.KeyLower()] = true
@@ -144,19 +148,18 @@ func loadPropertiesFromInput(obj interface{}, properties Properties, inputs Inpu
 			return fmt.Errorf("property %s not found", inp.Key)
 		}
 		if err := loadProperty(val, p.Name, inp.Value); err != nil {
-			return err
+			fieldErrors = append(fieldErrors, kbcommon.FieldError{Field: p.Name, Message: err.Error()})
 		}
 	}
 
-	missingProperties := []string{}
 	for _, p := range properties {
 		if p.Required && !suppliedProperties[p.NameLower()] {
-			missingProperties = append(missingProperties, p.Name)
+			fieldErrors = append(fieldErrors, kbcommon.FieldError{Field: p.Name, Message: "is required"})
 		}
 	}
 
-	if len(missingProperties) > 0 {
-		return fmt.Errorf("Required properties are missing: %s", strings.Join(missingProperties, ","))
+	if len(fieldErrors) > 0 {
+		return &kbcommon.ValidationError{Fields: fieldErrors}
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package args
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -8,6 +9,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
 )
 
 type TestEnum string
@@ -115,7 +118,7 @@ func TestLoadProperties_InvalidEnum(t *testing.T) {
 		t.Fatal("expecting error, got nil instead")
 	}
 
-	expError := "Invalid value FOO1 for enum"
+	expError := "kbcli: invalid request: Enum: Invalid value FOO1 for enum"
 	if err.Error() != expError {
 		t.Fatalf("Expecting: %v, Got: %v", expError, err.Error())
 	}
@@ -133,13 +136,35 @@ func TestLoadProperties_InvalidEnumPtr(t *testing.T) {
 		t.Fatal("expecting error, got nil instead")
 	}
 
-	expError := "Invalid value FOO1 for enum"
+	expError := "kbcli: invalid request: EnumPtr: Invalid value FOO1 for enum"
 	if err.Error() != expError {
 		t.Fatalf("Expecting: %v, Got: %v", expError, err.Error())
 	}
 
 }
 
+func TestLoadProperties_InvalidProperties(t *testing.T) {
+	inputs := []Input{
+		{Key: "AccountID", Value: "123"},
+		{Key: "UniqueID", Value: "john"},
+		{Key: "IsDefault", Value: "maybe"},
+	}
+	obj := testObj{}
+	err := loadPropertiesFromInput(&obj, propertyList, inputs)
+	var validationErr *kbcommon.ValidationError
+	if !errors.As(err, &validationErr) || !errors.Is(err, kbcommon.ErrValidation) {
+		t.Fatalf("expecting validation error, got %v", err)
+	}
+	expected := []kbcommon.FieldError{
+		{Field: "UniqueID", Message: "Value john is not UUID"},
+		{Field: "IsDefault", Message: `strconv.ParseBool: parsing "maybe": invalid syntax`},
+		{Field: "ParentID", Message: "is required"},
+	}
+	if diff := cmp.Diff(expected, validationErr.Fields); diff != "" {
+		t.Fatalf("unexpected errors (-want +got):\n%s", diff)
+	}
+}
+
 func TestGetProperties(t *testing.T) {
 	result := GetProperties(&testObj{})
 	exp := []Property{
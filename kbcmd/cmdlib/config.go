@@ -0,0 +1,273 @@
+package cmdlib
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the kbcmd configuration file, for ex.:
+//
+//	current_profile: local
+//	profiles:
+//	  local:
+//	    host: 127.0.0.1:8080
+//	    scheme: http
+//	    api_key: bob
+//	    api_secret: lazar
+//	  prod-eu:
+//	    url: https://kb.example.com/killbill
+//	    auth: session
+//	    user: jane
+//	    password:
+//	      command: pass show kb/prod
+//	    api_key: acme
+//	    api_secret:
+//	      file: ~/.config/kbcmd/acme.secret
+//	    read_only: true
+type Config struct {
+	// CurrentProfile - profile used when --profile is not set.
+	CurrentProfile string `yaml:"current_profile,omitempty"`
+
+	Profiles map[string]*Profile `yaml:"profiles,omitempty"`
+
+	// Views - see View.
+	Views map[string]*View `yaml:"views,omitempty"`
+}
+
+// Profile holds the connection settings of a kill bill instance and tenant. The global flags and
+// their environment variables override the profile values.
+type Profile struct {
+	Host   string `yaml:"host,omitempty"`
+	URL    string `yaml:"url,omitempty"`
+	Scheme string `yaml:"scheme,omitempty"`
+
+	// Auth - authentication scheme, one of basic, session, bearer.
+	Auth        string `yaml:"auth,omitempty"`
+	User        string `yaml:"user,omitempty"`
+	Password    Secret `yaml:"password,omitempty"`
+	BearerToken Secret `yaml:"bearer_token,omitempty"`
+
+	APIKey    string `yaml:"api_key,omitempty"`
+	APISecret Secret `yaml:"api_secret,omitempty"`
+
+	CreatedBy string `yaml:"created_by,omitempty"`
+	Format    string `yaml:"format,omitempty"`
+
+	// ReadOnly rejects the mutating requests, except the operations in ReadOnlyAllow.
+	ReadOnly      bool     `yaml:"read_only,omitempty"`
+	ReadOnlyAllow []string `yaml:"read_only_allow,omitempty"`
+}
+
+// Secret is a plaintext value, or read from a file or the output of a command. In the configuration
+// file, plaintext secrets are strings, the others are objects with a file or a command.
+type Secret struct {
+	Value   string `yaml:"value,omitempty"`
+	File    string `yaml:"file,omitempty"`
+	Command string `yaml:"command,omitempty"`
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *Secret) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value string
+	if err := unmarshal(&value); err == nil {
+		*s = Secret{Value: value}
+		return nil
+	}
+	type plain Secret
+	return unmarshal((*plain)(s))
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s Secret) MarshalYAML() (interface{}, error) {
+	if s.File == "" && s.Command == "" {
+		return s.Value, nil
+	}
+	type plain Secret
+	return plain(s), nil
+}
+
+// IsZero returns true if the secret is not set.
+func (s Secret) IsZero() bool {
+	return s == Secret{}
+}
+
+// Redacted returns the secret with its plaintext value masked.
+func (s Secret) Redacted() Secret {
+	if s.Value != "" {
+		s.Value = "********"
+	}
+	return s
+}
+
+// Resolve returns the value of the secret. Trailing new lines of files and command outputs are removed.
+func (s Secret) Resolve() (string, error) {
+	switch {
+	case s.Command != "":
+		cmd := exec.Command("sh", "-c", s.Command)
+		cmd.Stderr = os.Stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("secret command %q failed: %v", s.Command, err)
+		}
+		return strings.TrimRight(string(out), "\r\n"), nil
+	case s.File != "":
+		data, err := ioutil.ReadFile(expandHome(s.File))
+		if err != nil {
+			return "", fmt.Errorf("can't read secret: %v", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	default:
+		return s.Value, nil
+	}
+}
+
+// Redacted returns the profile with its plaintext secrets masked.
+func (p Profile) Redacted() Profile {
+	p.Password = p.Password.Redacted()
+	p.BearerToken = p.BearerToken.Redacted()
+	p.APISecret = p.APISecret.Redacted()
+	return p
+}
+
+// DefaultConfigFile returns $XDG_CONFIG_HOME/kbcmd/config.yaml, or ~/.config/kbcmd/config.yaml.
+func DefaultConfigFile() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "kbcmd", "config.yaml")
+}
+
+// LoadConfig reads the configuration file. A missing file is an empty configuration.
+func LoadConfig(file string) (*Config, error) {
+	conf := &Config{}
+	if file == "" {
+		return conf, nil
+	}
+	data, err := ioutil.ReadFile(expandHome(file))
+	if os.IsNotExist(err) {
+		return conf, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, conf); err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", file, err)
+	}
+	return conf, nil
+}
+
+// Save writes the configuration file. It is only readable by the user, as it may hold secrets.
+func (c *Config) Save(file string) error {
+	if file == "" {
+		return fmt.Errorf("no configuration file, use --config")
+	}
+	file = expandHome(file)
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0600)
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
+
+// pendingSecret is a secret of the profile, resolved when a command calls kill bill.
+type pendingSecret struct {
+	dst    *string
+	secret Secret
+}
+
+// applyProfile loads the configuration file, and sets the options of the selected profile that
+// aren't set with flags or environment variables.
+func (r *App) applyProfile(c *cli.Context) error {
+	conf, err := LoadConfig(r.o.ConfigFile)
+	if err != nil {
+		return err
+	}
+	r.o.config = conf
+	r.secrets = nil
+	r.profileErr = nil
+
+	name := r.o.ProfileName
+	if name == "" {
+		name = conf.CurrentProfile
+	}
+	if name == "" {
+		return nil
+	}
+	p, ok := conf.Profiles[name]
+	if !ok {
+		// Reported by the commands calling kill bill, so that the profile commands can fix it.
+		r.profileErr = fmt.Errorf("profile %q not found in %s", name, r.o.ConfigFile)
+		return nil
+	}
+	r.o.ProfileName = name
+
+	set := func(flag string, dst *string, value string) {
+		if value != "" && !c.IsSet(flag) {
+			*dst = value
+		}
+	}
+	set("host", &r.o.Host, p.Host)
+	set("url", &r.o.URL, p.URL)
+	set("transport_scheme", &r.o.TransportScheme, p.Scheme)
+	set("auth", &r.o.Auth, p.Auth)
+	set("user", &r.o.Username, p.User)
+	set("api_key", &r.o.APIKey, p.APIKey)
+	set("created_by", &r.o.CreatedBy, p.CreatedBy)
+	set("format", &formatStr, p.Format)
+	if p.ReadOnly && !c.IsSet("read_only") {
+		r.o.ReadOnly = true
+	}
+	set("read_only_allow", &r.o.ReadOnlyAllow, strings.Join(p.ReadOnlyAllow, ","))
+
+	secret := func(flag string, dst *string, s Secret) {
+		if !s.IsZero() && !c.IsSet(flag) {
+			r.secrets = append(r.secrets, pendingSecret{dst: dst, secret: s})
+		}
+	}
+	secret("password", &r.o.Password, p.Password)
+	secret("bearer_token", &r.o.BearerToken, p.BearerToken)
+	secret("api_secret", &r.o.APISecret, p.APISecret)
+	return nil
+}
+
+// resolveSecrets resolves the secrets of the profile.
+func (r *App) resolveSecrets() error {
+	if r.profileErr != nil {
+		return r.profileErr
+	}
+	for _, s := range r.secrets {
+		value, err := s.secret.Resolve()
+		if err != nil {
+			return fmt.Errorf("profile %s: %v", r.o.ProfileName, err)
+		}
+		*s.dst = value
+	}
+	r.secrets = nil
+	return nil
+}
@@ -0,0 +1,161 @@
+package cmdlib
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/urfave/cli"
+)
+
+func TestConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kbcmd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "kbcmd", "config.yaml")
+
+	conf, err := LoadConfig(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&Config{}, conf); diff != "" {
+		t.Fatalf("expecting an empty configuration (-want +got):\n%s", diff)
+	}
+
+	secretFile := filepath.Join(dir, "secret")
+	if err := ioutil.WriteFile(secretFile, []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	conf = &Config{
+		CurrentProfile: "prod",
+		Profiles: map[string]*Profile{
+			"local": {Host: "127.0.0.1:8080", APIKey: "bob", APISecret: Secret{Value: "lazar"}},
+			"prod": {
+				URL:           "https://kb.example.com/killbill",
+				Password:      Secret{Command: "echo from-command"},
+				APIKey:        "acme",
+				APISecret:     Secret{File: secretFile},
+				ReadOnly:      true,
+				ReadOnlyAllow: []string{"generateDryRunInvoice"},
+			},
+		},
+	}
+	if err := conf.Save(file); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadConfig(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(conf, loaded); diff != "" {
+		t.Fatalf("unexpected configuration (-want +got):\n%s", diff)
+	}
+
+	for _, s := range []struct {
+		secret   Secret
+		expected string
+	}{
+		{loaded.Profiles["local"].APISecret, "lazar"},
+		{loaded.Profiles["prod"].APISecret, "from-file"},
+		{loaded.Profiles["prod"].Password, "from-command"},
+	} {
+		value, err := s.secret.Resolve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != s.expected {
+			t.Fatalf("expecting %q, got %q", s.expected, value)
+		}
+	}
+	if _, err := (Secret{Command: "exit 1"}).Resolve(); err == nil {
+		t.Fatal("expecting an error for a failed command")
+	}
+	if p := loaded.Profiles["local"].Redacted(); p.APISecret.Value != "********" {
+		t.Fatalf("unexpected redacted secret %+v", p.APISecret)
+	}
+}
+
+func TestApplyProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kbcmd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	conf := &Config{
+		CurrentProfile: "local",
+		Profiles: map[string]*Profile{
+			"local": {Host: "profile:8080", APIKey: "profile-key", CreatedBy: "profile-user", Format: "csv"},
+			"other": {Host: "other:8080"},
+		},
+	}
+	if err := conf.Save(file); err != nil {
+		t.Fatal(err)
+	}
+
+	scenarios := []struct {
+		name     string
+		args     []string
+		env      map[string]string
+		expected Options
+	}{
+		{
+			name:     "profile",
+			expected: Options{Host: "profile:8080", APIKey: "profile-key", CreatedBy: "profile-user", ProfileName: "local", FO: &FormatOptions{Type: FormatTypeCSV}},
+		},
+		{
+			name:     "env over profile",
+			env:      map[string]string{"KB_HOST": "env:8080", "KB_API_KEY": "env-key"},
+			expected: Options{Host: "env:8080", APIKey: "env-key", CreatedBy: "profile-user", ProfileName: "local", FO: &FormatOptions{Type: FormatTypeCSV}},
+		},
+		{
+			name:     "flag over env",
+			args:     []string{"--host", "flag:8080"},
+			env:      map[string]string{"KB_HOST": "env:8080", "KB_API_KEY": "env-key"},
+			expected: Options{Host: "flag:8080", APIKey: "env-key", CreatedBy: "profile-user", ProfileName: "local", FO: &FormatOptions{Type: FormatTypeCSV}},
+		},
+		{
+			name:     "selected profile",
+			args:     []string{"--profile", "other", "--created_by", "flag-user"},
+			expected: Options{Host: "other:8080", APIKey: "bob", CreatedBy: "flag-user", ProfileName: "other", FO: &FormatOptions{}},
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			for _, name := range []string{"KB_HOST", "KB_API_KEY", "KB_API_CREATED_BY", "KB_PROFILE", "KB_CONFIG"} {
+				value, ok := os.LookupEnv(name)
+				os.Unsetenv(name)
+				if ok {
+					defer os.Setenv(name, value)
+				} else {
+					defer os.Unsetenv(name)
+				}
+			}
+			for name, value := range s.env {
+				os.Setenv(name, value)
+			}
+			defer func() { formatStr = "" }()
+
+			var got Options
+			r := NewApp()
+			r.RegisterLocal("", cli.Command{Name: "options"}, func(ctx context.Context, o *Options) error {
+				got = *o
+				return nil
+			})
+			args := append([]string{"kbcmd", "--config", file}, s.args...)
+			if err := r.Run(append(args, "options")); err != nil {
+				t.Fatal(err)
+			}
+			actual := Options{Host: got.Host, APIKey: got.APIKey, CreatedBy: got.CreatedBy, ProfileName: got.ProfileName, FO: got.FO}
+			if diff := cmp.Diff(s.expected, actual, cmpopts.IgnoreUnexported(Options{})); diff != "" {
+				t.Fatalf("unexpected options (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package cmdlib
 
+import "text/template"
+
 // FormatType represents type of format
 type FormatType int
 
@@ -18,6 +20,22 @@ const (
 
 	// FormatTypeFullJSON - Full JSON
 	FormatTypeFullJSON
+
+	// FormatTypeCSV - comma separated values. Sub items rows are records of their own, repeating
+	// the values of their parent row.
+	FormatTypeCSV
+
+	// FormatTypeTSV - tab separated values, with the records of FormatTypeCSV.
+	FormatTypeTSV
+
+	// FormatTypeJSONL - one JSON object per line, with the records of FormatTypeCSV.
+	FormatTypeJSONL
+
+	// FormatTypeYAML - YAML list of items, with their columns and sub items.
+	FormatTypeYAML
+
+	// FormatTypeTemplate - FormatOptions.Template executed for each item.
+	FormatTypeTemplate
 )
 
 // Scan from string
@@ -33,15 +51,39 @@ func (t *FormatType) Scan(str string) {
 		*t = FormatTypeList
 	case "json":
 		*t = FormatTypeFullJSON
+	case "csv":
+		*t = FormatTypeCSV
+	case "tsv":
+		*t = FormatTypeTSV
+	case "jsonl":
+		*t = FormatTypeJSONL
+	case "yaml":
+		*t = FormatTypeYAML
 	}
 }
 
+// isData returns true for the formats read by other programs: values are printed raw, and nil
+// values are empty.
+func (t FormatType) isData() bool {
+	switch t {
+	case FormatTypeCSV, FormatTypeTSV, FormatTypeJSONL, FormatTypeYAML:
+		return true
+	}
+	return false
+}
+
 // FormatOptions - options for formatting
 type FormatOptions struct {
 	Type FormatType
 
-	// NoHeader - skip printing header for tabular, csv format
+	// NoHeader - skip printing header for tabular, csv and tsv formats
 	NoHeader bool
+
+	// Template of FormatTypeTemplate
+	Template *template.Template
+
+	// Query selects the items of lists, if set
+	Query *Query
 }
 
 // CustomFormatter function
@@ -0,0 +1,125 @@
+package cmdlib
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// derivedFormatters caches the formatters derived for the types without a registered one.
+var derivedFormatters = struct {
+	sync.Mutex
+	m map[reflect.Type]*Formatter
+}{m: map[reflect.Type]*Formatter{}}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// derivedFormatter returns the formatter derived from the fields of a struct type, or nil if tp
+// is not a struct, or has nothing to print. See deriveFormatter.
+func derivedFormatter(tp reflect.Type) *Formatter {
+	derivedFormatters.Lock()
+	defer derivedFormatters.Unlock()
+	f, ok := derivedFormatters.m[tp]
+	if !ok {
+		f = deriveFormatter(tp)
+		derivedFormatters.m[tp] = f
+	}
+	return f
+}
+
+// deriveFormatter builds a formatter from the json tags of a struct type: scalar fields are
+// columns, named after their json name in upper snake case (accountId is ACCOUNT_ID), and slices
+// of structs are sub items, formatted with the formatter of their type. Other fields are skipped.
+func deriveFormatter(tp reflect.Type) *Formatter {
+	if tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	if tp.Kind() != reflect.Struct || isScalar(tp) {
+		return nil
+	}
+	var f Formatter
+	addDerivedFields(&f, tp, "", true)
+	if len(f.Columns) == 0 && len(f.SubItems) == 0 {
+		return nil
+	}
+	return &f
+}
+
+// addDerivedFields adds the columns and sub items of the fields of tp. Fields of embedded structs
+// are added as if they were fields of tp. The sub items of unexported embedded structs are skipped,
+// as their fields can't be read.
+func addDerivedFields(f *Formatter, tp reflect.Type, fieldPrefix string, subItems bool) {
+	for i := 0; i < tp.NumField(); i++ {
+		field := tp.Field(i)
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if field.Anonymous && isStruct(ft) && field.Tag.Get("json") == "" {
+			addDerivedFields(f, ft, fieldPrefix+field.Name+".", subItems && field.PkgPath == "")
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		name := field.Name
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		switch {
+		case isScalar(ft):
+			f.Columns = append(f.Columns, Column{Name: upperSnakeCase(name), Path: "$." + name})
+		case subItems && ft.Kind() == reflect.Slice && isStruct(ft.Elem()):
+			f.SubItems = append(f.SubItems, SubItem{Name: upperSnakeCase(name), FieldName: fieldPrefix + field.Name})
+		}
+	}
+}
+
+// isScalar returns true for the types printed as a single value: numbers, strings, booleans, and
+// types marshaled as such, like dates.
+func isScalar(tp reflect.Type) bool {
+	switch tp.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	case reflect.Struct:
+		ptr := reflect.PtrTo(tp)
+		return ptr.Implements(jsonMarshalerType) || ptr.Implements(textMarshalerType)
+	}
+	return false
+}
+
+// isStruct returns true for structs and pointers to structs, other than scalars.
+func isStruct(tp reflect.Type) bool {
+	if tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	return tp.Kind() == reflect.Struct && !isScalar(tp)
+}
+
+// upperSnakeCase converts a json name to a column name, for ex. paymentMethodId to PAYMENT_METHOD_ID.
+func upperSnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
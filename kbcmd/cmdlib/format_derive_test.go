@@ -0,0 +1,120 @@
+package cmdlib
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testAudit struct {
+	ChangedBy string `json:"changedBy"`
+}
+
+type testPayment struct {
+	testAudit
+	PaymentID   string                 `json:"paymentId,omitempty"`
+	Amount      *float64               `json:"amount"`
+	EffectiveAt time.Time              `json:"effectiveAt"`
+	Properties  map[string]interface{} `json:"properties"`
+	Tags        []string               `json:"tags"`
+	Items       []*testItem            `json:"items"`
+	Secret      string                 `json:"-"`
+	note        string
+}
+
+type testRefund struct {
+	RefundID string `json:"refundId"`
+}
+
+// TestInvoiceLines is embedded by value, and exported.
+type TestInvoiceLines struct {
+	Lines []testItem `json:"lines"`
+}
+
+type testStatement struct {
+	TestInvoiceLines
+	InvoiceID string `json:"invoiceId"`
+}
+
+func TestDerivedFormatter(t *testing.T) {
+	f := getFormatter(NewLogger(), []*testPayment{})
+	expected := []Column{
+		{Name: "CHANGED_BY", Path: "$.changedBy"},
+		{Name: "PAYMENT_ID", Path: "$.paymentId"},
+		{Name: "AMOUNT", Path: "$.amount"},
+		{Name: "EFFECTIVE_AT", Path: "$.effectiveAt"},
+	}
+	if diff := cmp.Diff(expected, f.Columns); diff != "" {
+		t.Fatalf("unexpected columns (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]SubItem{{Name: "ITEMS", FieldName: "Items"}}, f.SubItems); diff != "" {
+		t.Fatalf("unexpected sub items (-want +got):\n%s", diff)
+	}
+
+	amount := 12.5
+	payment := &testPayment{
+		testAudit:   testAudit{ChangedBy: "admin"},
+		PaymentID:   "p1",
+		Amount:      &amount,
+		EffectiveAt: time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC),
+		Items:       []*testItem{{Amount: 10}, {Amount: 2.5}},
+	}
+	rows, err := getFormattedOutput(NewLogger(), payment, FormatOptions{Type: FormatTypeCSV}, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(`CHANGED_BY,PAYMENT_ID,AMOUNT,EFFECTIVE_AT,ITEMS.AMOUNT
+admin,p1,12.5,2021-03-01T10:00:00Z,10
+admin,p1,12.5,2021-03-01T10:00:00Z,2.5`, strings.Join(rows, "\n")); diff != "" {
+		t.Fatalf("unexpected output (-want +got):\n%s", diff)
+	}
+
+	// Sub items of exported embedded structs are printed.
+	f = getFormatter(NewLogger(), &testStatement{})
+	if diff := cmp.Diff([]SubItem{{Name: "LINES", FieldName: "TestInvoiceLines.Lines"}}, f.SubItems); diff != "" {
+		t.Fatalf("unexpected sub items (-want +got):\n%s", diff)
+	}
+	statement := &testStatement{InvoiceID: "i1", TestInvoiceLines: TestInvoiceLines{Lines: []testItem{{Amount: 3}, {Amount: 4}}}}
+	rows, err = getFormattedOutput(NewLogger(), statement, FormatOptions{Type: FormatTypeCSV}, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("INVOICE_ID,LINES.AMOUNT\ni1,3\ni1,4", strings.Join(rows, "\n")); diff != "" {
+		t.Fatalf("unexpected output (-want +got):\n%s", diff)
+	}
+
+	// Registered formatters win.
+	tp := reflect.TypeOf(&testRefund{})
+	if f := getFormatter(NewLogger(), &testRefund{}); len(f.Columns) != 1 || f.Columns[0].Name != "REFUND_ID" {
+		t.Fatalf("unexpected derived formatter %+v", f)
+	}
+	AddFormatter(tp, Formatter{Columns: []Column{{Name: "ID", Path: "$.refundId"}}})
+	defer delete(formatRegistry, tp)
+	if f := getFormatter(NewLogger(), &testRefund{}); len(f.Columns) != 1 || f.Columns[0].Name != "ID" {
+		t.Fatalf("expecting the registered formatter, got %+v", f)
+	}
+
+	// Types without fields to print are printed as raw json.
+	if f := getFormatter(NewLogger(), map[string]string{}); f.CustomFn == nil {
+		t.Fatalf("expecting the raw json formatter, got %+v", f)
+	}
+}
+
+func TestUpperSnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{
+		"accountId":                  "ACCOUNT_ID",
+		"paymentMethodId":            "PAYMENT_METHOD_ID",
+		"isPaymentDelegatedToParent": "IS_PAYMENT_DELEGATED_TO_PARENT",
+		"billCycleDayLocal":          "BILL_CYCLE_DAY_LOCAL",
+		"URLPath":                    "URL_PATH",
+		"address1":                   "ADDRESS1",
+		"Name":                       "NAME",
+	} {
+		if got := upperSnakeCase(name); got != expected {
+			t.Fatalf("expecting %s for %s, got %s", expected, name, got)
+		}
+	}
+}
@@ -23,6 +23,13 @@ type OutputRow struct {
 
 	// Child items
 	Children []Output
+
+	// item - index of the item of the row, in the processed list
+	item int
+
+	// raw - values of the columns before formatting, for the typed values of the jsonl and yaml
+	// formats. Unset for the rows of custom formatters.
+	raw []interface{}
 }
 
 // Output for a command
@@ -81,7 +88,8 @@ func (out *Output) applyFormatter(log Logger, v interface{}, fo FormatOptions, f
 		} else {
 			res, _ = jsonpath.JsonPathLookup(jsonData, c.Path)
 		}
-		row.Values = append(row.Values, fmt.Sprintf("%v", res))
+		row.Values = append(row.Values, formatValue(res, fo))
+		row.raw = append(row.raw, res)
 	}
 
 	// Apply formatter for sub items
@@ -119,10 +127,14 @@ func (out *Output) process(log Logger, v interface{}, fo FormatOptions, f Format
 	if reflect.TypeOf(v).Kind() == reflect.Slice {
 		s := reflect.ValueOf(v)
 		for i := 0; i < s.Len(); i++ {
+			n := len(out.Rows)
 			err := out.process(log, s.Index(i).Interface(), fo, f)
 			if err != nil {
 				return err
 			}
+			for j := n; j < len(out.Rows); j++ {
+				out.Rows[j].item = i
+			}
 		}
 		return nil
 	}
@@ -139,9 +151,23 @@ func (out *Output) process(log Logger, v interface{}, fo FormatOptions, f Format
 // getFormattedOutput returns finally formatted output as list of lines.
 func getFormattedOutput(log Logger, v interface{}, fo FormatOptions, f Formatter) ([]string, error) {
 	out := NewOutput(f)
+	query := fo.Query != nil && reflect.TypeOf(v).Kind() == reflect.Slice
+	if query {
+		// The items are selected on the rows of the output.
+		if err := out.process(log, v, fo, f); err != nil {
+			return nil, err
+		}
+		if err := out.apply(fo.Query); err != nil {
+			return nil, err
+		}
+		v = out.items(v)
+	}
 	if fo.Type == FormatTypeFullJSON {
 		return strings.Split(MarshalJSON(v), "\n"), nil
 	}
+	if fo.Type == FormatTypeTemplate {
+		return printTemplate(v, fo.Template)
+	}
 
 	if fo.Type == FormatTypeDefault {
 		if reflect.TypeOf(v).Kind() == reflect.Slice {
@@ -151,13 +177,20 @@ func getFormattedOutput(log Logger, v interface{}, fo FormatOptions, f Formatter
 		}
 	}
 
-	err := out.process(log, v, fo, f)
-	if err != nil {
-		return nil, err
+	if !query {
+		err := out.process(log, v, fo, f)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if fo.Type == FormatTypeList {
+	switch fo.Type {
+	case FormatTypeList:
 		return printList(out, fo, "")
+	case FormatTypeCSV, FormatTypeTSV, FormatTypeJSONL:
+		return newRecordTable().print(out, fo, true)
+	case FormatTypeYAML:
+		return printYAML(out, reflect.TypeOf(v).Kind() == reflect.Slice)
 	}
 	return printColumns(out, fo, "")
 }
@@ -0,0 +1,232 @@
+package cmdlib
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query filters, sorts and limits the items of a list, on the values of their columns. It applies
+// to the rows of the output, before rendering, so every format prints the same items.
+type Query struct {
+	// Filters - all of them must match.
+	Filters []Filter
+
+	// SortBy - column to sort by, in ascending order unless Desc is set.
+	SortBy string
+	Desc   bool
+
+	// Limit - maximum number of items, if set.
+	Limit int
+}
+
+// Filter compares the value of a column with Value.
+type Filter struct {
+	Column string
+	Op     string
+	Value  string
+}
+
+// filterOps - operators of filters. Two characters operators come first.
+var filterOps = []string{"!=", ">=", "<=", "=", ">", "<"}
+
+// ParseFilter parses a filter: COLUMN OP VALUE where OP is one of = != > >= < <=, for ex.
+// BALANCE>0 or CURRENCY=EUR.
+func ParseFilter(expr string) (Filter, error) {
+	i := strings.IndexAny(expr, "!=<>")
+	if i <= 0 {
+		return Filter{}, fmt.Errorf("invalid filter %q, expecting COLUMN OP VALUE with OP one of %s", expr, strings.Join(filterOps, " "))
+	}
+	for _, op := range filterOps {
+		if strings.HasPrefix(expr[i:], op) {
+			return Filter{
+				Column: strings.TrimSpace(expr[:i]),
+				Op:     op,
+				Value:  strings.TrimSpace(expr[i+len(op):]),
+			}, nil
+		}
+	}
+	return Filter{}, fmt.Errorf("invalid filter %q, expecting COLUMN OP VALUE with OP one of %s", expr, strings.Join(filterOps, " "))
+}
+
+// ParseQuery parses the query flags: filters, COLUMN[:desc] to sort by, and limit. It returns
+// nil if none is set.
+func ParseQuery(filters []string, sortBy string, limit int) (*Query, error) {
+	if len(filters) == 0 && sortBy == "" && limit == 0 {
+		return nil, nil
+	}
+	if limit < 0 {
+		return nil, fmt.Errorf("invalid limit %d", limit)
+	}
+	q := &Query{Limit: limit}
+	for _, expr := range filters {
+		f, err := ParseFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+		q.Filters = append(q.Filters, f)
+	}
+	if i := strings.LastIndex(sortBy, ":"); i >= 0 {
+		switch order := strings.ToLower(sortBy[i+1:]); order {
+		case "desc":
+			q.Desc = true
+		case "asc":
+		default:
+			return nil, fmt.Errorf("invalid sort order %q, expecting asc or desc", order)
+		}
+		sortBy = sortBy[:i]
+	}
+	q.SortBy = strings.TrimSpace(sortBy)
+	return q, nil
+}
+
+// column returns the index of a column of out.
+func (out *Output) column(name string) (int, error) {
+	for i, c := range out.Columns {
+		if strings.EqualFold(c, name) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("unknown column %s (One of %s)", name, strings.Join(out.Columns, ", "))
+}
+
+// apply filters, sorts and limits the rows of out.
+func (out *Output) apply(q *Query) error {
+	filters := make([]int, len(q.Filters))
+	for i, f := range q.Filters {
+		col, err := out.column(f.Column)
+		if err != nil {
+			return err
+		}
+		filters[i] = col
+	}
+
+	var rows []OutputRow
+	for _, r := range out.Rows {
+		match := true
+		for i, f := range q.Filters {
+			if filters[i] >= len(r.Values) || !f.matches(r.Values[filters[i]]) {
+				match = false
+				break
+			}
+		}
+		if match {
+			rows = append(rows, r)
+		}
+	}
+
+	if q.SortBy != "" {
+		col, err := out.column(q.SortBy)
+		if err != nil {
+			return err
+		}
+		value := func(r OutputRow) string {
+			if col < len(r.Values) {
+				return r.Values[col]
+			}
+			return ""
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			if q.Desc {
+				return compareValues(value(rows[j]), value(rows[i])) < 0
+			}
+			return compareValues(value(rows[i]), value(rows[j])) < 0
+		})
+	}
+
+	if q.Limit > 0 && len(rows) > q.Limit {
+		rows = rows[:q.Limit]
+	}
+	out.Rows = rows
+	return nil
+}
+
+// items returns the items of list v printed by the rows of out, in the order of the rows.
+func (out *Output) items(v interface{}) interface{} {
+	s := reflect.ValueOf(v)
+	res := reflect.MakeSlice(s.Type(), 0, len(out.Rows))
+	for i, r := range out.Rows {
+		// Custom formatters may print an item on several rows.
+		if i > 0 && out.Rows[i-1].item == r.item {
+			continue
+		}
+		res = reflect.Append(res, s.Index(r.item))
+	}
+	return res.Interface()
+}
+
+// matches returns true if value matches the filter.
+func (f Filter) matches(value string) bool {
+	c := compareValues(value, f.Value)
+	switch f.Op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	}
+	return false
+}
+
+// dateLayouts - layouts of the dates compared as dates.
+var dateLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}
+
+// compareValues compares two column values: as numbers if both are numbers, as dates if both are
+// dates, or else as strings, ignoring case. Missing values come first.
+func compareValues(a, b string) int {
+	a, b = normalizeValue(a), normalizeValue(b)
+	if a == "" || b == "" {
+		return strings.Compare(a, b)
+	}
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	if x, ok := parseDate(a); ok {
+		if y, ok := parseDate(b); ok {
+			switch {
+			case x.Before(y):
+				return -1
+			case x.After(y):
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// normalizeValue returns the empty string for missing values, printed as <nil> by the table formats.
+func normalizeValue(v string) string {
+	v = strings.TrimSpace(v)
+	if v == "<nil>" {
+		return ""
+	}
+	return v
+}
+
+func parseDate(v string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,143 @@
+package cmdlib
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testAccount struct {
+	Name     string  `json:"name"`
+	Currency string  `json:"currency"`
+	Balance  float64 `json:"balance"`
+	Created  string  `json:"created"`
+}
+
+var testAccountFormatter = Formatter{
+	Columns: []Column{
+		{Name: "NAME", Path: "$.name"},
+		{Name: "CURRENCY", Path: "$.currency"},
+		{Name: "BALANCE", Path: "$.balance"},
+		{Name: "CREATED", Path: "$.created"},
+	},
+}
+
+var testAccounts = []*testAccount{
+	{Name: "ann", Currency: "EUR", Balance: 9, Created: "2021-03-01T10:00:00.000Z"},
+	{Name: "Bob", Currency: "USD", Balance: 0, Created: "2020-12-31T23:00:00.000Z"},
+	{Name: "cid", Currency: "EUR", Balance: 10.5, Created: "2021-01-15T08:30:00.000Z"},
+	{Name: "dan", Currency: "GBP", Balance: -3, Created: "2021-02-01T00:00:00.000Z"},
+}
+
+func TestQuery(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		format   FormatType
+		filters  []string
+		sortBy   string
+		limit    int
+		expected string
+	}{
+		{name: "filter number", filters: []string{"BALANCE>0"}, expected: "ann\ncid"},
+		{name: "filter string", filters: []string{"currency=eur"}, expected: "ann\ncid"},
+		{name: "filters", filters: []string{"CURRENCY!=EUR", "BALANCE <= 0"}, expected: "Bob\ndan"},
+		{name: "filter date", filters: []string{"CREATED>=2021-01-15"}, expected: "ann\ncid\ndan"},
+		{name: "sort number", sortBy: "BALANCE", expected: "dan\nBob\nann\ncid"},
+		{name: "sort date desc", sortBy: "CREATED:desc", expected: "ann\ndan\ncid\nBob"},
+		{name: "sort string", sortBy: "NAME:desc", expected: "dan\ncid\nBob\nann"},
+		{name: "limit", limit: 3, expected: "ann\nBob\ncid"},
+		{name: "sorted limit", sortBy: "BALANCE:desc", limit: 2, expected: "cid\nann"},
+		{name: "csv", format: FormatTypeCSV, filters: []string{"BALANCE<1"}, sortBy: "NAME", expected: `NAME,CURRENCY,BALANCE,CREATED
+Bob,USD,0,2020-12-31T23:00:00.000Z
+dan,GBP,-3,2021-02-01T00:00:00.000Z`},
+		{name: "json", format: FormatTypeFullJSON, filters: []string{"NAME=dan"}, expected: `[
+  {
+    "name": "dan",
+    "currency": "GBP",
+    "balance": -3,
+    "created": "2021-02-01T00:00:00.000Z"
+  }
+]`},
+		{name: "unknown column", sortBy: "EMAIL", expected: "unknown column EMAIL (One of NAME, CURRENCY, BALANCE, CREATED)"},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			query, err := ParseQuery(s.filters, s.sortBy, s.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Items are printed with their name, unless another format is set.
+			fo := FormatOptions{Type: s.format, Query: query}
+			if s.format == FormatTypeDefault {
+				fo.Type, fo.Template = FormatTypeTemplate, mustParseTemplate(t, "{{.name}}")
+			}
+			f := testAccountFormatter
+			rows, err := getFormattedOutput(NewLogger(), testAccounts, fo, f)
+			got := strings.Join(rows, "\n")
+			if err != nil {
+				got = err.Error()
+			}
+			if diff := cmp.Diff(s.expected, got); diff != "" {
+				t.Fatalf("unexpected output (-want +got):\n%s", diff)
+			}
+
+			// The printer selects the same items.
+			var out bytes.Buffer
+			o := &Options{Log: NewLogger(), FO: &fo, out: &out}
+			p := o.NewPrinterWithFormatter(f)
+			fetched := 0
+			for _, a := range testAccounts {
+				fetched++
+				if err := p.Print(a); err == ErrorLimitReached {
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+			}
+			// Unsorted lists are not fetched past the limit.
+			if s.limit > 0 && s.sortBy == "" && fetched != s.limit {
+				t.Fatalf("expecting %d items fetched, got %d", s.limit, fetched)
+			}
+			if err := p.Close(); err != nil {
+				got = err.Error()
+			} else {
+				got = strings.TrimSuffix(out.String(), "\n")
+			}
+			if diff := cmp.Diff(s.expected, got); diff != "" {
+				t.Fatalf("unexpected printer output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	for _, s := range []struct {
+		filters []string
+		sortBy  string
+		limit   int
+		err     string
+	}{
+		{filters: []string{"BALANCE"}, err: `invalid filter "BALANCE", expecting COLUMN OP VALUE with OP one of != >= <= = > <`},
+		{filters: []string{">0"}, err: `invalid filter ">0", expecting COLUMN OP VALUE with OP one of != >= <= = > <`},
+		{sortBy: "NAME:up", err: `invalid sort order "up", expecting asc or desc`},
+		{limit: -1, err: "invalid limit -1"},
+	} {
+		if _, err := ParseQuery(s.filters, s.sortBy, s.limit); err == nil || err.Error() != s.err {
+			t.Fatalf("expecting error %q, got %v", s.err, err)
+		}
+	}
+	if q, err := ParseQuery(nil, "", 0); q != nil || err != nil {
+		t.Fatalf("expecting no query, got %+v, %v", q, err)
+	}
+}
+
+func mustParseTemplate(t *testing.T, text string) *template.Template {
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tmpl
+}
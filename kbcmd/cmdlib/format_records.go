@@ -0,0 +1,213 @@
+package cmdlib
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// formatValue formats a column value. Data formats print numbers without exponent, nil values as
+// empty strings, and objects as JSON.
+func formatValue(v interface{}, fo FormatOptions) string {
+	if !fo.Type.isData() {
+		return fmt.Sprintf("%v", v)
+	}
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// typedValue returns the value of column i of r for the jsonl and yaml formats: numbers, booleans
+// and null keep their type, other values are formatted as in the other data formats.
+func (r *OutputRow) typedValue(i int) interface{} {
+	if i < len(r.raw) {
+		switch v := r.raw[i].(type) {
+		case float64:
+			// Printed without exponent, as formatValue does, for ex. by yaml.
+			if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+				return int64(v)
+			}
+			return v
+		case nil, bool, json.Number, int, int32, int64, float32:
+			return v
+		}
+	}
+	return r.Values[i]
+}
+
+// recordValue - value of a record column, formatted for csv and tsv, and typed for jsonl.
+type recordValue struct {
+	text  string
+	typed interface{}
+}
+
+// recordTable flattens outputs into records for the csv, tsv and jsonl formats. Rows without sub
+// items are records. Rows with sub items give one record per sub item row, repeating the values of
+// the row. The columns of sub items are prefixed with their title, for ex. ITEMS.AMOUNT.
+type recordTable struct {
+	columns []string
+	index   map[string]int
+
+	// fixed is set once the header is printed: new sub item columns are dropped.
+	fixed bool
+}
+
+func newRecordTable() *recordTable {
+	return &recordTable{index: map[string]int{}}
+}
+
+// column returns the index of the column, or -1 if it is dropped.
+func (t *recordTable) column(name string) int {
+	if i, ok := t.index[name]; ok {
+		return i
+	}
+	if t.fixed {
+		return -1
+	}
+	t.index[name] = len(t.columns)
+	t.columns = append(t.columns, name)
+	return len(t.columns) - 1
+}
+
+// records returns the records of out. Values are indexed by column.
+func (t *recordTable) records(out Output, prefix string) []map[int]recordValue {
+	cols := make([]int, len(out.Columns))
+	for i, c := range out.Columns {
+		cols[i] = t.column(prefix + c)
+	}
+	var res []map[int]recordValue
+	for _, r := range out.Rows {
+		rec := map[int]recordValue{}
+		for i, v := range r.Values {
+			if i < len(cols) && cols[i] >= 0 {
+				rec[cols[i]] = recordValue{text: v, typed: r.typedValue(i)}
+			}
+		}
+		var children []map[int]recordValue
+		for _, child := range r.Children {
+			children = append(children, t.records(child, prefix+child.Title+".")...)
+		}
+		if len(children) == 0 {
+			res = append(res, rec)
+			continue
+		}
+		for _, c := range children {
+			for k, v := range rec {
+				c[k] = v
+			}
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// print returns the lines of the records of out, preceded by the header if header is true.
+func (t *recordTable) print(out Output, fo FormatOptions, header bool) ([]string, error) {
+	records := t.records(out, "")
+	header = header && !fo.NoHeader
+	t.fixed = true
+
+	var buf bytes.Buffer
+	if fo.Type == FormatTypeJSONL {
+		for _, rec := range records {
+			buf.WriteByte('{')
+			for i, c := range t.columns {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				name, _ := json.Marshal(c)
+				// Columns without value, for ex. of missing sub items, are null.
+				value, _ := json.Marshal(rec[i].typed)
+				buf.Write(name)
+				buf.WriteByte(':')
+				buf.Write(value)
+			}
+			buf.WriteString("}\n")
+		}
+		return splitLines(buf.String()), nil
+	}
+
+	w := csv.NewWriter(&buf)
+	if fo.Type == FormatTypeTSV {
+		w.Comma = '\t'
+	}
+	if header {
+		if err := w.Write(t.columns); err != nil {
+			return nil, err
+		}
+	}
+	for _, rec := range records {
+		values := make([]string, len(t.columns))
+		for i, v := range rec {
+			values[i] = v.text
+		}
+		if err := w.Write(values); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return splitLines(buf.String()), nil
+}
+
+// yamlItems returns the rows of out as yaml maps, keeping the order of the columns. Sub items are
+// lists named after their title.
+func yamlItems(out Output) []yaml.MapSlice {
+	var res []yaml.MapSlice
+	for _, r := range out.Rows {
+		var item yaml.MapSlice
+		for i := range r.Values {
+			if i < len(out.Columns) {
+				item = append(item, yaml.MapItem{Key: out.Columns[i], Value: r.typedValue(i)})
+			}
+		}
+		for _, child := range r.Children {
+			item = append(item, yaml.MapItem{Key: child.Title, Value: yamlItems(child)})
+		}
+		res = append(res, item)
+	}
+	return res
+}
+
+// printYAML returns the lines of out as a yaml list, or as a single item if list is false.
+func printYAML(out Output, list bool) ([]string, error) {
+	items := yamlItems(out)
+	var v interface{} = items
+	if !list && len(items) == 1 {
+		v = items[0]
+	} else if len(items) == 0 {
+		v = []interface{}{}
+	}
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return splitLines(string(data)), nil
+}
+
+// splitLines splits s into lines, without the last new line.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
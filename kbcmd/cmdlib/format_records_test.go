@@ -0,0 +1,98 @@
+package cmdlib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testItem struct {
+	Amount float64 `json:"amount"`
+}
+
+type testInvoice struct {
+	ID     string      `json:"id"`
+	Note   *string     `json:"note"`
+	Amount float64     `json:"amount"`
+	Items  []*testItem `json:"items"`
+}
+
+var testInvoiceFormatter = Formatter{
+	Columns: []Column{
+		{Name: "ID", Path: "$.id"},
+		{Name: "NOTE", Path: "$.note"},
+		{Name: "AMOUNT", Path: "$.amount"},
+	},
+	SubItems: []SubItem{
+		{
+			Name:      "ITEMS",
+			FieldName: "Items",
+			Formatter: &Formatter{Columns: []Column{{Name: "AMOUNT", Path: "$.amount"}}},
+		},
+	},
+}
+
+func TestDataFormats(t *testing.T) {
+	note := "late, \"urgent\""
+	invoices := []*testInvoice{
+		{ID: "i1", Note: &note, Amount: 1500000, Items: []*testItem{{Amount: 1000000}, {Amount: 500000}}},
+		{ID: "i2", Amount: 0.5},
+	}
+	scenarios := []struct {
+		name     string
+		format   FormatType
+		noHeader bool
+		v        interface{}
+		expected string
+	}{
+		{name: "csv", format: FormatTypeCSV, v: invoices, expected: `ID,NOTE,AMOUNT,ITEMS.AMOUNT
+i1,"late, ""urgent""",1500000,1000000
+i1,"late, ""urgent""",1500000,500000
+i2,,0.5,`},
+		{name: "csv without header", format: FormatTypeCSV, noHeader: true, v: invoices[1:], expected: `i2,,0.5`},
+		{name: "tsv", format: FormatTypeTSV, v: invoices[1], expected: "ID\tNOTE\tAMOUNT\ni2\t\t0.5"},
+		{name: "jsonl", format: FormatTypeJSONL, v: invoices, expected: `{"ID":"i1","NOTE":"late, \"urgent\"","AMOUNT":1500000,"ITEMS.AMOUNT":1000000}
+{"ID":"i1","NOTE":"late, \"urgent\"","AMOUNT":1500000,"ITEMS.AMOUNT":500000}
+{"ID":"i2","NOTE":null,"AMOUNT":0.5,"ITEMS.AMOUNT":null}`},
+		{name: "yaml", format: FormatTypeYAML, v: invoices, expected: `- ID: i1
+  NOTE: late, "urgent"
+  AMOUNT: 1500000
+  ITEMS:
+  - AMOUNT: 1000000
+  - AMOUNT: 500000
+- ID: i2
+  NOTE: null
+  AMOUNT: 0.5`},
+		{name: "yaml item", format: FormatTypeYAML, v: invoices[1], expected: `ID: i2
+NOTE: null
+AMOUNT: 0.5`},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			fo := FormatOptions{Type: s.format, NoHeader: s.noHeader}
+			rows, err := getFormattedOutput(NewLogger(), s.v, fo, testInvoiceFormatter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(s.expected, strings.Join(rows, "\n")); diff != "" {
+				t.Fatalf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTypedValue(t *testing.T) {
+	row := OutputRow{
+		Values: []string{"true", "", "2.5", "10", "true", "extra"},
+		raw:    []interface{}{true, nil, 2.5, float64(10), "true"},
+	}
+	expected := []interface{}{true, nil, 2.5, int64(10), "true", "extra"}
+	var actual []interface{}
+	for i := range row.Values {
+		actual = append(actual, row.typedValue(i))
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Fatalf("unexpected values (-want +got):\n%s", diff)
+	}
+}
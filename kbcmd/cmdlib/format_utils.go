@@ -28,7 +28,8 @@ func toGenericObject(v interface{}) (interface{}, error) {
 func getFieldValue(v interface{}, fieldPath string) (interface{}, error) {
 	for _, fieldName := range strings.Split(fieldPath, ".") {
 		val := reflect.ValueOf(v)
-		if v == nil || val.IsNil() {
+		// Fields of embedded structs are structs, which can't be nil.
+		if v == nil || (val.Kind() == reflect.Ptr && val.IsNil()) {
 			return nil, nil
 		}
 
@@ -174,7 +175,8 @@ func printColumns(out Output, fo FormatOptions, indent string) ([]string, error)
 }
 
 // getFormatter returns formatter for the given type.
-// If formatter is not registered, it will return the default formatter.
+// If formatter is not registered, it will return the formatter derived from the fields of the
+// type, or the default formatter printing raw JSON.
 func getFormatter(log Logger, v interface{}) Formatter {
 	tp := reflect.TypeOf(v)
 	if tp.Kind() == reflect.Slice {
@@ -184,6 +186,9 @@ func getFormatter(log Logger, v interface{}) Formatter {
 	if ok {
 		return f
 	}
+	if df := derivedFormatter(tp); df != nil {
+		return *df
+	}
 
 	log.Warningf("formatter for type %s not found, returning default", reflect.TypeOf(v))
 	return Formatter{
@@ -0,0 +1,218 @@
+package cmdlib
+
+import (
+	"fmt"
+	"strings"
+)
+
+// printerBatchSize - number of items formatted at once by a Printer.
+const printerBatchSize = 50
+
+// ErrorLimitReached - error returned by Printer.Print once the --limit items are printed, so that
+// the list is not fetched further. It is not a failure: the printer is closed as usual.
+var ErrorLimitReached = fmt.Errorf("limit reached")
+
+// Printer prints the items of a list as they are received, instead of loading the whole
+// list first. Items are formatted in small batches. Columns are sized on the first batch,
+// and widened by the next ones if needed. Sorted lists are printed once complete.
+//
+//	p := o.NewPrinter()
+//	for it.Next(ctx) {
+//		if err := p.Print(it.Account()); errors.Is(err, ErrorLimitReached) {
+//			break
+//		} else if err != nil {
+//			return err
+//		}
+//	}
+//	return p.Close()
+type Printer struct {
+	o  *Options
+	f  *Formatter
+	fo FormatOptions
+
+	started bool
+	batch   []interface{}
+	widths  []int
+	records *recordTable
+	printed int
+}
+
+// NewPrinter creates a printer using the formatter registered for the type of the items.
+func (o *Options) NewPrinter() *Printer {
+	fo := *o.FO
+	if fo.Type == FormatTypeDefault {
+		fo.Type = FormatTypeShort
+	}
+	return &Printer{o: o, fo: fo}
+}
+
+// NewPrinterWithFormatter creates a printer using the given formatter.
+func (o *Options) NewPrinterWithFormatter(f Formatter) *Printer {
+	p := o.NewPrinter()
+	p.f = &f
+	return p
+}
+
+// Print prints the given item, once its batch is complete. It returns ErrorLimitReached once the
+// limit of an unsorted list is reached.
+func (p *Printer) Print(v interface{}) error {
+	if !p.started {
+		// The selected columns, template or view apply from the first item.
+		f := Formatter{}
+		if p.f != nil {
+			f = *p.f
+		} else {
+			f = getFormatter(p.o.Log, v)
+		}
+		f, fo, err := p.o.formatFor(v, f)
+		if err != nil {
+			return err
+		}
+		if fo.Type == FormatTypeDefault {
+			fo.Type = FormatTypeShort
+		}
+		p.f, p.fo, p.started = &f, fo, true
+	}
+	q := p.fo.Query
+	if q != nil && q.SortBy != "" {
+		// Sorted lists are printed once complete.
+		p.batch = append(p.batch, v)
+		return nil
+	}
+	limit := 0
+	if q != nil {
+		limit = q.Limit
+	}
+	if limit > 0 && p.printed >= limit {
+		return ErrorLimitReached
+	}
+	p.batch = append(p.batch, v)
+	if len(p.batch) < printerBatchSize && (limit == 0 || p.printed+len(p.batch) < limit) {
+		return nil
+	}
+	if err := p.flush(); err != nil {
+		return err
+	}
+	if limit > 0 && p.printed >= limit {
+		return ErrorLimitReached
+	}
+	return nil
+}
+
+// Close prints the remaining items.
+func (p *Printer) Close() error {
+	if err := p.flush(); err != nil {
+		return err
+	}
+	if p.fo.Type == FormatTypeFullJSON && p.printed == 0 {
+		p.o.out.Write([]byte("[]\n"))
+	} else if p.fo.Type == FormatTypeFullJSON {
+		p.o.out.Write([]byte("\n]\n"))
+	} else if p.fo.Type == FormatTypeYAML && p.printed == 0 {
+		p.o.out.Write([]byte("[]\n"))
+	}
+	return nil
+}
+
+func (p *Printer) flush() error {
+	if len(p.batch) == 0 {
+		return nil
+	}
+	batch := p.batch
+	p.batch = nil
+	defer func() { p.printed += len(batch) }()
+
+	out := NewOutput(*p.f)
+	query := p.fo.Query != nil
+	if query {
+		// The limit applies to the items of all batches.
+		q := *p.fo.Query
+		if q.Limit > 0 {
+			q.Limit -= p.printed
+		}
+		if err := out.process(p.o.Log, batch, p.fo, *p.f); err != nil {
+			return err
+		}
+		if err := out.apply(&q); err != nil {
+			return err
+		}
+		batch = out.items(batch).([]interface{})
+		if len(batch) == 0 {
+			return nil
+		}
+	}
+
+	if p.fo.Type == FormatTypeFullJSON {
+		for i, v := range batch {
+			sep := ",\n"
+			if p.printed+i == 0 {
+				sep = "[\n"
+			}
+			p.o.out.Write([]byte(sep + "  " + strings.Replace(MarshalJSON(v), "\n", "\n  ", -1)))
+		}
+		return nil
+	}
+	if p.fo.Type == FormatTypeTemplate {
+		rows, err := printTemplate(batch, p.fo.Template)
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			p.o.out.Write([]byte(fmt.Sprintf("%s\n", strings.Join(rows, "\n"))))
+		}
+		return nil
+	}
+
+	if !query {
+		if err := out.process(p.o.Log, batch, p.fo, *p.f); err != nil {
+			return err
+		}
+	}
+	fo := p.fo
+	fo.NoHeader = fo.NoHeader || p.printed > 0
+	var rows []string
+	var err error
+	switch fo.Type {
+	case FormatTypeList:
+		rows, err = printList(out, fo, "")
+	case FormatTypeCSV, FormatTypeTSV, FormatTypeJSONL:
+		// The columns are the ones of the first batch.
+		if p.records == nil {
+			p.records = newRecordTable()
+		}
+		rows, err = p.records.print(out, fo, p.printed == 0)
+	case FormatTypeYAML:
+		// The items of each batch continue the list.
+		rows, err = printYAML(out, true)
+	case FormatTypeTabular:
+		rows, err = printColumns(out, fo, "")
+	default:
+		rows = p.printShort(out, fo)
+	}
+	if err != nil {
+		return err
+	}
+	p.o.out.Write([]byte(fmt.Sprintf("%s\n", strings.Join(rows, "\n"))))
+	return nil
+}
+
+// printShort prints the rows of out without their sub items, keeping the columns aligned with
+// the rows already printed.
+func (p *Printer) printShort(out Output, fo FormatOptions) []string {
+	widths := computeMaxColumnSize(out, true)
+	for i := range widths {
+		if i < len(p.widths) && p.widths[i] > widths[i] {
+			widths[i] = p.widths[i]
+		}
+	}
+	p.widths = widths
+
+	var rows []string
+	if !fo.NoHeader {
+		rows = append(rows, printSingleRow(out.Columns, widths, fo))
+	}
+	for _, r := range out.Rows {
+		rows = append(rows, printSingleRow(r.Values, widths, fo))
+	}
+	return rows
+}
@@ -2,13 +2,15 @@ package cmdlib
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/killbill/kbcli/v3/kbauth"
 	"github.com/killbill/kbcli/v3/kbclient/debug"
 	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbtransport"
 
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
@@ -22,9 +24,17 @@ type App struct {
 	app *cli.App
 	o   *Options
 	ctx context.Context
+
+	// Secrets of the profile, and the error selecting the profile, if any.
+	secrets    []pendingSecret
+	profileErr error
 }
 
-var formatStr string
+var (
+	formatStr string
+	sortByStr string
+	limit     int
+)
 
 // NewApp creates new command registry
 func NewApp() *App {
@@ -79,6 +89,17 @@ func (r *App) Register(parentCmd string, command cli.Command, fn HandlerFn) {
 	*parent = append(*parent, command)
 }
 
+// RegisterLocal registers a command that doesn't call kill bill, for ex. to manage the configuration:
+// the client is not created, and the secrets of the profile are not resolved.
+func (r *App) RegisterLocal(parentCmd string, command cli.Command, fn HandlerFn) {
+	command.Action = func(c *cli.Context) error {
+		o := *r.o
+		o.Args = c.Args()
+		return fn(r.ctx, &o)
+	}
+	r.Register(parentCmd, command, nil)
+}
+
 // init initializes the registry
 func (r *App) init() {
 	r.app.Name = "kbcmd"
@@ -87,11 +108,32 @@ func (r *App) init() {
 	r.app.EnableBashCompletion = true
 	r.app.Before = func(c *cli.Context) error {
 		r.ctx = context.Background()
+		if err := r.applyProfile(c); err != nil {
+			return err
+		}
 		r.o.FO.Type.Scan(formatStr)
+		query, err := ParseQuery(c.StringSlice("filter"), sortByStr, limit)
+		if err != nil {
+			return err
+		}
+		r.o.FO.Query = query
 		return nil
 	}
 
 	r.app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:        "config",
+			Value:       DefaultConfigFile(),
+			Usage:       "Configuration file holding the connection profiles",
+			Destination: &r.o.ConfigFile,
+			EnvVar:      "KB_CONFIG",
+		},
+		cli.StringFlag{
+			Name:        "profile",
+			Usage:       "Connection profile of the configuration file. Defaults to the current profile (see kbcmd profile use)",
+			Destination: &r.o.ProfileName,
+			EnvVar:      "KB_PROFILE",
+		},
 		cli.StringFlag{
 			Name:        "host",
 			Value:       "127.0.0.1:8080",
@@ -113,6 +155,19 @@ func (r *App) init() {
 			Destination: &r.o.Password,
 			EnvVar:      "KB_PASSWORD",
 		},
+		cli.StringFlag{
+			Name:        "auth",
+			Value:       "basic",
+			Usage:       "Authentication scheme (One of basic, session, bearer)",
+			Destination: &r.o.Auth,
+			EnvVar:      "KB_AUTH",
+		},
+		cli.StringFlag{
+			Name:        "bearer_token",
+			Usage:       "Token to use with bearer authentication",
+			Destination: &r.o.BearerToken,
+			EnvVar:      "KB_BEARER_TOKEN",
+		},
 		cli.StringFlag{
 			Name:        "created_by",
 			Value:       os.Getenv("USER"),
@@ -120,6 +175,19 @@ func (r *App) init() {
 			Destination: &r.o.CreatedBy,
 			EnvVar:      "KB_API_CREATED_BY",
 		},
+		cli.StringFlag{
+			Name:        "reason",
+			Usage:       "Value to use in X-Killbill-Reason",
+			Destination: &r.o.Reason,
+			EnvVar:      "KB_API_REASON",
+		},
+		cli.StringFlag{
+			Name:        "comment",
+			Value:       "Created by kbcmd tool",
+			Usage:       "Value to use in X-Killbill-Comment",
+			Destination: &r.o.Comment,
+			EnvVar:      "KB_API_COMMENT",
+		},
 		cli.StringFlag{
 			Name:        "api_key",
 			Value:       "bob",
@@ -141,16 +209,112 @@ func (r *App) init() {
 			Destination: &r.o.TransportScheme,
 			EnvVar:      "KB_TRANSPORT_SCHEME",
 		},
+		cli.StringFlag{
+			Name:        "url",
+			Usage:       "Kill bill url, for ex. https://kb.example.com/killbill. Overrides host and transport_scheme",
+			Destination: &r.o.URL,
+			EnvVar:      "KB_URL",
+		},
+		cli.StringFlag{
+			Name:        "ca_cert",
+			Usage:       "PEM encoded CA bundle used to verify kill bill certificate",
+			Destination: &r.o.Transport.CAFile,
+			EnvVar:      "KB_CA_CERT",
+		},
+		cli.StringFlag{
+			Name:        "client_cert",
+			Usage:       "PEM encoded client certificate, for mutual TLS",
+			Destination: &r.o.Transport.CertFile,
+			EnvVar:      "KB_CLIENT_CERT",
+		},
+		cli.StringFlag{
+			Name:        "client_key",
+			Usage:       "PEM encoded client key, for mutual TLS",
+			Destination: &r.o.Transport.KeyFile,
+			EnvVar:      "KB_CLIENT_KEY",
+		},
+		cli.BoolFlag{
+			Name:        "insecure_skip_verify",
+			Usage:       "Don't verify kill bill certificate. For development only",
+			Destination: &r.o.Transport.InsecureSkipVerify,
+			EnvVar:      "KB_INSECURE_SKIP_VERIFY",
+		},
+		cli.StringFlag{
+			Name:        "proxy",
+			Usage:       "Http proxy url. Defaults to HTTP_PROXY/HTTPS_PROXY",
+			Destination: &r.o.Transport.ProxyURL,
+			EnvVar:      "KB_PROXY",
+		},
+		cli.IntFlag{
+			Name:        "max_idle_conns_per_host",
+			Usage:       "Maximum number of idle connections to kill bill",
+			Destination: &r.o.Transport.MaxIdleConnsPerHost,
+			EnvVar:      "KB_MAX_IDLE_CONNS_PER_HOST",
+		},
+		cli.DurationFlag{
+			Name:        "idle_conn_timeout",
+			Usage:       "Time after which idle connections are closed",
+			Destination: &r.o.Transport.IdleConnTimeout,
+			EnvVar:      "KB_IDLE_CONN_TIMEOUT",
+		},
+		cli.BoolFlag{
+			Name:        "disable_keep_alives",
+			Usage:       "Use a new connection for each request",
+			Destination: &r.o.Transport.DisableKeepAlives,
+			EnvVar:      "KB_DISABLE_KEEP_ALIVES",
+		},
+		cli.IntFlag{
+			Name:        "retries",
+			Value:       2,
+			Usage:       "Number of times to retry GET requests on connection errors and 502/503/504 responses",
+			Destination: &r.o.Retries,
+			EnvVar:      "KB_RETRIES",
+		},
+		cli.BoolFlag{
+			Name:        "validate",
+			Usage:       "Validate requests before sending them to kill bill",
+			Destination: &r.o.Validate,
+			EnvVar:      "KB_VALIDATE",
+		},
+		cli.BoolFlag{
+			Name:        "read_only, read-only",
+			Usage:       "Reject every mutating request (POST, PUT, DELETE) before sending it to kill bill",
+			Destination: &r.o.ReadOnly,
+			EnvVar:      "KB_READ_ONLY",
+		},
+		cli.StringFlag{
+			Name:        "read_only_allow",
+			Usage:       "Comma separated ids of the operations still allowed in read-only mode, for ex. generateDryRunInvoice",
+			Destination: &r.o.ReadOnlyAllow,
+			EnvVar:      "KB_READ_ONLY_ALLOW",
+		},
+		cli.BoolFlag{
+			Name:        "profiling",
+			Usage:       "Ask kill bill to profile the requests, and print the profiled calls after the output",
+			Destination: &r.o.Profiling,
+			EnvVar:      "KB_PROFILING",
+		},
+		cli.StringFlag{
+			Name:        "profiling_info",
+			Value:       kbcommon.DefaultProfilingInfo,
+			Usage:       "Comma separated kinds of calls to profile with --profiling (Any of JAXRS, API, DAO, DAO_DETAILS, DAO_CONNECTION, PLUGIN, GLOCK)",
+			Destination: &r.o.ProfilingInfo,
+			EnvVar:      "KB_PROFILING_INFO",
+		},
 		cli.StringFlag{
 			Name:  "format, f",
 			Value: "default",
-			Usage: `Output format. (One of table, short, default, list, json)
+			Usage: `Output format. (One of table, short, default, list, json, csv, tsv, jsonl, yaml)
 
      table   - tabular format
      short   - short tabular format. child items are not printed.
 	 default - use short for collections, and table for single item.
 	 list    - list of key value pairs.
      json    - print json.
+     csv     - comma separated values. child items are flattened, one record per child item.
+     tsv     - tab separated values, same records as csv.
+     jsonl   - one json object per line, same records as csv.
+     yaml    - yaml list of the items, with their child items.
 `,
 			Destination: &formatStr,
 		},
@@ -160,54 +324,150 @@ func (r *App) init() {
 			Destination: &r.o.PrintDebug,
 			EnvVar:      "KB_DEBUG",
 		},
+		cli.StringFlag{
+			Name:        "debug_format",
+			Usage:       "Format of the debug logs of http requests (One of text, json). Credentials are redacted",
+			Value:       string(kbtransport.DebugText),
+			Destination: &r.o.DebugFormat,
+			EnvVar:      "KB_DEBUG_FORMAT",
+		},
 		cli.BoolFlag{
 			Name:        "no_header",
-			Usage:       "Don't print header in csv/table format",
+			Usage:       "Don't print header in table, csv and tsv formats",
 			Destination: &r.o.FO.NoHeader,
 		},
+		cli.StringFlag{
+			Name:        "columns",
+			Usage:       "Comma separated columns to print: NAME=JSONPATH, JSONPATH or the NAME of a default column, for ex. 'ID=$.accountId,TZ=$.timeZone'",
+			Destination: &r.o.Columns,
+		},
+		cli.StringFlag{
+			Name:        "template",
+			Usage:       "Go text/template executed for each item, with the json fields of the item, for ex. '{{.accountId}} {{.email}}'",
+			Destination: &r.o.Template,
+		},
+		cli.StringFlag{
+			Name:        "view",
+			Usage:       "View of the configuration file to print the items with",
+			Destination: &r.o.View,
+			EnvVar:      "KB_VIEW",
+		},
+		cli.StringSliceFlag{
+			Name:  "filter",
+			Usage: "Print the items of lists matching COLUMN OP VALUE, with OP one of = != > >= < <=, for ex. 'BALANCE>0'. Can be repeated",
+		},
+		cli.StringFlag{
+			Name:        "sort_by, sort-by",
+			Usage:       "Sort lists by COLUMN[:desc]. Numbers and dates are compared as such",
+			Destination: &sortByStr,
+		},
+		cli.IntFlag{
+			Name:        "limit",
+			Usage:       "Maximum number of items of lists to print",
+			Destination: &limit,
+		},
 	}
 	r.app.Commands = []cli.Command{}
 }
 
+// newRuntime returns the transport for --url, or --host and --transport_scheme.
+func newRuntime(o *Options) (*httptransport.Runtime, error) {
+	conf := o.Transport
+	conf.URL = o.URL
+	if conf.URL == "" {
+		conf.URL = o.Host
+		conf.Schemes = strings.Split(o.TransportScheme, ",")
+	}
+	return kbtransport.NewRuntime(conf)
+}
+
+// newAuthProvider returns the authentication provider selected with --auth.
+func newAuthProvider(o *Options) (kbauth.Provider, error) {
+	switch o.Auth {
+	case "", "basic":
+		return kbauth.Basic(o.Username, o.Password), nil
+	case "session":
+		return kbauth.NewSession(o.Username, o.Password), nil
+	case "bearer":
+		if o.BearerToken == "" {
+			return nil, fmt.Errorf("--bearer_token is required with bearer authentication")
+		}
+		return kbauth.Bearer(o.BearerToken), nil
+	default:
+		return nil, fmt.Errorf("unknown authentication scheme %q (One of basic, session, bearer)", o.Auth)
+	}
+}
+
 // toAction converts handler function to action handler to be usable by cli.
 func (r *App) toAction(fn HandlerFn) func(c *cli.Context) error {
 	return func(c *cli.Context) error {
+		if err := r.resolveSecrets(); err != nil {
+			return err
+		}
 		o := *r.o
 		o.Args = c.Args()
 
-		trp := httptransport.New(o.Host, "", strings.Split(o.TransportScheme, ","))
-
-		// Add text/xml producer which is not handled by openapi runtime.
-		trp.Producers["text/xml"] = runtime.TextProducer()
+		trp, err := newRuntime(&o)
+		if err != nil {
+			return err
+		}
 		trp.Consumers["text/xml"] = runtime.TextConsumer()
 
 		// In some cases (400/401), Kill Bill might return HTML
 		// See https://github.com/killbill/kbcli/issues/11
 		trp.Consumers["text/html"] = HTMLConsumer()
 
-		trp.Debug = o.PrintDebug
-		authWriter := runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
-			encoded := base64.StdEncoding.EncodeToString([]byte(o.Username + ":" + o.Password))
-			if err := r.SetHeaderParam("Authorization", "Basic "+encoded); err != nil {
-				return err
-			}
-			if err := r.SetHeaderParam("X-KillBill-ApiKey", o.APIKey); err != nil {
-				return err
-			}
-			if err := r.SetHeaderParam("X-KillBill-ApiSecret", o.APISecret); err != nil {
-				return err
+		if o.PrintDebug {
+			format := kbtransport.DebugFormat(o.DebugFormat)
+			if format != kbtransport.DebugText && format != kbtransport.DebugJSON {
+				return fmt.Errorf("unknown debug format %q (One of text, json)", o.DebugFormat)
 			}
-			return nil
-		})
+			trp.Transport = kbtransport.NewDebugTransport(trp.Transport, kbtransport.DebugOptions{
+				Format: format,
+				Logger: o.Log,
+			})
+		}
+		auth, err := newAuthProvider(&o)
+		if err != nil {
+			return err
+		}
+		authWriter := kbauth.WithTenant(auth, o.APIKey, o.APISecret)
 
 		o.client = kbclient.New(trp, strfmt.Default, authWriter, kbclient.KillbillDefaults{})
 		o.devClient = debug.New(trp, strfmt.Default, authWriter, kbclient.KillbillDefaults{})
 
+		var clientTrp runtime.ClientTransport = trp
+		if o.ReadOnly {
+			var allow []string
+			for _, id := range strings.Split(o.ReadOnlyAllow, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					allow = append(allow, id)
+				}
+			}
+			clientTrp = kbtransport.NewReadOnlyTransport(clientTrp, allow...)
+		}
+		clientTrp = kbtransport.NewProfilingTransport(clientTrp, nil)
+		if o.Validate {
+			clientTrp = kbtransport.NewValidationTransport(clientTrp)
+		}
+		if o.Retries > 0 {
+			policy := kbtransport.DefaultRetryPolicy()
+			policy.MaxAttempts = o.Retries + 1
+			policy.OnRetry = func(op *runtime.ClientOperation, attempt int, err error, delay time.Duration) {
+				o.Log.Warningf("%s failed (attempt %d): %v. retrying in %v", op.ID, attempt, err, delay)
+			}
+			clientTrp = kbtransport.NewRetryTransport(clientTrp, policy)
+		}
+		clientTrp = kbauth.NewTransport(clientTrp)
+		clientTrp = kbtransport.NewTimeoutTransport(clientTrp)
+		o.client.SetTransport(clientTrp)
+		o.devClient.SetTransport(clientTrp)
+
 		// Set defaults
 
 		createdBy := o.CreatedBy
-		comment := "Created by kbcmd tool"
-		reason := ""
+		comment := o.Comment
+		reason := o.Reason
 
 		o.client.SetDefaults(kbclient.KillbillDefaults{
 			CreatedBy:      &createdBy,
@@ -216,7 +476,15 @@ func (r *App) toAction(fn HandlerFn) func(c *cli.Context) error {
 			WithStackTrace: &o.PrintDebug,
 		})
 
-		err := fn(r.ctx, &o)
+		ctx := r.ctx
+		if o.Profiling {
+			ctx = kbcommon.WithProfiling(ctx, o.ProfilingInfo)
+		}
+		err = fn(ctx, &o)
+		for _, p := range kbcommon.ProfilesFromContext(ctx) {
+			o.Outputln("")
+			p.WriteTree(o.out)
+		}
 		if err == nil {
 			return err
 		}
@@ -10,17 +10,24 @@ import (
 	"github.com/killbill/kbcli/v3/kbclient/debug"
 
 	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbtransport"
 )
 
 // Options for command line
 type Options struct {
 	Host            string
+	URL             string
 	Username        string
 	Password        string
+	Auth            string
+	BearerToken     string
 	CreatedBy       string
+	Reason          string
+	Comment         string
 	APIKey          string
 	APISecret       string
 	PrintDebug      bool
+	DebugFormat     string
 	Args            []string
 	client          *kbclient.KillBill
 	devClient       *debug.Client
@@ -28,6 +35,19 @@ type Options struct {
 	out             io.Writer
 	FO              *FormatOptions
 	TransportScheme string
+	Transport       kbtransport.RuntimeConfig
+	Retries         int
+	Validate        bool
+	ReadOnly        bool
+	ReadOnlyAllow   string
+	Profiling       bool
+	ProfilingInfo   string
+	ConfigFile      string
+	ProfileName     string
+	Columns         string
+	Template        string
+	View            string
+	config          *Config
 }
 
 // Client returns killbill client
@@ -35,6 +55,11 @@ func (o *Options) Client() *kbclient.KillBill {
 	return o.client
 }
 
+// Config returns the configuration file, loaded when the app starts
+func (o *Options) Config() *Config {
+	return o.config
+}
+
 // DevClient returns dev client
 func (o *Options) DevClient() *debug.Client {
 	return o.devClient
@@ -52,17 +77,17 @@ func (o *Options) Outputln(format string, args ...interface{}) {
 
 // Print writes formatted output of given resource
 func (o *Options) Print(v interface{}) {
-	rows, err := getFormattedOutput(o.Log, v, *o.FO, getFormatter(o.Log, v))
+	o.OutputWithFormatter(v, getFormatter(o.Log, v))
+}
+
+// OutputWithFormatter - print with custom formatter, unless columns, a template or a view are selected
+func (o *Options) OutputWithFormatter(v interface{}, f Formatter) {
+	f, fo, err := o.formatFor(v, f)
 	if err != nil {
 		o.out.Write([]byte(fmt.Sprintf("%v\n", err)))
 		return
 	}
-	o.out.Write([]byte(fmt.Sprintf("%s\n", strings.Join(rows, "\n"))))
-}
-
-// OutputWithFormatter - print with custom formatter
-func (o *Options) OutputWithFormatter(v interface{}, f Formatter) {
-	rows, err := getFormattedOutput(o.Log, v, *o.FO, f)
+	rows, err := getFormattedOutput(o.Log, v, fo, f)
 	if err != nil {
 		o.out.Write([]byte(fmt.Sprintf("%v\n", err)))
 		return
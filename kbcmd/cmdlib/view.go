@@ -0,0 +1,234 @@
+package cmdlib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// View changes how the items of a type are printed: with other columns, or with a template.
+// Views are saved in the configuration file, for ex.:
+//
+//	views:
+//	  billing:
+//	    type: Account
+//	    columns: NAME,ID=$.accountId,BALANCE=$.accountBalance,TZ=$.timeZone
+//	    default: true
+//	  emails:
+//	    type: Account
+//	    template: '{{.email}} {{.name}}'
+type View struct {
+	// Type - name of the printed type, for ex. Account for kbmodel.Account.
+	Type string `yaml:"type,omitempty"`
+
+	// Columns - see ParseColumns.
+	Columns string `yaml:"columns,omitempty"`
+
+	// Template - go text/template executed for each item, with the JSON fields of the item.
+	Template string `yaml:"template,omitempty"`
+
+	// Default - use the view instead of the registered formatter of the type.
+	Default bool `yaml:"default,omitempty"`
+}
+
+// matches returns true if the view applies to the type of v.
+func (view *View) matches(v interface{}) bool {
+	if view.Type == "" {
+		return true
+	}
+	tp := reflect.TypeOf(v)
+	for tp.Kind() == reflect.Slice || tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	name := tp.Name()
+	if i := strings.LastIndex(tp.PkgPath(), "/"); i >= 0 {
+		name = tp.PkgPath()[i+1:] + "." + name
+	}
+	return strings.EqualFold(view.Type, tp.Name()) || strings.EqualFold(view.Type, name)
+}
+
+// ParseColumns parses a comma separated list of columns: NAME=JSONPATH, for ex. ID=$.accountId,
+// a JSONPATH named after its path, or the NAME of a column of f. Commas within brackets or quotes
+// are part of the JSONPATH, for ex. $.items[0,1].amount or $.tags[?(@.name=='a,b')].
+func ParseColumns(spec string, f Formatter) ([]Column, error) {
+	var columns []Column
+	for _, c := range splitColumns(spec) {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		if i := strings.Index(c, "="); i >= 0 && isColumnName(strings.TrimSpace(c[:i])) {
+			columns = append(columns, Column{Name: strings.TrimSpace(c[:i]), Path: strings.TrimSpace(c[i+1:])})
+			continue
+		}
+		if strings.HasPrefix(c, "$") {
+			columns = append(columns, Column{Name: strings.TrimPrefix(strings.TrimPrefix(c, "$"), "."), Path: c})
+			continue
+		}
+		found := false
+		for _, fc := range f.Columns {
+			if strings.EqualFold(fc.Name, c) {
+				columns = append(columns, fc)
+				found = true
+				break
+			}
+		}
+		if !found {
+			var names []string
+			for _, fc := range f.Columns {
+				names = append(names, fc.Name)
+			}
+			return nil, fmt.Errorf("unknown column %s (One of %s, or NAME=JSONPATH)", c, strings.Join(names, ", "))
+		}
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns in %q", spec)
+	}
+	return columns, nil
+}
+
+// splitColumns splits the columns spec on the commas which are outside of brackets and quotes.
+func splitColumns(spec string) []string {
+	var res []string
+	depth, start := 0, 0
+	var quote rune
+	for i, r := range spec {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '[' || r == '(':
+			depth++
+		case (r == ']' || r == ')') && depth > 0:
+			depth--
+		case r == ',' && depth == 0:
+			res = append(res, spec[start:i])
+			start = i + 1
+		}
+	}
+	return append(res, spec[start:])
+}
+
+// isColumnName returns true if s is a plain identifier, which can name a column.
+func isColumnName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// templateFuncs - functions available in the templates.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// parseTemplate parses an output template.
+func parseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// printTemplate executes the template for v, or each item of v. The output of each item ends with
+// a new line.
+func printTemplate(v interface{}, tmpl *template.Template) ([]string, error) {
+	if reflect.TypeOf(v).Kind() == reflect.Slice {
+		var lines []string
+		s := reflect.ValueOf(v)
+		for i := 0; i < s.Len(); i++ {
+			itemLines, err := printTemplate(s.Index(i).Interface(), tmpl)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, itemLines...)
+		}
+		return lines, nil
+	}
+	data, err := toGenericObject(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return splitLines(buf.String()), nil
+}
+
+// view returns the view selected with --view if it applies to v, or the default view of the type of v.
+func (o *Options) view(v interface{}) (*View, error) {
+	var views map[string]*View
+	if o.config != nil {
+		views = o.config.Views
+	}
+	if o.View != "" {
+		view, ok := views[o.View]
+		if !ok {
+			return nil, fmt.Errorf("view %q not found in %s", o.View, o.ConfigFile)
+		}
+		if view.matches(v) {
+			return view, nil
+		}
+	}
+	var names []string
+	for name := range views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if view := views[name]; view.Default && view.Type != "" && view.matches(v) {
+			return view, nil
+		}
+	}
+	return nil, nil
+}
+
+// formatFor returns the formatter and format options used to print v: f, unless columns or a
+// template are selected with --columns, --template or a view.
+func (o *Options) formatFor(v interface{}, f Formatter) (Formatter, FormatOptions, error) {
+	fo := *o.FO
+	columns, text := o.Columns, o.Template
+	if columns == "" && text == "" {
+		view, err := o.view(v)
+		if err != nil {
+			return f, fo, err
+		}
+		if view != nil {
+			columns, text = view.Columns, view.Template
+		}
+	}
+	if text != "" {
+		tmpl, err := parseTemplate(text)
+		if err != nil {
+			return f, fo, err
+		}
+		fo.Type = FormatTypeTemplate
+		fo.Template = tmpl
+	}
+	if columns != "" {
+		cols, err := ParseColumns(columns, f)
+		if err != nil {
+			return f, fo, err
+		}
+		f = Formatter{Columns: cols}
+	}
+	return f, fo, nil
+}
@@ -0,0 +1,98 @@
+package cmdlib
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestViews(t *testing.T) {
+	note := "late"
+	invoices := []*testInvoice{
+		{ID: "i1", Note: &note, Amount: 1500000, Items: []*testItem{{Amount: 1000000}}},
+		{ID: "i2", Amount: 0.5},
+	}
+	config := &Config{
+		Views: map[string]*View{
+			"ids":     {Type: "testInvoice", Columns: "ID"},
+			"amounts": {Type: "cmdlib.testInvoice", Columns: "ID,TOTAL=$.amount", Default: true},
+			"notes":   {Type: "testInvoice", Template: "{{.id}}: {{.note}}"},
+			"items":   {Type: "testItem", Columns: "$.amount"},
+		},
+	}
+	scenarios := []struct {
+		name     string
+		columns  string
+		template string
+		view     string
+		expected string
+	}{
+		{name: "default view", expected: `ID,TOTAL
+i1,1500000
+i2,0.5`},
+		{name: "columns", columns: "id, NOTE=$.note", expected: `ID,NOTE
+i1,late
+i2,`},
+		{name: "columns override view", columns: "$.amount", view: "notes", expected: `amount
+1500000
+0.5`},
+		{name: "template", template: `{{.id}} {{json .items}}`, expected: `i1 [{"amount":1000000}]
+i2 null`},
+		{name: "view", view: "ids", expected: `ID
+i1
+i2`},
+		{name: "template view", view: "notes", expected: `i1: late
+i2: <no value>`},
+		{name: "view of another type", view: "items", expected: `ID,TOTAL
+i1,1500000
+i2,0.5`},
+		{name: "unknown column", columns: "ID,BALANCE", expected: "unknown column BALANCE (One of ID, NOTE, AMOUNT, or NAME=JSONPATH)"},
+		{name: "invalid template", template: "{{.id", expected: "invalid template: template: output:1: unclosed action"},
+		{name: "unknown view", view: "none", expected: `view "none" not found in config.yaml`},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			var out bytes.Buffer
+			o := &Options{
+				Log:        NewLogger(),
+				FO:         &FormatOptions{Type: FormatTypeCSV},
+				Columns:    s.columns,
+				Template:   s.template,
+				View:       s.view,
+				ConfigFile: "config.yaml",
+				config:     config,
+				out:        &out,
+			}
+			o.OutputWithFormatter(invoices, testInvoiceFormatter)
+			if diff := cmp.Diff(s.expected, strings.TrimSuffix(out.String(), "\n")); diff != "" {
+				t.Fatalf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	scenarios := []struct {
+		spec     string
+		expected []Column
+	}{
+		{"ID, NOTE = $.note", []Column{{Name: "ID", Path: "$.id"}, {Name: "NOTE", Path: "$.note"}}},
+		{"$.items[0,1].amount", []Column{{Name: "items[0,1].amount", Path: "$.items[0,1].amount"}}},
+		{"FIRST=$.items[0,1].amount,ID", []Column{{Name: "FIRST", Path: "$.items[0,1].amount"}, {Name: "ID", Path: "$.id"}}},
+		{"$.tags[?(@.name=='X')]", []Column{{Name: "tags[?(@.name=='X')]", Path: "$.tags[?(@.name=='X')]"}}},
+		{"TAG=$.tags[?(@.name=='a,b')].id", []Column{{Name: "TAG", Path: "$.tags[?(@.name=='a,b')].id"}}},
+	}
+	for _, s := range scenarios {
+		t.Run(s.spec, func(t *testing.T) {
+			columns, err := ParseColumns(s.spec, testInvoiceFormatter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(s.expected, columns); diff != "" {
+				t.Fatalf("unexpected columns (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	"github.com/killbill/kbcli/v3/kbcmd/cmdlib"
 	"github.com/killbill/kbcli/v3/kbcmd/kblib"
 	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbpager"
 	"github.com/urfave/cli"
 )
 
@@ -52,13 +54,20 @@ var (
 )
 
 func listAccounts(ctx context.Context, o *cmdlib.Options) error {
-	var err error
-	resp, err := o.Client().Account.GetAccounts(ctx, &account.GetAccountsParams{})
-	if err != nil {
+	p := o.NewPrinter()
+	it := kbpager.GetAccounts(o.Client().Account, &account.GetAccountsParams{})
+	for it.Next(ctx) {
+		if err := p.Print(it.Account()); errors.Is(err, cmdlib.ErrorLimitReached) {
+			// Don't fetch the next pages.
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
 		return err
 	}
-	o.Print(resp.Payload)
-	return nil
+	return p.Close()
 }
 
 // getAccount - get account information command
@@ -367,4 +376,5 @@ func RegisterAccountCommands(r *cmdlib.App) {
 	registerAccountTagCommands(r)
 	registerAccountCustomFieldCommands(r)
 	registerAccountStripeCommands(r)
+	registerAccountExportCommands(r)
 }
@@ -0,0 +1,96 @@
+package accounts
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+
+	"github.com/killbill/kbcli/v3/kbclient/export"
+	"github.com/killbill/kbcli/v3/kbcmd/cmdlib"
+	"github.com/killbill/kbcli/v3/kbcmd/kblib"
+	"github.com/killbill/kbcli/v3/kbexport"
+	"github.com/urfave/cli"
+)
+
+var exportDir string
+
+// exportFile is the csv file of an exported table.
+type exportFile struct {
+	f    *os.File
+	w    *csv.Writer
+	rows int
+}
+
+// exportAccount - export the account data, one csv file per table
+func exportAccount(ctx context.Context, o *cmdlib.Options) error {
+	if len(o.Args) != 1 {
+		return cmdlib.ErrorInvalidArgs
+	}
+	acc, err := kblib.GetAccountByKeyOrID(ctx, o.Client(), o.Args[0])
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(exportDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]*exportFile{}
+	var tables []string
+	defer func() {
+		for _, ef := range files {
+			ef.f.Close()
+		}
+	}()
+	err = kbexport.ExportDataForAccount(ctx, o.Client().Export, &export.ExportDataForAccountParams{AccountID: acc.AccountID},
+		func(rec *kbexport.Record) error {
+			ef, ok := files[rec.Table]
+			if !ok {
+				f, err := os.Create(filepath.Join(exportDir, rec.Table+".csv"))
+				if err != nil {
+					return err
+				}
+				ef = &exportFile{f: f, w: csv.NewWriter(f)}
+				files[rec.Table] = ef
+				tables = append(tables, rec.Table)
+				if err := ef.w.Write(rec.Columns); err != nil {
+					return err
+				}
+			}
+			ef.rows++
+			return ef.w.Write(rec.Values)
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		ef := files[table]
+		ef.w.Flush()
+		if err := ef.w.Error(); err != nil {
+			return err
+		}
+		if err := ef.f.Close(); err != nil {
+			return err
+		}
+		o.Outputln("%s (%d rows)", ef.f.Name(), ef.rows)
+	}
+	return nil
+}
+
+func registerAccountExportCommands(r *cmdlib.App) {
+	r.Register("accounts", cli.Command{
+		Name:      "export",
+		Usage:     "Export all the data of the account, one csv file per table",
+		ArgsUsage: "ACCOUNT",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:        "dir",
+				Usage:       "Directory of the csv files",
+				Value:       ".",
+				Destination: &exportDir,
+			},
+		},
+		Description: "ACCOUNT can be the account id or external key. For ex., kbcmd accounts export --dir=john john",
+	}, exportAccount)
+}
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"strconv"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/killbill/kbcli/v3/kbcmd/cmdlib"
 	"github.com/killbill/kbcli/v3/kbcmd/kblib"
 	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbstream"
 	"github.com/urfave/cli"
 )
 
@@ -51,17 +53,16 @@ func listAccountPayments(ctx context.Context, o *cmdlib.Options) error {
 
 	// Always include payment attempts in the response
 	var withAttempts = true
-	resp, err := o.Client().Account.GetPaymentsForAccount(ctx, &account.GetPaymentsForAccountParams{
+	// Print the payments as they are received
+	p := o.NewPrinter()
+	_, err = o.Client().Account.GetPaymentsForAccount(ctx, &account.GetPaymentsForAccountParams{
 		AccountID:    acc.AccountID,
 		WithAttempts: &withAttempts,
-	})
-	if err != nil {
+	}, kbstream.DecodeArray(func() interface{} { return &kbmodel.Payment{} }, p.Print))
+	if err != nil && !errors.Is(err, cmdlib.ErrorLimitReached) {
 		return err
 	}
-
-	// Print the list of payments
-	o.Print(resp.Payload)
-	return nil
+	return p.Close()
 }
 
 func listAccountPaymentMethods(ctx context.Context, o *cmdlib.Options) error {
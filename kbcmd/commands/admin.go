@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/killbill/kbcli/v3/kbcommon"
 	"github.com/killbill/kbcli/v3/kbmodel"
@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/killbill/kbcli/v3/kbclient/admin"
 	"github.com/killbill/kbcli/v3/kbcmd/cmdlib"
+	"github.com/killbill/kbcli/v3/kbqueue"
 	"github.com/urfave/cli"
 )
 
@@ -40,6 +41,7 @@ func getQueues(ctx context.Context, o *cmdlib.Options) error {
 		WithInProcessing:  BoolPtr(true),
 		WithNotifications: BoolPtr(true),
 	}
+	printEntries := true
 
 	for _, arg := range o.Args {
 		parts := strings.SplitN(arg, "=", 2)
@@ -73,26 +75,47 @@ func getQueues(ctx context.Context, o *cmdlib.Options) error {
 		case "withNotifications":
 			val := parseBool(argValue)
 			params.WithNotifications = &val
+		case "entries":
+			printEntries = parseBool(argValue)
 		default:
 			return errors.New("unknown argument: " + argName)
 		}
 	}
 
-	resp, err := o.Client().Admin.GetQueueEntries(ctx, params)
-	if err != nil {
+	sum := kbqueue.NewSummary(time.Now())
+	h := sum.Handler()
+	var p *cmdlib.Printer
+	if printEntries {
+		// Print the entries as they are received. The summary needs all of them, so they are
+		// read past the limit.
+		p = o.NewPrinterWithFormatter(queueEntryFormatter)
+		printEntry := func(e *kbqueue.Info) error {
+			if err := p.Print(e); !errors.Is(err, cmdlib.ErrorLimitReached) {
+				return err
+			}
+			return nil
+		}
+		h = kbqueue.Handler{
+			BusEvent: func(e *kbqueue.BusEvent) error {
+				sum.Add(e.Info())
+				return printEntry(e.Info())
+			},
+			Notification: func(n *kbqueue.Notification) error {
+				sum.Add(n.Info())
+				return printEntry(n.Info())
+			},
+		}
+	}
+	if _, err := kbqueue.GetQueueEntries(ctx, o.Client().Admin, params, h); err != nil {
 		return err
 	}
-	if resp.IsSuccess() {
-		result := adminFormatter{}
-		result.Message = "Successfully retrieved queue entries"
-		o.Print(&result)
-		bodyBytes, err := io.ReadAll(resp.HttpResponse.Body())
-		if err != nil {
+	if p != nil {
+		if err := p.Close(); err != nil {
 			return err
 		}
-		o.Print(string(bodyBytes))
+		o.Outputln("")
 	}
-
+	o.Print(sum)
 	return nil
 }
 
@@ -498,8 +521,72 @@ var simpleSuccessOrFailFormatter = cmdlib.Formatter{
 	},
 }
 
+var queueEntryFormatter = cmdlib.Formatter{
+	Columns: []cmdlib.Column{
+		{Name: "SERVICE", Path: "$.service"},
+		{Name: "QUEUE", Path: "$.queue"},
+		{Name: "RECORD_ID", Path: "$.recordId"},
+		{Name: "STATE", Path: "$.state"},
+		{Name: "DATE", Path: "$.date"},
+		{Name: "OWNER", Path: "$.processingOwner"},
+		{Name: "ERRORS", Path: "$.errorCount"},
+		{Name: "CLASS", Path: "$.className"},
+	},
+}
+
+var queueSummaryFormatter = cmdlib.Formatter{
+	Columns: []cmdlib.Column{
+		{Name: "SERVICE", Path: "$.service"},
+		{Name: "QUEUE", Path: "$.queue"},
+		{Name: "READY", Path: "$.ready"},
+		{Name: "FUTURE", Path: "$.future"},
+		{Name: "IN_PROCESSING", Path: "$.inProcessing"},
+		{Name: "STUCK", Path: "$.stuck"},
+		{Name: "PROCESSED", Path: "$.processed"},
+		{Name: "FAILED", Path: "$.failed"},
+		{Name: "OTHER", Path: "$.other"},
+	},
+}
+
+var summaryFormatter = cmdlib.Formatter{
+	Columns: []cmdlib.Column{
+		{
+			Name: "READY",
+			Getter: func(v interface{}) interface{} {
+				var n int
+				for _, q := range v.(*kbqueue.Summary).Queues {
+					n += q.Ready
+				}
+				return n
+			},
+		},
+		{
+			Name: "STUCK",
+			Getter: func(v interface{}) interface{} {
+				return len(v.(*kbqueue.Summary).Stuck)
+			},
+		},
+		{
+			Name: "OLDEST_READY",
+			Getter: func(v interface{}) interface{} {
+				info := v.(*kbqueue.Summary).OldestReady
+				if info == nil {
+					return "-"
+				}
+				return fmt.Sprintf("%s:%s #%d due %s", info.Service, info.Queue, info.RecordID, info.Date)
+			},
+		},
+	},
+	SubItems: []cmdlib.SubItem{
+		{Name: "QUEUES", FieldName: "Queues"},
+		{Name: "STUCK", FieldName: "Stuck"},
+	},
+}
+
 func registerAdminCommands(r *cmdlib.App) {
-	cmdlib.AddFormatter(reflect.TypeOf(&admin.GetQueueEntriesOK{}), simpleSuccessOrFailFormatter)
+	cmdlib.AddFormatter(reflect.TypeOf(&kbqueue.Info{}), queueEntryFormatter)
+	cmdlib.AddFormatter(reflect.TypeOf(&kbqueue.QueueSummary{}), queueSummaryFormatter)
+	cmdlib.AddFormatter(reflect.TypeOf(&kbqueue.Summary{}), summaryFormatter)
 	cmdlib.AddFormatter(reflect.TypeOf(&adminFormatter{}), simpleSuccessOrFailFormatter)
 	// Register top level command
 	r.Register("", cli.Command{
@@ -519,6 +606,8 @@ func registerAdminCommands(r *cmdlib.App) {
 - withInProcessing=<true|false> : If true, include entries in processing. (Default: true)
 - withBusEvents=<true|false>    : If true, include bus events. (Default: true)
 - withNotifications=<true|false>: If true, include notifications. (Default: true)
+- entries=<true|false>          : If false, only print the summary: counts per service and queue, the oldest
+                                  ready entry and the entries stuck in processing. (Default: true)
 
 Usage Example:
 getQueues --accountId=12345-6789-abcd-efgh --queueName=myQueue --withHistory=false
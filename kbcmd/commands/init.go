@@ -22,6 +22,7 @@ func RegisterAll(r *cmdlib.App) {
 	registerTenantCommands(r)
 	registerAdminCommands(r)
 	registerNodesInfoCommands(r)
+	registerProfileCommands(r)
 
 	// Dev
 	registerDevCommands(r)
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/killbill/kbcli/v3/kbcommon"
 	"reflect"
@@ -16,6 +17,7 @@ import (
 	"github.com/killbill/kbcli/v3/kbcmd/cmdlib/args"
 	"github.com/killbill/kbcli/v3/kbcmd/kblib"
 	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbstream"
 	"github.com/urfave/cli"
 )
 
@@ -121,14 +123,14 @@ func listAccountInvoices(ctx context.Context, o *cmdlib.Options) error {
 		return err
 	}
 
-	resp, err := o.Client().Account.GetInvoicesForAccount(ctx, params)
-	if err != nil {
+	p := o.NewPrinter()
+	_, err = o.Client().Account.GetInvoicesForAccount(ctx, params, kbstream.DecodeArray(
+		func() interface{} { return &kbmodel.Invoice{} },
+		p.Print))
+	if err != nil && !errors.Is(err, cmdlib.ErrorLimitReached) {
 		return err
 	}
-
-	o.Print(resp.Payload)
-
-	return nil
+	return p.Close()
 }
 
 func getInvoice(ctx context.Context, o *cmdlib.Options) error {
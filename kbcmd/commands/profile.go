@@ -0,0 +1,260 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/killbill/kbcli/v3/kbcmd/cmdlib"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// newProfile is the profile added by profile add, set with its flags.
+var (
+	newProfile              cmdlib.Profile
+	newProfileReadOnlyAllow string
+)
+
+// profileSummary is a row of profile list.
+type profileSummary struct {
+	Name     string `json:"name"`
+	Current  string `json:"current"`
+	Host     string `json:"host"`
+	APIKey   string `json:"apiKey"`
+	ReadOnly bool   `json:"readOnly"`
+}
+
+var profileSummaryFormatter = cmdlib.Formatter{
+	Columns: []cmdlib.Column{
+		{
+			Name: "NAME",
+			Path: "$.name",
+		},
+		{
+			Name: "CURRENT",
+			Path: "$.current",
+		},
+		{
+			Name: "HOST",
+			Path: "$.host",
+		},
+		{
+			Name: "API_KEY",
+			Path: "$.apiKey",
+		},
+		{
+			Name: "READ_ONLY",
+			Path: "$.readOnly",
+		},
+	},
+}
+
+func listProfiles(ctx context.Context, o *cmdlib.Options) error {
+	conf := o.Config()
+	var names []string
+	for name := range conf.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var profiles []*profileSummary
+	for _, name := range names {
+		p := conf.Profiles[name]
+		s := &profileSummary{Name: name, Host: p.Host, APIKey: p.APIKey, ReadOnly: p.ReadOnly}
+		if p.URL != "" {
+			s.Host = p.URL
+		}
+		if name == o.ProfileName {
+			s.Current = "*"
+		}
+		profiles = append(profiles, s)
+	}
+	o.Print(profiles)
+	return nil
+}
+
+func showProfile(ctx context.Context, o *cmdlib.Options) error {
+	if len(o.Args) > 1 {
+		return cmdlib.ErrorInvalidArgs
+	}
+	name := o.ProfileName
+	if len(o.Args) == 1 {
+		name = o.Args[0]
+	}
+	p, ok := o.Config().Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %q not found in %s", name, o.ConfigFile)
+	}
+	data, err := yaml.Marshal(map[string]cmdlib.Profile{name: p.Redacted()})
+	if err != nil {
+		return err
+	}
+	o.Output("%s", data)
+	return nil
+}
+
+func useProfile(ctx context.Context, o *cmdlib.Options) error {
+	if len(o.Args) != 1 {
+		return cmdlib.ErrorInvalidArgs
+	}
+	conf := o.Config()
+	if _, ok := conf.Profiles[o.Args[0]]; !ok {
+		return fmt.Errorf("profile %q not found in %s", o.Args[0], o.ConfigFile)
+	}
+	conf.CurrentProfile = o.Args[0]
+	if err := conf.Save(o.ConfigFile); err != nil {
+		return err
+	}
+	o.Outputln("Using profile %s", conf.CurrentProfile)
+	return nil
+}
+
+func addProfile(ctx context.Context, o *cmdlib.Options) error {
+	if len(o.Args) != 1 {
+		return cmdlib.ErrorInvalidArgs
+	}
+	name := o.Args[0]
+	p := newProfile
+	for _, id := range strings.Split(newProfileReadOnlyAllow, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			p.ReadOnlyAllow = append(p.ReadOnlyAllow, id)
+		}
+	}
+
+	conf := o.Config()
+	if conf.Profiles == nil {
+		conf.Profiles = map[string]*cmdlib.Profile{}
+	}
+	_, replaced := conf.Profiles[name]
+	conf.Profiles[name] = &p
+	if conf.CurrentProfile == "" {
+		conf.CurrentProfile = name
+	}
+	if err := conf.Save(o.ConfigFile); err != nil {
+		return err
+	}
+	if replaced {
+		o.Outputln("Replaced profile %s in %s", name, o.ConfigFile)
+	} else {
+		o.Outputln("Added profile %s to %s", name, o.ConfigFile)
+	}
+	return nil
+}
+
+// secretFlags returns the flags setting a secret of the new profile: as plaintext, from a file,
+// or from the output of a command.
+func secretFlags(name, usage string, s *cmdlib.Secret) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:        name,
+			Usage:       usage + ", in plaintext",
+			Destination: &s.Value,
+		},
+		cli.StringFlag{
+			Name:        name + "_file",
+			Usage:       "File holding the " + usage,
+			Destination: &s.File,
+		},
+		cli.StringFlag{
+			Name:        name + "_command",
+			Usage:       "Command printing the " + usage + ", for ex. 'pass show kb/prod'",
+			Destination: &s.Command,
+		},
+	}
+}
+
+func registerProfileCommands(r *cmdlib.App) {
+	cmdlib.AddFormatter(reflect.TypeOf(&profileSummary{}), profileSummaryFormatter)
+
+	r.Register("", cli.Command{
+		Name:  "profile",
+		Usage: "Connection profiles of the configuration file (see --config and --profile)",
+	}, nil)
+
+	r.RegisterLocal("profile", cli.Command{
+		Name:  "list",
+		Usage: "List the profiles. The current one is marked with a *",
+	}, listProfiles)
+
+	r.RegisterLocal("profile", cli.Command{
+		Name:        "show",
+		Usage:       "Show a profile, with its plaintext secrets masked",
+		ArgsUsage:   "[NAME]",
+		Description: "Shows the current profile if NAME is not set",
+	}, showProfile)
+
+	r.RegisterLocal("profile", cli.Command{
+		Name:      "use",
+		Usage:     "Use a profile when --profile is not set",
+		ArgsUsage: "NAME",
+	}, useProfile)
+
+	flags := []cli.Flag{
+		cli.StringFlag{
+			Name:        "host",
+			Usage:       "Kill bill host:port",
+			Destination: &newProfile.Host,
+		},
+		cli.StringFlag{
+			Name:        "url",
+			Usage:       "Kill bill url, for ex. https://kb.example.com/killbill. Overrides host and scheme",
+			Destination: &newProfile.URL,
+		},
+		cli.StringFlag{
+			Name:        "scheme",
+			Usage:       "Transport scheme (One of http https http,https)",
+			Destination: &newProfile.Scheme,
+		},
+		cli.StringFlag{
+			Name:        "auth",
+			Usage:       "Authentication scheme (One of basic, session, bearer)",
+			Destination: &newProfile.Auth,
+		},
+		cli.StringFlag{
+			Name:        "user",
+			Usage:       "Kill bill username",
+			Destination: &newProfile.User,
+		},
+	}
+	flags = append(flags, secretFlags("password", "kill bill password", &newProfile.Password)...)
+	flags = append(flags, secretFlags("bearer_token", "bearer token", &newProfile.BearerToken)...)
+	flags = append(flags, cli.StringFlag{
+		Name:        "api_key",
+		Usage:       "Tenant api key",
+		Destination: &newProfile.APIKey,
+	})
+	flags = append(flags, secretFlags("api_secret", "tenant api secret", &newProfile.APISecret)...)
+	flags = append(flags,
+		cli.StringFlag{
+			Name:        "created_by",
+			Usage:       "Value to use in X-Killbill-CreatedBy",
+			Destination: &newProfile.CreatedBy,
+		},
+		cli.StringFlag{
+			Name:        "format",
+			Usage:       "Output format (One of table, short, default, list, json, csv, tsv, jsonl, yaml)",
+			Destination: &newProfile.Format,
+		},
+		cli.BoolFlag{
+			Name:        "read_only",
+			Usage:       "Reject every mutating request with this profile",
+			Destination: &newProfile.ReadOnly,
+		},
+		cli.StringFlag{
+			Name:        "read_only_allow",
+			Usage:       "Comma separated ids of the operations still allowed in read-only mode",
+			Destination: &newProfileReadOnlyAllow,
+		})
+	r.RegisterLocal("profile", cli.Command{
+		Name:      "add",
+		Usage:     "Add or replace a profile",
+		ArgsUsage: "NAME",
+		Flags:     flags,
+		Description: "The first profile added becomes the current one. For ex.,\n" +
+			"   kbcmd profile add --url=https://kb.example.com/killbill --auth=session --user=jane --password_command='pass show kb/prod' \\\n" +
+			"     --api_key=acme --api_secret_file=~/.config/kbcmd/acme.secret --read_only prod-eu",
+	}, addProfile)
+}
@@ -0,0 +1,70 @@
+package kbcommon
+
+import "context"
+
+// Audit headers sent by Kill Bill clients on mutating calls, and recorded in audit logs.
+const (
+	CreatedByHeader = "X-Killbill-CreatedBy"
+	ReasonHeader    = "X-Killbill-Reason"
+	CommentHeader   = "X-Killbill-Comment"
+)
+
+// AuditInfo is the audit information recorded by Kill Bill for a change.
+// Empty fields are left to the client defaults.
+type AuditInfo struct {
+	// CreatedBy - actor of the change, for ex. the end user on whose behalf the call is made.
+	CreatedBy string
+	// Reason - reason code of the change.
+	Reason string
+	// Comment - free form comment, for ex. a ticket number.
+	Comment string
+}
+
+// IsZero returns true if no field is set.
+func (a AuditInfo) IsZero() bool {
+	return a == AuditInfo{}
+}
+
+// Headers returns the audit headers to send, for the fields that are set.
+func (a AuditInfo) Headers() map[string]string {
+	res := map[string]string{}
+	if a.CreatedBy != "" {
+		res[CreatedByHeader] = a.CreatedBy
+	}
+	if a.Reason != "" {
+		res[ReasonHeader] = a.Reason
+	}
+	if a.Comment != "" {
+		res[CommentHeader] = a.Comment
+	}
+	return res
+}
+
+type auditCtxKey struct{}
+
+// WithAuditInfo returns a context carrying the given audit information. Mutating calls made
+// with it send the audit headers, overriding the client defaults (see kbtransport.AuditTransport).
+// Fields that are empty are inherited from the audit information of the parent context, if any.
+func WithAuditInfo(parent context.Context, info AuditInfo) context.Context {
+	if prev, ok := AuditInfoFromContext(parent); ok {
+		if info.CreatedBy == "" {
+			info.CreatedBy = prev.CreatedBy
+		}
+		if info.Reason == "" {
+			info.Reason = prev.Reason
+		}
+		if info.Comment == "" {
+			info.Comment = prev.Comment
+		}
+	}
+	return context.WithValue(parent, auditCtxKey{}, info)
+}
+
+// AuditInfoFromContext returns the audit information set by WithAuditInfo.
+func AuditInfoFromContext(ctx context.Context) (AuditInfo, bool) {
+	if ctx == nil {
+		return AuditInfo{}, false
+	}
+	info, ok := ctx.Value(auditCtxKey{}).(AuditInfo)
+	return info, ok
+}
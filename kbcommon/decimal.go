@@ -0,0 +1,236 @@
+package kbcommon
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalScale bounds the scale of parsed decimals, both ways, so that exponents like 1e2000000000
+// don't allocate unbounded memory.
+const maxDecimalScale = 1000
+
+// Decimal is an exact decimal number (unscaled * 10^-scale).
+// The zero value is 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// NewDecimal returns unscaled * 10^-scale. For ex. NewDecimal(1234, 2) is 12.34.
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	d := Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+	if scale < 0 {
+		return d.rescale(0)
+	}
+	return d
+}
+
+// ParseDecimal parses a decimal number, for ex. "-12.345" or "1.5E+3". Numbers with more than 1000
+// digits after the decimal point, or more than 1000 zeros added by their exponent, are out of range.
+func ParseDecimal(s string) (Decimal, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+
+	var exp int64
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+		}
+		s = s[:i]
+	}
+
+	digits := s
+	var frac string
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		digits, frac = s[:i], s[i+1:]
+	}
+	sign := ""
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		sign, digits = digits[:1], digits[1:]
+	}
+	if digits+frac == "" || strings.IndexFunc(digits+frac, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+
+	unscaled, ok := new(big.Int).SetString(sign+digits+frac, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+	scale := int64(len(frac)) - exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("decimal %q out of range", orig)
+	}
+	d := Decimal{unscaled: unscaled, scale: int32(scale)}
+	if d.scale < 0 {
+		return d.rescale(0), nil
+	}
+	return d, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on invalid input.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimalFromFloat converts a float64 to the shortest decimal that represents it.
+// Amounts decoded by kbmodel into float64 are recovered exactly as sent by the server,
+// as long as they have less than 16 significant digits.
+func NewDecimalFromFloat(f float64) Decimal {
+	return MustParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// int returns the unscaled value, never nil.
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// rescale returns d with the given scale, which must not be less than d's scale.
+func (d Decimal) rescale(scale int32) Decimal {
+	v := new(big.Int).Set(d.int())
+	if diff := scale - d.scale; diff > 0 {
+		v.Mul(v, new(big.Int).Exp(bigTen, big.NewInt(int64(diff)), nil))
+	}
+	return Decimal{unscaled: v, scale: scale}
+}
+
+// align returns both decimals with the same scale.
+func align(a, b Decimal) (Decimal, Decimal) {
+	if a.scale > b.scale {
+		return a, b.rescale(a.scale)
+	}
+	if b.scale > a.scale {
+		return a.rescale(b.scale), b
+	}
+	return a, b
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b := align(d, o)
+	return Decimal{unscaled: new(big.Int).Add(a.int(), b.int()), scale: a.scale}
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b := align(d, o)
+	return Decimal{unscaled: new(big.Int).Sub(a.int(), b.int()), scale: a.scale}
+}
+
+// Mul returns d * o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Sign returns -1, 0 or 1.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero returns true if d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and o and returns -1, 0 or 1.
+func (d Decimal) Cmp(o Decimal) int {
+	a, b := align(d, o)
+	return a.int().Cmp(b.int())
+}
+
+// Equal returns true if d and o represent the same number (regardless of the scale).
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// Round rounds d to the given number of decimal places, half away from zero
+// (java's RoundingMode.HALF_UP, used by Kill Bill).
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if d.scale <= places {
+		return d.rescale(places)
+	}
+	divisor := new(big.Int).Exp(bigTen, big.NewInt(int64(d.scale-places)), nil)
+	abs := new(big.Int).Abs(d.int())
+	q, r := new(big.Int).QuoRem(abs, divisor, new(big.Int))
+	if r.Mul(r, big.NewInt(2)).Cmp(divisor) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if d.Sign() < 0 {
+		q.Neg(q)
+	}
+	return Decimal{unscaled: q, scale: places}
+}
+
+// String returns the plain decimal representation, for ex. "-12.30".
+func (d Decimal) String() string {
+	s := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) - len(s) + 1; pad > 0 {
+			s = strings.Repeat("0", pad) + s
+		}
+		s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+	}
+	if d.Sign() < 0 {
+		return "-" + s
+	}
+	return s
+}
+
+// Float64 returns the nearest float64 value.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// MarshalJSON marshals the decimal as a JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON unmarshals a JSON number (or a string holding a number).
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
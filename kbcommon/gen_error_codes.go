@@ -0,0 +1,228 @@
+//go:build ignore
+// +build ignore
+
+// gen_error_codes generates error_code_gen.go from org.killbill.billing.ErrorCode of killbill-api:
+//
+//	go run gen_error_codes.go -ref=killbill-api-0.54.0
+//	go run gen_error_codes.go -file=/path/to/ErrorCode.java
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const errorCodeURL = "https://raw.githubusercontent.com/killbill/killbill-api/%s/src/main/java/org/killbill/billing/ErrorCode.java"
+
+var (
+	// SUB_CREATE_BP_EXISTS(1015, "Subscription bundle %s already has a base subscription"),
+	codeRegexp = regexp.MustCompile(`^\s*([A-Z_][A-Z0-9_]*)\s*\(\s*(-?\d+)\s*,`)
+	// Range 1000 : ENTITLEMENTS
+	rangeRegexp = regexp.MustCompile(`Range\s+(-?\d+)\s*:\s*([A-Za-z ,/_-]+)`)
+)
+
+// initialisms are kept upper case in go names, as golint expects.
+var initialisms = map[string]bool{"ID": true, "API": true, "URL": true, "UUID": true, "HTTP": true, "JSON": true, "XML": true}
+
+// categories of the codes not matched by the rules of category.
+var categories = map[string]string{
+	"__UNKNOWN_ERROR_CODE":                      "ErrorCategoryUnknown",
+	"SUB_CREATE_NO_BUNDLE":                      "ErrorCategoryNotFound",
+	"SUB_GET_NO_BUNDLE_FOR_SUBSCRIPTION":        "ErrorCategoryNotFound",
+	"SUB_GET_INVALID_BUNDLE_ID":                 "ErrorCategoryNotFound",
+	"SUB_INVALID_SUBSCRIPTION_ID":               "ErrorCategoryNotFound",
+	"SUB_GET_INVALID_BUNDLE_KEY":                "ErrorCategoryNotFound",
+	"INVOICE_ACCOUNT_ID_INVALID":                "ErrorCategoryNotFound",
+	"INVOICE_NO_ACCOUNT_ID_FOR_SUBSCRIPTION_ID": "ErrorCategoryNotFound",
+	"PAYMENT_NO_PAYMENT_METHODS":                "ErrorCategoryNotFound",
+}
+
+type errorCode struct {
+	name   string
+	code   int
+	goName string
+}
+
+type codeRange struct {
+	start int
+	name  string
+	codes []errorCode
+}
+
+func main() {
+	ref := flag.String("ref", "", "killbill-api git ref (tag) to read ErrorCode.java from")
+	file := flag.String("file", "", "ErrorCode.java to read instead of the killbill-api repository")
+	out := flag.String("out", "error_code_gen.go", "generated file")
+	flag.Parse()
+
+	if *file == "" && *ref == "" {
+		log.Fatal("-ref or -file is required")
+	}
+	src, err := readSource(*file, *ref)
+	if err != nil {
+		log.Fatal(err)
+	}
+	source := *ref
+	if *file != "" {
+		source = filepath.Base(*file)
+	}
+	ranges, err := parse(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := generate(ranges, source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readSource(file, ref string) ([]byte, error) {
+	if file != "" {
+		return ioutil.ReadFile(file)
+	}
+	resp, err := http.Get(fmt.Sprintf(errorCodeURL, ref))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching ErrorCode.java at %s: %s", ref, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// parse reads the enum constants of ErrorCode.java, grouped by the "Range N : NAME" comments.
+func parse(src []byte) ([]*codeRange, error) {
+	var ranges []*codeRange
+	seen := map[int]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := rangeRegexp.FindStringSubmatch(line); m != nil {
+			start, _ := strconv.Atoi(m[1])
+			ranges = append(ranges, &codeRange{start: start, name: strings.TrimSpace(m[2])})
+			continue
+		}
+		m := codeRegexp.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		code, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, err
+		}
+		if prev, ok := seen[code]; ok {
+			return nil, fmt.Errorf("code %d of %s is also the code of %s", code, m[1], prev)
+		}
+		seen[code] = m[1]
+		if len(ranges) == 0 {
+			ranges = append(ranges, &codeRange{name: "Common"})
+		}
+		r := ranges[len(ranges)-1]
+		r.codes = append(r.codes, errorCode{name: m[1], code: code, goName: "ErrorCode" + goName(m[1])})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(seen) == 0 {
+		return nil, fmt.Errorf("no error code found")
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+	return ranges, nil
+}
+
+// goName converts an enum constant to a go name, for ex. ACCOUNT_DOES_NOT_EXIST_FOR_ID to
+// AccountDoesNotExistForID.
+func goName(name string) string {
+	var b strings.Builder
+	for _, w := range strings.Split(name, "_") {
+		if w == "" {
+			continue
+		}
+		if initialisms[w] {
+			b.WriteString(w)
+			continue
+		}
+		b.WriteString(w[:1] + strings.ToLower(w[1:]))
+	}
+	return b.String()
+}
+
+// category returns the category of an error code, from its name.
+func category(name string) string {
+	if c, ok := categories[name]; ok {
+		return c
+	}
+	contains := func(parts ...string) bool {
+		for _, p := range parts {
+			if strings.Contains(name, p) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case strings.HasPrefix(name, "PAYMENT_PLUGIN_"):
+		return "ErrorCategoryPaymentPlugin"
+	case name == "NOT_IMPLEMENTED" || name == "UNEXPECTED_ERROR" || strings.HasSuffix(name, "_FAILED"):
+		return "ErrorCategoryInternal"
+	case contains("DOES_NOT_EXIST", "NOT_FOUND", "NO_SUCH", "UNKNOWN"):
+		return "ErrorCategoryNotFound"
+	case contains("EXISTS", "ALREADY_", "CONFLICT", "IN_USE"):
+		return "ErrorCategoryConflict"
+	}
+	return "ErrorCategoryValidation"
+}
+
+func generate(ranges []*codeRange, source string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_error_codes.go from org.killbill.billing.ErrorCode of %s; DO NOT EDIT.\n", source)
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package kbcommon")
+	for _, r := range ranges {
+		if len(r.codes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n// %s (%d)\nconst (\n", title(r.name), r.start)
+		for _, c := range r.codes {
+			fmt.Fprintf(&b, "\t%s ErrorCode = %d\n", c.goName, c.code)
+		}
+		fmt.Fprintln(&b, ")")
+	}
+
+	fmt.Fprintln(&b, "\n// errorCodes maps error codes to their server side name and category.")
+	fmt.Fprintln(&b, "var errorCodes = map[ErrorCode]errorCodeInfo{")
+	for i, r := range ranges {
+		if i > 0 && len(r.codes) > 0 {
+			fmt.Fprintln(&b)
+		}
+		for _, c := range r.codes {
+			fmt.Fprintf(&b, "\t%s: {%q, %s},\n", c.goName, c.name, category(c.name))
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	return format.Source(b.Bytes())
+}
+
+// title converts a range name to title case, for ex. COMMON EXCEPTIONS to Common exceptions.
+func title(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
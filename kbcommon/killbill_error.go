@@ -2,7 +2,9 @@
 package kbcommon
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -52,7 +54,139 @@ func (k *KillbillError) FormatStackTrace() string {
 	return strings.Join(result, "\n")
 }
 
+// ErrorCode returns the kill bill error code.
+func (k *KillbillError) ErrorCode() ErrorCode {
+	return ErrorCode(k.Code)
+}
+
+// Category returns the category of this error. If the error code is unknown,
+// the category is derived from the http status code.
+func (k *KillbillError) Category() ErrorCategory {
+	if c := k.ErrorCode().Category(); c != ErrorCategoryUnknown {
+		return c
+	}
+	if k.IsDuplicateEntry() {
+		return ErrorCategoryConflict
+	}
+	if strings.Contains(k.ClassName, "PaymentApiException") &&
+		(k.HTTPCode == http.StatusBadGateway || k.HTTPCode == http.StatusGatewayTimeout) {
+		return ErrorCategoryPaymentPlugin
+	}
+	return categoryFromHTTPCode(k.HTTPCode)
+}
+
+// IsDuplicateEntry returns true if the error was caused by an entity that already exists.
+//
+// Kill Bill doesn't always return the right error code on duplicate entries and
+// may return a 500 with the database error instead (https://github.com/killbill/killbill/issues/1344).
+// For ex.:
+//
+//	MySQL:    java.sql.SQLIntegrityConstraintViolationException: (conn=81) Duplicate entry '...' for key 'accounts.accounts_external_key'
+//	Postgres: org.postgresql.util.PSQLException: ERROR: duplicate key value violates unique constraint "accounts_external_key"
+func (k *KillbillError) IsDuplicateEntry() bool {
+	if duplicateErrorCodes[k.ErrorCode()] {
+		return true
+	}
+	return isDuplicateEntryMessage(k.Message) || isDuplicateEntryMessage(k.CauseMessage)
+}
+
+// Is implements errors.Is. A KillbillError matches the ErrorCode it carries and
+// the sentinel errors of this package (ErrNotFound, ErrAccountNotFound, ...).
+func (k *KillbillError) Is(target error) bool {
+	switch t := target.(type) {
+	case ErrorCode:
+		return k.Code != 0 && k.ErrorCode() == t
+	case *errorMatcher:
+		return t.match(k)
+	}
+	return false
+}
+
 // FormatFull formats all the fields of this error.
 func (k *KillbillError) FormatFull() string {
 	return fmt.Sprintf("httpcode = %d, class = %s, cause = %s, cause_msg = %s, code = %d, desc = %s", k.HTTPCode, k.ClassName, k.CauseClassName, k.CauseMessage, k.Code, k.Message)
 }
+
+// duplicateErrorCodes - error codes returned when an entity already exists.
+var duplicateErrorCodes = map[ErrorCode]bool{
+	ErrorCodeAccountAlreadyExists:       true,
+	ErrorCodeTagDefinitionAlreadyExists: true,
+	ErrorCodeTagAlreadyExists:           true,
+	ErrorCodeTenantAlreadyExists:        true,
+}
+
+// isDuplicateEntryMessage detects unique constraint violations reported by the database.
+func isDuplicateEntryMessage(msg string) bool {
+	return (strings.Contains(msg, "Duplicate entry") && strings.Contains(msg, "for key")) /* MySQL */ ||
+		strings.Contains(msg, "duplicate key value violates unique constraint") /* Postgres */ ||
+		strings.Contains(msg, "Unique index or primary key violation") /* H2 */
+}
+
+// IsDuplicateEntry returns true if err was caused by an entity that already exists.
+// See KillbillError.IsDuplicateEntry.
+func IsDuplicateEntry(err error) bool {
+	if err == nil {
+		return false
+	}
+	var kbErr *KillbillError
+	if errors.As(err, &kbErr) {
+		return kbErr.IsDuplicateEntry()
+	}
+	return isDuplicateEntryMessage(err.Error())
+}
+
+// errorMatcher is a sentinel error that matches kill bill errors through errors.Is.
+type errorMatcher struct {
+	msg   string
+	match func(k *KillbillError) bool
+}
+
+// Error returns error message
+func (e *errorMatcher) Error() string {
+	return e.msg
+}
+
+func categoryMatcher(msg string, category ErrorCategory) *errorMatcher {
+	return &errorMatcher{msg: msg, match: func(k *KillbillError) bool {
+		return k.Category() == category
+	}}
+}
+
+func codeMatcher(msg string, codes ...ErrorCode) *errorMatcher {
+	return &errorMatcher{msg: msg, match: func(k *KillbillError) bool {
+		for _, c := range codes {
+			if k.ErrorCode() == c {
+				return true
+			}
+		}
+		return false
+	}}
+}
+
+// Sentinel errors to be used with errors.Is. For ex.,
+//
+//	_, err := client.Account.GetAccountByKey(ctx, params)
+//	if errors.Is(err, kbcommon.ErrAccountNotFound) {
+//		...
+//	}
+var (
+	ErrNotFound      = categoryMatcher("killbill: not found", ErrorCategoryNotFound)
+	ErrConflict      = categoryMatcher("killbill: conflict", ErrorCategoryConflict)
+	ErrValidation    = categoryMatcher("killbill: validation error", ErrorCategoryValidation)
+	ErrPaymentPlugin = categoryMatcher("killbill: payment plugin error", ErrorCategoryPaymentPlugin)
+	ErrInternal      = categoryMatcher("killbill: internal error", ErrorCategoryInternal)
+
+	ErrDuplicateEntry = &errorMatcher{msg: "killbill: duplicate entry", match: (*KillbillError).IsDuplicateEntry}
+
+	ErrAccountNotFound        = codeMatcher("killbill: account not found", ErrorCodeAccountDoesNotExistForID, ErrorCodeAccountDoesNotExistForKey)
+	ErrAccountAlreadyExists   = codeMatcher("killbill: account already exists", ErrorCodeAccountAlreadyExists)
+	ErrBundleNotFound         = codeMatcher("killbill: bundle not found", ErrorCodeSubGetInvalidBundleID, ErrorCodeSubGetInvalidBundleKey, ErrorCodeSubCreateNoBundle)
+	ErrSubscriptionNotFound   = codeMatcher("killbill: subscription not found", ErrorCodeSubInvalidSubscriptionID)
+	ErrBaseSubscriptionExists = codeMatcher("killbill: base subscription already exists", ErrorCodeSubCreateBpExists)
+	ErrInvoiceNotFound        = codeMatcher("killbill: invoice not found", ErrorCodeInvoiceNotFound)
+	ErrPaymentNotFound        = codeMatcher("killbill: payment not found", ErrorCodePaymentNoSuchPayment)
+	ErrPaymentMethodNotFound  = codeMatcher("killbill: payment method not found", ErrorCodePaymentNoSuchPaymentMethod)
+	ErrTagDefinitionNotFound  = codeMatcher("killbill: tag definition not found", ErrorCodeTagDefinitionDoesNotExist)
+	ErrTenantNotFound         = codeMatcher("killbill: tenant not found", ErrorCodeTenantDoesNotExistForID, ErrorCodeTenantDoesNotExistForAPIKey)
+	ErrTenantAlreadyExists    = codeMatcher("killbill: tenant already exists", ErrorCodeTenantAlreadyExists)
+)
@@ -0,0 +1,131 @@
+package kbcommon
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestKillbillError_Is(t *testing.T) {
+	notFound := &KillbillError{HTTPCode: 404, Code: int(ErrorCodeAccountDoesNotExistForKey)}
+	wrapped := fmt.Errorf("get account: %w", notFound)
+
+	var scenarios = []struct {
+		Err      error
+		Target   error
+		Expected bool
+	}{
+		{notFound, ErrAccountNotFound, true},
+		{wrapped, ErrAccountNotFound, true},
+		{wrapped, ErrNotFound, true},
+		{wrapped, ErrorCodeAccountDoesNotExistForKey, true},
+		{wrapped, ErrorCodeAccountDoesNotExistForID, false},
+		{wrapped, ErrConflict, false},
+		{wrapped, ErrTenantNotFound, false},
+		{&KillbillError{HTTPCode: 409, Code: int(ErrorCodeSubCreateBpExists)}, ErrBaseSubscriptionExists, true},
+		{&KillbillError{HTTPCode: 409, Code: int(ErrorCodeSubCreateBpExists)}, ErrConflict, true},
+		{&KillbillError{HTTPCode: 502, Code: int(ErrorCodePaymentPluginException)}, ErrPaymentPlugin, true},
+		{&KillbillError{HTTPCode: 400}, ErrValidation, true},
+		{&KillbillError{HTTPCode: 404}, ErrNotFound, true},
+		{&KillbillError{HTTPCode: 404}, ErrAccountNotFound, false},
+		{&KillbillError{HTTPCode: 500}, ErrInternal, true},
+		{&KillbillError{HTTPCode: 500}, ErrorCode(0), false},
+		{errors.New("some error"), ErrNotFound, false},
+	}
+
+	for i, s := range scenarios {
+		if result := errors.Is(s.Err, s.Target); result != s.Expected {
+			t.Fatalf("scenario %d: errors.Is(%v, %v) = %t, expected %t", i, s.Err, s.Target, result, s.Expected)
+		}
+	}
+}
+
+func TestKillbillError_Category(t *testing.T) {
+	var scenarios = []struct {
+		Err      *KillbillError
+		Expected ErrorCategory
+	}{
+		{&KillbillError{HTTPCode: 409, Code: int(ErrorCodeAccountAlreadyExists)}, ErrorCategoryConflict},
+		{&KillbillError{HTTPCode: 404, Code: int(ErrorCodeTenantDoesNotExistForAPIKey)}, ErrorCategoryNotFound},
+		{&KillbillError{HTTPCode: 504, Code: 99999, ClassName: "org.killbill.billing.payment.api.PaymentApiException"}, ErrorCategoryPaymentPlugin},
+		{&KillbillError{HTTPCode: 500, Message: "ERROR: duplicate key value violates unique constraint \"accounts_external_key\""}, ErrorCategoryConflict},
+		{&KillbillError{HTTPCode: 422}, ErrorCategoryValidation},
+		{&KillbillError{HTTPCode: 401}, ErrorCategoryUnknown},
+	}
+
+	for i, s := range scenarios {
+		if result := s.Err.Category(); result != s.Expected {
+			t.Fatalf("scenario %d: expected %s, got %s", i, s.Expected, result)
+		}
+	}
+}
+
+func TestIsDuplicateEntry(t *testing.T) {
+	var scenarios = []struct {
+		Err      error
+		Expected bool
+	}{
+		{nil, false},
+		{errors.New("boom"), false},
+		{&KillbillError{HTTPCode: 500, Message: "java.sql.SQLIntegrityConstraintViolationException: (conn=81) Duplicate entry '37144e24-916' for key 'accounts.accounts_external_key'"}, true},
+		{&KillbillError{HTTPCode: 500, CauseMessage: "ERROR: duplicate key value violates unique constraint \"accounts_external_key\""}, true},
+		{fmt.Errorf("wrapped: %w", &KillbillError{HTTPCode: 409, Code: int(ErrorCodeAccountAlreadyExists)}), true},
+		{&KillbillError{HTTPCode: 500, Message: "Duplicate entry"}, false},
+		{errors.New("[500] Duplicate entry 'x' for key 'y'"), true},
+	}
+
+	for i, s := range scenarios {
+		if result := IsDuplicateEntry(s.Err); result != s.Expected {
+			t.Fatalf("scenario %d: expected %t, got %t", i, s.Expected, result)
+		}
+	}
+	if !errors.Is(scenarios[2].Err, ErrDuplicateEntry) {
+		t.Fatalf("expecting ErrDuplicateEntry to match")
+	}
+}
+
+func TestErrorCode_String(t *testing.T) {
+	if s := ErrorCodeAccountDoesNotExistForKey.String(); s != "ACCOUNT_DOES_NOT_EXIST_FOR_KEY(3003)" {
+		t.Fatalf("invalid string %s", s)
+	}
+	if s := ErrorCode(424242).String(); s != "ErrorCode(424242)" {
+		t.Fatalf("invalid string %s", s)
+	}
+}
+
+func TestErrorCodes(t *testing.T) {
+	// A code of every range of org.killbill.billing.ErrorCode.
+	for _, s := range []struct {
+		code     ErrorCode
+		value    int
+		name     string
+		category ErrorCategory
+	}{
+		{ErrorCodeUnknownErrorCode, -1, "__UNKNOWN_ERROR_CODE", ErrorCategoryUnknown},
+		{ErrorCodeUnexpectedError, 3, "UNEXPECTED_ERROR", ErrorCategoryInternal},
+		{ErrorCodeSubCreateBpExists, 1015, "SUB_CREATE_BP_EXISTS", ErrorCategoryConflict},
+		{ErrorCodeCatNoSuchPlan, 2020, "CAT_NO_SUCH_PLAN", ErrorCategoryNotFound},
+		{ErrorCodeAccountDoesNotExistForID, 3002, "ACCOUNT_DOES_NOT_EXIST_FOR_ID", ErrorCategoryNotFound},
+		{ErrorCodeTagDefinitionInUse, 3903, "TAG_DEFINITION_IN_USE", ErrorCategoryConflict},
+		{ErrorCodeTagDoesNotExist, 3950, "TAG_DOES_NOT_EXIST", ErrorCategoryNotFound},
+		{ErrorCodeInvoiceInvalidTransition, 4002, "INVOICE_INVALID_TRANSITION", ErrorCategoryValidation},
+		{ErrorCodePaymentPluginTimeout, 7101, "PAYMENT_PLUGIN_TIMEOUT", ErrorCategoryPaymentPlugin},
+		{ErrorCodeTenantAlreadyExists, 10000, "TENANT_ALREADY_EXISTS", ErrorCategoryConflict},
+	} {
+		if int(s.code) != s.value || s.code.Name() != s.name || s.code.Category() != s.category {
+			t.Fatalf("expecting %s(%d) %s, got %s %s", s.name, s.value, s.category, s.code, s.code.Category())
+		}
+	}
+
+	// Every code has a name and a category.
+	names := map[string]ErrorCode{}
+	for code, info := range errorCodes {
+		if info.name == "" || (info.category == ErrorCategoryUnknown && code != ErrorCodeUnknownErrorCode) {
+			t.Fatalf("missing name or category for %d", int(code))
+		}
+		if prev, ok := names[info.name]; ok {
+			t.Fatalf("%s is the name of %d and %d", info.name, int(prev), int(code))
+		}
+		names[info.name] = code
+	}
+}
@@ -0,0 +1,146 @@
+package kbcommon
+
+import (
+	"fmt"
+	"strings"
+)
+
+// currencyDigits - number of minor unit digits for currencies that don't use 2.
+// See ISO 4217.
+var currencyDigits = map[string]int32{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"CLF": 4, "UYW": 4,
+	"BTC": 8,
+}
+
+// CurrencyDigits returns the number of minor unit digits of the given currency
+// (2 for USD, 0 for JPY, 3 for KWD, ...).
+func CurrencyDigits(currency string) int32 {
+	if d, ok := currencyDigits[strings.ToUpper(currency)]; ok {
+		return d
+	}
+	return 2
+}
+
+// Money is an exact amount of money in a given currency.
+//
+// Money marshals to (and unmarshals from) a JSON number, as used by the kill bill API.
+// The currency is carried separately in kill bill resources, so it isn't part
+// of the JSON representation.
+type Money struct {
+	Amount   Decimal
+	Currency string
+}
+
+// NewMoney creates new money.
+func NewMoney(amount Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// ParseMoney parses the given amount.
+func ParseMoney(amount string, currency string) (Money, error) {
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(d, currency), nil
+}
+
+// NewMoneyFromFloat creates money from a float64 amount as decoded in kbmodel resources.
+// See NewDecimalFromFloat.
+func NewMoneyFromFloat(amount float64, currency string) Money {
+	return NewMoney(NewDecimalFromFloat(amount), currency)
+}
+
+// checkCurrency returns the currency of the result of an operation on m and o.
+// Zero money without currency is compatible with every currency.
+func (m Money) checkCurrency(o Money) (string, error) {
+	switch {
+	case m.Currency == o.Currency:
+		return m.Currency, nil
+	case m.Currency == "" && m.Amount.IsZero():
+		return o.Currency, nil
+	case o.Currency == "" && o.Amount.IsZero():
+		return m.Currency, nil
+	}
+	return "", fmt.Errorf("currency mismatch: %s vs %s", m.Currency, o.Currency)
+}
+
+// Add returns m + o. Both must have the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.checkCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Add(o.Amount), Currency: currency}, nil
+}
+
+// Sub returns m - o. Both must have the same currency.
+func (m Money) Sub(o Money) (Money, error) {
+	currency, err := m.checkCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Sub(o.Amount), Currency: currency}, nil
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{Amount: m.Amount.Neg(), Currency: m.Currency}
+}
+
+// Cmp compares m and o and returns -1, 0 or 1. Both must have the same currency.
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.checkCurrency(o); err != nil {
+		return 0, err
+	}
+	return m.Amount.Cmp(o.Amount), nil
+}
+
+// IsZero returns true if the amount is 0.
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// Round rounds the amount to the minor unit of the currency, the same way
+// Kill Bill does (half up).
+func (m Money) Round() Money {
+	return Money{Amount: m.Amount.Round(CurrencyDigits(m.Currency)), Currency: m.Currency}
+}
+
+// Float64 returns the nearest float64 amount, for ex. to set kbmodel fields.
+func (m Money) Float64() float64 {
+	return m.Amount.Float64()
+}
+
+// String returns the amount and currency, for ex. "12.30 USD".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount.String()
+	}
+	return m.Amount.String() + " " + m.Currency
+}
+
+// MarshalJSON marshals the amount as a JSON number.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return m.Amount.MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the amount from a JSON number. The currency is left unchanged.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	return m.Amount.UnmarshalJSON(data)
+}
+
+// SumMoney adds up the given amounts. All of them must have the same currency.
+func SumMoney(values ...Money) (Money, error) {
+	var total Money
+	for _, v := range values {
+		var err error
+		if total, err = total.Add(v); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,147 @@
+package kbcommon
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	var scenarios = []struct {
+		Input          string
+		ExpectedResult string
+		ExpectedError  bool
+	}{
+		{"0", "0", false},
+		{"12.30", "12.30", false},
+		{"-0.05", "-0.05", false},
+		{"+7", "7", false},
+		{".5", "0.5", false},
+		{"1.5E+3", "1500", false},
+		{"1.5e-3", "0.0015", false},
+		{"", "", true},
+		{"abc", "", true},
+		{"1.2.3", "", true},
+		{"-", "", true},
+		{"1e1000", "1" + strings.Repeat("0", 1000), false},
+		{"1e-1000", "0." + strings.Repeat("0", 999) + "1", false},
+		{"1e1001", "", true},
+		{"1e-1001", "", true},
+		{"1e2000000000", "", true},
+		{"0." + strings.Repeat("0", 1000) + "1", "", true},
+		// len(frac) - exp overflows int32.
+		{"0.5e-2147483648", "", true},
+		{"1e2147483648", "", true},
+	}
+
+	for _, s := range scenarios {
+		d, err := ParseDecimal(s.Input)
+		if (err != nil) != s.ExpectedError {
+			t.Fatalf("input: %s, unexpected error %v", s.Input, err)
+		}
+		if err == nil && d.String() != s.ExpectedResult {
+			t.Fatalf("input: %s, expected: %s, got: %s", s.Input, s.ExpectedResult, d.String())
+		}
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+	if sum := a.Add(b); !sum.Equal(MustParseDecimal("0.3")) {
+		t.Fatalf("expected 0.3, got %s", sum)
+	}
+	if diff := a.Sub(b); diff.String() != "-0.1" {
+		t.Fatalf("expected -0.1, got %s", diff)
+	}
+	if p := MustParseDecimal("1.25").Mul(MustParseDecimal("3")); p.String() != "3.75" {
+		t.Fatalf("expected 3.75, got %s", p)
+	}
+	if NewDecimal(5, -2).String() != "500" {
+		t.Fatalf("expected 500, got %s", NewDecimal(5, -2))
+	}
+	var zero Decimal
+	if !zero.Add(a).Equal(a) || zero.String() != "0" {
+		t.Fatalf("invalid zero value")
+	}
+
+	// Summing floats drifts, decimals don't.
+	var f float64
+	var d Decimal
+	for i := 0; i < 10; i++ {
+		f += 0.1
+		d = d.Add(NewDecimalFromFloat(0.1))
+	}
+	if f == 1 || d.String() != "1.0" {
+		t.Fatalf("expected float drift and exact decimal, got %v and %s", f, d)
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	var scenarios = []struct {
+		Input          string
+		Places         int32
+		ExpectedResult string
+	}{
+		{"1.005", 2, "1.01"},
+		{"1.004", 2, "1.00"},
+		{"-1.005", 2, "-1.01"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"7", 2, "7.00"},
+		{"0.0049", 2, "0.00"},
+	}
+
+	for _, s := range scenarios {
+		result := MustParseDecimal(s.Input).Round(s.Places).String()
+		if result != s.ExpectedResult {
+			t.Fatalf("input: %s, expected: %s, got: %s", s.Input, s.ExpectedResult, result)
+		}
+	}
+}
+
+func TestMoney(t *testing.T) {
+	usd := NewMoney(MustParseDecimal("10.005"), "usd")
+	if r := usd.Round().String(); r != "10.01 USD" {
+		t.Fatalf("invalid rounding %s", r)
+	}
+	if r := NewMoney(MustParseDecimal("1234.5"), "JPY").Round().String(); r != "1235 JPY" {
+		t.Fatalf("invalid rounding %s", r)
+	}
+	if r := NewMoney(MustParseDecimal("1.2345"), "KWD").Round().String(); r != "1.235 KWD" {
+		t.Fatalf("invalid rounding %s", r)
+	}
+
+	total, err := SumMoney(NewMoneyFromFloat(0.1, "EUR"), NewMoneyFromFloat(0.2, "EUR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.String() != "0.3 EUR" {
+		t.Fatalf("invalid sum %s", total)
+	}
+
+	if _, err := usd.Add(NewMoneyFromFloat(1, "EUR")); err == nil {
+		t.Fatalf("expecting currency mismatch error")
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	type item struct {
+		Amount Money `json:"amount"`
+	}
+	var it item
+	if err := json.Unmarshal([]byte(`{"amount": 19.99}`), &it); err != nil {
+		t.Fatal(err)
+	}
+	if it.Amount.Amount.String() != "19.99" {
+		t.Fatalf("invalid amount %s", it.Amount.String())
+	}
+	it.Amount.Currency = "USD"
+	data, err := json.Marshal(it)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"amount":19.99}` {
+		t.Fatalf("invalid json %s", string(data))
+	}
+}
@@ -0,0 +1,82 @@
+package kbcommon
+
+import "strconv"
+
+// Pagination headers returned by Kill Bill on paginated (list and search) endpoints.
+const (
+	PaginationCurrentOffsetHeader  = "X-Killbill-Pagination-CurrentOffset"
+	PaginationNextOffsetHeader     = "X-Killbill-Pagination-NextOffset"
+	PaginationTotalNbRecordsHeader = "X-Killbill-Pagination-TotalNbRecords"
+	PaginationMaxNbRecordsHeader   = "X-Killbill-Pagination-MaxNbRecords"
+	PaginationNextPageURIHeader    = "X-Killbill-Pagination-NextPageUri"
+)
+
+// HeaderGetter is implemented by responses that expose http headers,
+// for ex. runtime.ClientResponse.
+type HeaderGetter interface {
+	GetHeader(name string) string
+}
+
+// PaginationInfo represents the pagination headers of a single page.
+type PaginationInfo struct {
+	// Found is true if the response carried pagination headers at all.
+	Found bool
+
+	// CurrentOffset - offset of the current page
+	CurrentOffset int64
+
+	// NextOffset - offset of the next page. Only valid if HasNext is true.
+	NextOffset int64
+
+	// HasNext is true if the server advertised a next page.
+	HasNext bool
+
+	// TotalNbRecords - number of records matching the query
+	TotalNbRecords int64
+
+	// MaxNbRecords - total number of records (without any search filter)
+	MaxNbRecords int64
+
+	// NextPageURI - uri of the next page, if any
+	NextPageURI string
+}
+
+// ParsePaginationHeaders parses pagination headers from the given response.
+// Missing or malformed headers are ignored.
+func ParsePaginationHeaders(h HeaderGetter) PaginationInfo {
+	var info PaginationInfo
+	if h == nil {
+		return info
+	}
+	if v, ok := parseInt64Header(h, PaginationCurrentOffsetHeader); ok {
+		info.Found = true
+		info.CurrentOffset = v
+	}
+	if v, ok := parseInt64Header(h, PaginationNextOffsetHeader); ok {
+		info.Found = true
+		info.HasNext = true
+		info.NextOffset = v
+	}
+	if v, ok := parseInt64Header(h, PaginationTotalNbRecordsHeader); ok {
+		info.Found = true
+		info.TotalNbRecords = v
+	}
+	if v, ok := parseInt64Header(h, PaginationMaxNbRecordsHeader); ok {
+		info.Found = true
+		info.MaxNbRecords = v
+	}
+	info.NextPageURI = h.GetHeader(PaginationNextPageURIHeader)
+	return info
+}
+
+func parseInt64Header(h HeaderGetter, name string) (int64, bool) {
+	v := h.GetHeader(name)
+	if v == "" {
+		return 0, false
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
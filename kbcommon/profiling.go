@@ -0,0 +1,196 @@
+package kbcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Profiling headers. Kill Bill profiles the calls sending the profiling request header, and returns
+// the call tree in the response header.
+const (
+	ProfilingRequestHeader  = "X-Killbill-Profiling-Req"
+	ProfilingResponseHeader = "X-Killbill-Profiling-Resp"
+)
+
+// DefaultProfilingInfo - profiling features requested by default: the jax-rs resources, the apis,
+// the dao and the plugin calls.
+const DefaultProfilingInfo = "JAXRS,API,DAO,PLUGIN"
+
+// Kinds of profiled calls.
+const (
+	ProfilingJAXRS  = "JAXRS"
+	ProfilingAPI    = "API"
+	ProfilingDAO    = "DAO"
+	ProfilingPlugin = "PLUGIN"
+)
+
+// ProfilingCall is a profiled call, and the calls it made.
+type ProfilingCall struct {
+	// Name of the call, prefixed by its kind, for ex. "DAO:AccountSqlDao: getById".
+	Name string `json:"name"`
+
+	// DurationUsec - duration of the call, in microseconds.
+	DurationUsec int64 `json:"durationUsec"`
+
+	// Calls made during this call.
+	Calls []*ProfilingCall `json:"calls"`
+}
+
+// Kind returns the kind of the call, for ex. DAO.
+func (c *ProfilingCall) Kind() string {
+	if i := strings.Index(c.Name, ":"); i >= 0 {
+		return c.Name[:i]
+	}
+	return ""
+}
+
+// Duration returns the duration of the call.
+func (c *ProfilingCall) Duration() time.Duration {
+	return time.Duration(c.DurationUsec) * time.Microsecond
+}
+
+// Profile is the profiling data returned by Kill Bill for an operation.
+type Profile struct {
+	// OperationID, Method and PathPattern of the profiled operation, set by kbtransport.ProfilingTransport.
+	OperationID string `json:"operationId,omitempty"`
+	Method      string `json:"method,omitempty"`
+	PathPattern string `json:"pathPattern,omitempty"`
+
+	// Calls - the profiled calls made to serve the operation.
+	Calls []*ProfilingCall `json:"rawData"`
+
+	// Raw - the profiling response header.
+	Raw string `json:"-"`
+}
+
+// ParseProfile parses the value of the profiling response header.
+func ParseProfile(header string) (*Profile, error) {
+	p := &Profile{Raw: header}
+	if err := json.Unmarshal([]byte(header), p); err != nil {
+		return nil, fmt.Errorf("invalid profiling data: %v", err)
+	}
+	return p, nil
+}
+
+// ProfilingTiming is the total time spent in the calls of a kind.
+type ProfilingTiming struct {
+	Kind     string
+	Calls    int
+	Duration time.Duration
+}
+
+// Timings returns the time spent by kind of call, sorted by kind. Calls nested in a call of the
+// same kind are not counted twice.
+func (p *Profile) Timings() []ProfilingTiming {
+	timings := map[string]*ProfilingTiming{}
+	var walk func(calls []*ProfilingCall, kinds map[string]bool)
+	walk = func(calls []*ProfilingCall, kinds map[string]bool) {
+		for _, c := range calls {
+			kind := c.Kind()
+			if !kinds[kind] {
+				t, ok := timings[kind]
+				if !ok {
+					t = &ProfilingTiming{Kind: kind}
+					timings[kind] = t
+				}
+				t.Calls++
+				t.Duration += c.Duration()
+			}
+			nested := kinds
+			if !kinds[kind] {
+				nested = map[string]bool{kind: true}
+				for k := range kinds {
+					nested[k] = true
+				}
+			}
+			walk(c.Calls, nested)
+		}
+	}
+	walk(p.Calls, map[string]bool{})
+
+	res := make([]ProfilingTiming, 0, len(timings))
+	for _, t := range timings {
+		res = append(res, *t)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Kind < res[j].Kind })
+	return res
+}
+
+// WriteTree writes the call tree, one call per line indented by depth, followed by the timings.
+func (p *Profile) WriteTree(w io.Writer) {
+	if p.OperationID != "" {
+		fmt.Fprintf(w, "%s (%s %s)\n", p.OperationID, p.Method, p.PathPattern)
+	}
+	var walk func(calls []*ProfilingCall, indent string)
+	walk = func(calls []*ProfilingCall, indent string) {
+		for _, c := range calls {
+			fmt.Fprintf(w, "%s%s %v\n", indent, c.Name, c.Duration())
+			walk(c.Calls, indent+"  ")
+		}
+	}
+	walk(p.Calls, "  ")
+	for _, t := range p.Timings() {
+		fmt.Fprintf(w, "  = %s: %d calls, %v\n", t.Kind, t.Calls, t.Duration)
+	}
+}
+
+// profiling is the profiling request of a context, and the profiles of its calls.
+type profiling struct {
+	info string
+
+	mu       sync.Mutex
+	profiles []*Profile
+}
+
+type profilingCtxKey struct{}
+
+// WithProfiling returns a context asking Kill Bill to profile the calls made with it, for ex. with
+// DefaultProfilingInfo (see kbtransport.ProfilingTransport). The profiles are returned by
+// ProfilesFromContext.
+func WithProfiling(parent context.Context, info string) context.Context {
+	return context.WithValue(parent, profilingCtxKey{}, &profiling{info: info})
+}
+
+// ProfilingInfoFromContext returns the profiling features requested with WithProfiling.
+func ProfilingInfoFromContext(ctx context.Context) (string, bool) {
+	p := profilingFromContext(ctx)
+	if p == nil {
+		return "", false
+	}
+	return p.info, true
+}
+
+// AddProfile records the profile of a call made with ctx. It does nothing if ctx was not created
+// by WithProfiling.
+func AddProfile(ctx context.Context, profile *Profile) {
+	if p := profilingFromContext(ctx); p != nil {
+		p.mu.Lock()
+		p.profiles = append(p.profiles, profile)
+		p.mu.Unlock()
+	}
+}
+
+// ProfilesFromContext returns the profiles of the calls made with ctx, in order.
+func ProfilesFromContext(ctx context.Context) []*Profile {
+	p := profilingFromContext(ctx)
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*Profile(nil), p.profiles...)
+}
+
+func profilingFromContext(ctx context.Context) *profiling {
+	if ctx == nil {
+		return nil
+	}
+	p, _ := ctx.Value(profilingCtxKey{}).(*profiling)
+	return p
+}
@@ -0,0 +1,90 @@
+package kbcommon
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const profilingResp = `{"rawData": [
+  {"name": "JAXRS:GET /1.0/kb/accounts/{accountId}", "durationUsec": 5000, "calls": [
+    {"name": "API:getAccountById", "durationUsec": 4000, "calls": [
+      {"name": "DAO:AccountSqlDao: getById", "durationUsec": 1500, "calls": [
+        {"name": "DAO:AccountSqlDao: getRecordId", "durationUsec": 500}
+      ]},
+      {"name": "PLUGIN:killbill-stripe", "durationUsec": 2000, "calls": [
+        {"name": "DAO:StripeDao: getPaymentMethods", "durationUsec": 300}
+      ]}
+    ]}
+  ]}
+]}`
+
+func TestParseProfile(t *testing.T) {
+	p, err := ParseProfile(profilingResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dao := p.Calls[0].Calls[0].Calls[0]
+	if dao.Kind() != ProfilingDAO || dao.Duration() != 1500*time.Microsecond || len(dao.Calls) != 1 {
+		t.Fatalf("unexpected dao call %+v", dao)
+	}
+
+	// Nested dao calls are not counted twice, dao calls nested in plugin calls are.
+	expected := []ProfilingTiming{
+		{Kind: ProfilingAPI, Calls: 1, Duration: 4 * time.Millisecond},
+		{Kind: ProfilingDAO, Calls: 2, Duration: 1800 * time.Microsecond},
+		{Kind: ProfilingJAXRS, Calls: 1, Duration: 5 * time.Millisecond},
+		{Kind: ProfilingPlugin, Calls: 1, Duration: 2 * time.Millisecond},
+	}
+	if diff := cmp.Diff(expected, p.Timings()); diff != "" {
+		t.Fatalf("unexpected timings (-want +got):\n%s", diff)
+	}
+
+	p.OperationID, p.Method, p.PathPattern = "getAccount", "GET", "/1.0/kb/accounts/{accountId}"
+	var sb strings.Builder
+	p.WriteTree(&sb)
+	tree := `getAccount (GET /1.0/kb/accounts/{accountId})
+  JAXRS:GET /1.0/kb/accounts/{accountId} 5ms
+    API:getAccountById 4ms
+      DAO:AccountSqlDao: getById 1.5ms
+        DAO:AccountSqlDao: getRecordId 500µs
+      PLUGIN:killbill-stripe 2ms
+        DAO:StripeDao: getPaymentMethods 300µs
+  = API: 1 calls, 4ms
+  = DAO: 2 calls, 1.8ms
+  = JAXRS: 1 calls, 5ms
+  = PLUGIN: 1 calls, 2ms
+`
+	if diff := cmp.Diff(tree, sb.String()); diff != "" {
+		t.Fatalf("unexpected tree (-want +got):\n%s", diff)
+	}
+
+	if _, err := ParseProfile("not json"); err == nil {
+		t.Fatal("expecting an error for invalid data")
+	}
+}
+
+func TestProfilesFromContext(t *testing.T) {
+	ctx := context.Background()
+	AddProfile(ctx, &Profile{Raw: "ignored"})
+	if _, ok := ProfilingInfoFromContext(ctx); ok || ProfilesFromContext(ctx) != nil {
+		t.Fatal("expecting no profiling without WithProfiling")
+	}
+
+	ctx = WithProfiling(ctx, DefaultProfilingInfo)
+	if info, ok := ProfilingInfoFromContext(ctx); !ok || info != DefaultProfilingInfo {
+		t.Fatalf("unexpected profiling info %q", info)
+	}
+	AddProfile(ctx, &Profile{Raw: "1"})
+	AddProfile(context.WithValue(ctx, struct{}{}, "child"), &Profile{Raw: "2"})
+	var raw []string
+	for _, p := range ProfilesFromContext(ctx) {
+		raw = append(raw, p.Raw)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, raw); diff != "" {
+		t.Fatalf("unexpected profiles (-want +got):\n%s", diff)
+	}
+}
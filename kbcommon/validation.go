@@ -0,0 +1,89 @@
+package kbcommon
+
+import (
+	"fmt"
+	"strings"
+
+	oaerrors "github.com/go-openapi/errors"
+)
+
+// FieldError - invalid field of a request.
+type FieldError struct {
+	// Field - name of the parameter, as sent to kill bill. Body fields are prefixed with "body.",
+	// for ex. body.currency, or body.0.name for a list body.
+	Field string
+
+	// Message describing the error.
+	Message string
+}
+
+// ValidationError is returned when a request is rejected by client-side validation, before
+// being sent to kill bill. It lists every invalid field. ValidationError matches ErrValidation:
+//
+//	if errors.Is(err, kbcommon.ErrValidation) {
+//		...
+//	}
+type ValidationError struct {
+	// Operation - id of the rejected operation, for ex. addAccountBlockingState. May be empty.
+	Operation string
+
+	Fields []FieldError
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("kbcli: invalid ")
+	if e.Operation != "" {
+		sb.WriteString(e.Operation + " ")
+	}
+	sb.WriteString("request")
+	for i, f := range e.Fields {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		if f.Field != "" {
+			sb.WriteString(f.Field + ": ")
+		}
+		sb.WriteString(f.Message)
+	}
+	return sb.String()
+}
+
+// Is returns true for ErrValidation.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// FieldErrors converts the error returned by a kbmodel Validate method to field errors.
+// Field names are prefixed with prefix, if not empty.
+func FieldErrors(err error, prefix string) []FieldError {
+	if err == nil {
+		return nil
+	}
+	switch e := err.(type) {
+	case *oaerrors.CompositeError:
+		var res []FieldError
+		for _, inner := range e.Errors {
+			res = append(res, FieldErrors(inner, prefix)...)
+		}
+		return res
+	case *oaerrors.Validation:
+		return []FieldError{{Field: joinField(prefix, e.Name), Message: e.Error()}}
+	default:
+		return []FieldError{{Field: prefix, Message: err.Error()}}
+	}
+}
+
+func joinField(prefix, name string) string {
+	switch {
+	case prefix == "":
+		return name
+	case name == "":
+		return prefix
+	default:
+		return fmt.Sprintf("%s.%s", prefix, name)
+	}
+}
@@ -0,0 +1,209 @@
+// Package kbexport parses the account data export of kill bill (export.ExportDataForAccount).
+//
+// The export is a dump of all the rows of an account, table by table. Each table section
+// starts with a "-- table col1|col2|..." header line, followed by one CSV record per row with
+// the values separated by '|'. Values holding '|', quotes or line breaks are quoted, so a record
+// may span several lines. Tables without rows are not exported.
+//
+// Records are read one at a time as the export is downloaded:
+//
+//	params := &export.ExportDataForAccountParams{AccountID: accountID}
+//	err := kbexport.ExportDataForAccount(ctx, client.Export, params, func(rec *kbexport.Record) error {
+//		fmt.Println(rec.Table, rec.Get("id"))
+//		return nil
+//	})
+//
+// or all at once with ReadAll, which returns the tables keyed by name.
+package kbexport
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/killbill/kbcli/v3/kbclient/export"
+	"github.com/killbill/kbcli/v3/kbstream"
+)
+
+// Table is a table section of the export.
+type Table struct {
+	// Name of the table, for ex. invoice_items.
+	Name string
+
+	// Columns of the table.
+	Columns []string
+
+	// Records of the table, in the export order.
+	Records []*Record
+}
+
+// Record is a row of a table.
+type Record struct {
+	// Table the record belongs to.
+	Table string
+
+	// Columns of the table, shared by all the records of the table.
+	Columns []string
+
+	// Values in column order. NULL values are empty.
+	Values []string
+}
+
+// Get returns the value of the given column, or "" if the table has no such column.
+func (r *Record) Get(column string) string {
+	for i, c := range r.Columns {
+		if c == column {
+			return r.Values[i]
+		}
+	}
+	return ""
+}
+
+// Map returns the values of the record keyed by column name.
+func (r *Record) Map() map[string]string {
+	m := make(map[string]string, len(r.Columns))
+	for i, c := range r.Columns {
+		m[c] = r.Values[i]
+	}
+	return m
+}
+
+// headerPrefix starts the table header lines.
+const headerPrefix = "-- "
+
+// Reader reads the records of an export one at a time.
+type Reader struct {
+	r       *bufio.Reader
+	csv     *csv.Reader
+	line    int
+	table   string
+	columns []string
+}
+
+// NewReader returns a reader of the export read from r.
+func NewReader(r io.Reader) *Reader {
+	br := bufio.NewReader(r)
+	// csv.NewReader reuses br rather than buffering it again, so that the header lines and
+	// the records are read in order from br.
+	cr := csv.NewReader(br)
+	cr.Comma = '|'
+	cr.LazyQuotes = true
+	cr.FieldsPerRecord = -1
+	return &Reader{r: br, csv: cr}
+}
+
+// Read returns the next record, or io.EOF at the end of the export.
+func (r *Reader) Read() (*Record, error) {
+	for {
+		prefix, err := r.r.Peek(len(headerPrefix))
+		if len(prefix) == 0 {
+			return nil, err
+		}
+		switch {
+		case prefix[0] == '\n' || bytes.HasPrefix(prefix, []byte("\r\n")):
+			r.line++
+			if _, err := r.r.ReadString('\n'); err != nil {
+				return nil, err
+			}
+		case string(prefix) == headerPrefix:
+			if err := r.readHeader(); err != nil {
+				return nil, err
+			}
+		default:
+			return r.readRecord()
+		}
+	}
+}
+
+// readHeader reads a "-- table col1|col2" line.
+func (r *Reader) readHeader() error {
+	line, err := r.r.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return err
+	}
+	r.line++
+	line = strings.TrimRight(line, "\r\n")
+	fields := strings.SplitN(line[len(headerPrefix):], " ", 2)
+	if len(fields) != 2 || fields[0] == "" {
+		return fmt.Errorf("kbexport: line %d: invalid table header %q", r.line, line)
+	}
+	r.table = fields[0]
+	r.columns = strings.Split(fields[1], "|")
+	return nil
+}
+
+// readRecord reads a record of the current table.
+func (r *Reader) readRecord() (*Record, error) {
+	start := r.line + 1
+	values, err := r.csv.Read()
+	if err != nil {
+		var perr *csv.ParseError
+		if errors.As(err, &perr) {
+			return nil, fmt.Errorf("kbexport: line %d: %v", start+perr.Line-perr.StartLine, perr.Err)
+		}
+		return nil, err
+	}
+	// Line breaks are only found in quoted values, one per line of the record.
+	r.line = start
+	for _, v := range values {
+		r.line += strings.Count(v, "\n")
+	}
+	if r.table == "" {
+		return nil, fmt.Errorf("kbexport: line %d: record outside of a table", start)
+	}
+	if len(values) != len(r.columns) {
+		return nil, fmt.Errorf("kbexport: line %d: %s record has %d values, expecting %d",
+			start, r.table, len(values), len(r.columns))
+	}
+	return &Record{Table: r.table, Columns: r.columns, Values: values}, nil
+}
+
+// ReadAll reads all the records of the export, and returns the tables keyed by name.
+func ReadAll(r io.Reader) (map[string]*Table, error) {
+	tables := map[string]*Table{}
+	er := NewReader(r)
+	for {
+		rec, err := er.Read()
+		if err == io.EOF {
+			return tables, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		t, ok := tables[rec.Table]
+		if !ok {
+			t = &Table{Name: rec.Table, Columns: rec.Columns}
+			tables[rec.Table] = t
+		}
+		t.Records = append(t.Records, rec)
+	}
+}
+
+// ExportDataForAccount downloads the export of params.AccountID, and calls fn for each record
+// as it is received. Errors returned by fn stop the download, and are returned as is.
+func ExportDataForAccount(ctx context.Context, c export.ClientService, params *export.ExportDataForAccountParams,
+	fn func(*Record) error, opts ...export.ClientOption) error {
+	body, err := kbstream.ExportDataForAccount(ctx, c, params, opts...)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	r := NewReader(body)
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+}
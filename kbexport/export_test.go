@@ -0,0 +1,130 @@
+package kbexport
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/export"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestReadAll(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		export   string
+		expected map[string][]map[string]string
+		err      string
+	}{
+		{
+			name:     "empty",
+			export:   "",
+			expected: map[string][]map[string]string{},
+		},
+		{
+			name: "tables",
+			export: "-- accounts record_id|id|external_key\n1|a1|john\n" +
+				"-- invoices record_id|id|account_id\r\n1|i1|a1\r\n\r\n2|i2|a1",
+			expected: map[string][]map[string]string{
+				"accounts": {{"record_id": "1", "id": "a1", "external_key": "john"}},
+				"invoices": {
+					{"record_id": "1", "id": "i1", "account_id": "a1"},
+					{"record_id": "2", "id": "i2", "account_id": "a1"},
+				},
+			},
+		},
+		{
+			name:   "null values",
+			export: "-- accounts record_id|id|email\n1|a1|\n",
+			expected: map[string][]map[string]string{
+				"accounts": {{"record_id": "1", "id": "a1", "email": ""}},
+			},
+		},
+		{
+			name: "quoted values",
+			export: "-- accounts record_id|id|notes|email\n1|a1|\"a|b\nc \"\"d\"\"\"|\n" +
+				"-- invoices record_id|id\n1|\"i1\"\n",
+			expected: map[string][]map[string]string{
+				"accounts": {{"record_id": "1", "id": "a1", "notes": "a|b\nc \"d\"", "email": ""}},
+				"invoices": {{"record_id": "1", "id": "i1"}},
+			},
+		},
+		{
+			name:   "missing values after a quoted line break",
+			export: "-- accounts record_id|id|notes\n1|a1|\"a\nb\"\n2|a2\n",
+			err:    "kbexport: line 4: accounts record has 2 values, expecting 3",
+		},
+		{
+			name:   "invalid header",
+			export: "-- accounts\n",
+			err:    `kbexport: line 1: invalid table header "-- accounts"`,
+		},
+		{
+			name:   "record outside of a table",
+			export: "1|a1|john\n",
+			err:    "kbexport: line 1: record outside of a table",
+		},
+		{
+			name:   "missing values",
+			export: "-- accounts record_id|id|external_key\n1|a1\n",
+			err:    "kbexport: line 2: accounts record has 2 values, expecting 3",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			tables, err := ReadAll(strings.NewReader(s.export))
+			if s.err != "" {
+				if err == nil || err.Error() != s.err {
+					t.Fatalf("expecting error %q, got %v", s.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := map[string][]map[string]string{}
+			for name, table := range tables {
+				for _, rec := range table.Records {
+					actual[name] = append(actual[name], rec.Map())
+				}
+			}
+			if diff := cmp.Diff(s.expected, actual); diff != "" {
+				t.Fatalf("unexpected tables (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExportDataForAccount(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	ctx := context.Background()
+	acc, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+		Body:                  &kbmodel.Account{ExternalKey: "john", Name: "John \"Jr\" | Doe\nSr", Currency: kbmodel.AccountCurrencyUSD},
+		ProcessLocationHeader: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var records []*Record
+	err = ExportDataForAccount(ctx, client.Export, &export.ExportDataForAccountParams{AccountID: acc.Payload.AccountID},
+		func(rec *Record) error {
+			records = append(records, rec)
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Table != "accounts" ||
+		records[0].Get("id") != string(acc.Payload.AccountID) || records[0].Get("external_key") != "john" ||
+		records[0].Get("name") != acc.Payload.Name {
+		t.Fatalf("unexpected records %+v", records)
+	}
+}
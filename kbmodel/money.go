@@ -0,0 +1,149 @@
+package kbmodel
+
+// This file is not generated. It adds exact decimal accessors for the float64 amounts
+// of the generated models. See kbcommon.Money.
+
+import (
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// AccountBalanceMoney returns the account balance as exact money.
+func (m *Account) AccountBalanceMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.AccountBalance, string(m.Currency))
+}
+
+// AccountCBAMoney returns the account credit balance as exact money.
+func (m *Account) AccountCBAMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.AccountCBA, string(m.Currency))
+}
+
+// AmountMoney returns the invoice amount as exact money.
+func (m *Invoice) AmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.Amount, string(m.Currency))
+}
+
+// BalanceMoney returns the invoice balance as exact money.
+func (m *Invoice) BalanceMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.Balance, string(m.Currency))
+}
+
+// CreditAdjMoney returns the credit adjustment as exact money.
+func (m *Invoice) CreditAdjMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.CreditAdj, string(m.Currency))
+}
+
+// RefundAdjMoney returns the refund adjustment as exact money.
+func (m *Invoice) RefundAdjMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.RefundAdj, string(m.Currency))
+}
+
+// AmountMoney returns the item amount as exact money.
+func (m *InvoiceItem) AmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.Amount, string(m.Currency))
+}
+
+// RateMoney returns the item rate as exact money.
+func (m *InvoiceItem) RateMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.Rate, string(m.Currency))
+}
+
+// AuthAmountMoney returns the authorized amount as exact money.
+func (m *Payment) AuthAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.AuthAmount, string(m.Currency))
+}
+
+// CapturedAmountMoney returns the captured amount as exact money.
+func (m *Payment) CapturedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.CapturedAmount, string(m.Currency))
+}
+
+// CreditedAmountMoney returns the credited amount as exact money.
+func (m *Payment) CreditedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.CreditedAmount, string(m.Currency))
+}
+
+// PurchasedAmountMoney returns the purchased amount as exact money.
+func (m *Payment) PurchasedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.PurchasedAmount, string(m.Currency))
+}
+
+// RefundedAmountMoney returns the refunded amount as exact money.
+func (m *Payment) RefundedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.RefundedAmount, string(m.Currency))
+}
+
+// AuthAmountMoney returns the authorized amount as exact money.
+func (m *InvoicePayment) AuthAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.AuthAmount, string(m.Currency))
+}
+
+// CapturedAmountMoney returns the captured amount as exact money.
+func (m *InvoicePayment) CapturedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.CapturedAmount, string(m.Currency))
+}
+
+// CreditedAmountMoney returns the credited amount as exact money.
+func (m *InvoicePayment) CreditedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.CreditedAmount, string(m.Currency))
+}
+
+// PurchasedAmountMoney returns the purchased amount as exact money.
+func (m *InvoicePayment) PurchasedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.PurchasedAmount, string(m.Currency))
+}
+
+// RefundedAmountMoney returns the refunded amount as exact money.
+func (m *InvoicePayment) RefundedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.RefundedAmount, string(m.Currency))
+}
+
+// AmountMoney returns the transaction amount as exact money.
+func (m *PaymentTransaction) AmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.Amount, string(m.Currency))
+}
+
+// ProcessedAmountMoney returns the processed amount, in the processed currency as exact money.
+func (m *PaymentTransaction) ProcessedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.ProcessedAmount, string(m.ProcessedCurrency))
+}
+
+// AmountMoney returns the transaction amount as exact money.
+func (m *InvoicePaymentTransaction) AmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.Amount, string(m.Currency))
+}
+
+// ProcessedAmountMoney returns the processed amount, in the processed currency as exact money.
+func (m *InvoicePaymentTransaction) ProcessedAmountMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.ProcessedAmount, string(m.ProcessedCurrency))
+}
+
+// ValueMoney returns the price as exact money.
+func (m *Price) ValueMoney() kbcommon.Money {
+	return kbcommon.NewMoneyFromFloat(m.Value, string(m.Currency))
+}
+
+// QuantityDecimal returns the item quantity as an exact decimal.
+func (m *InvoiceItem) QuantityDecimal() kbcommon.Decimal {
+	return kbcommon.NewDecimalFromFloat(m.Quantity)
+}
+
+// AmountDecimal returns the usage amount as an exact decimal.
+func (m *UsageRecord) AmountDecimal() kbcommon.Decimal {
+	return kbcommon.NewDecimalFromFloat(m.Amount)
+}
+
+// ItemsAmountMoney returns the exact sum of the invoice item amounts.
+func (m *Invoice) ItemsAmountMoney() (kbcommon.Money, error) {
+	total := kbcommon.NewMoney(kbcommon.Decimal{}, string(m.Currency))
+	for _, item := range m.Items {
+		amount := item.AmountMoney()
+		if amount.Currency == "" {
+			amount.Currency = total.Currency
+		}
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return kbcommon.Money{}, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,67 @@
+package kbpager
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// AccountIterator iterates over accounts.
+type AccountIterator struct {
+	*Pager
+}
+
+// Account returns the current account. Only valid after Next returned true.
+func (it *AccountIterator) Account() *kbmodel.Account {
+	v, _ := it.Item().(*kbmodel.Account)
+	return v
+}
+
+// All reads all the remaining accounts.
+func (it *AccountIterator) All(ctx context.Context) ([]*kbmodel.Account, error) {
+	var result []*kbmodel.Account
+	for it.Next(ctx) {
+		result = append(result, it.Account())
+	}
+	return result, it.Err()
+}
+
+// GetAccounts returns an iterator over all the accounts of the tenant.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetAccounts(c account.ClientService, params *account.GetAccountsParams) *AccountIterator {
+	if params == nil {
+		params = account.NewGetAccountsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &AccountIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetAccounts(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchAccounts returns an iterator over the accounts matching params.SearchKey.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchAccounts(c account.ClientService, params *account.SearchAccountsParams) *AccountIterator {
+	if params == nil {
+		params = account.NewSearchAccountsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &AccountIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchAccounts(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
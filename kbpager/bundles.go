@@ -0,0 +1,87 @@
+package kbpager
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/bundle"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// BundleIterator iterates over bundles.
+type BundleIterator struct {
+	*Pager
+}
+
+// Bundle returns the current bundle. Only valid after Next returned true.
+func (it *BundleIterator) Bundle() *kbmodel.Bundle {
+	v, _ := it.Item().(*kbmodel.Bundle)
+	return v
+}
+
+// All reads all the remaining bundles.
+func (it *BundleIterator) All(ctx context.Context) ([]*kbmodel.Bundle, error) {
+	var result []*kbmodel.Bundle
+	for it.Next(ctx) {
+		result = append(result, it.Bundle())
+	}
+	return result, it.Err()
+}
+
+// GetBundles returns an iterator over all the bundles of the tenant.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetBundles(c bundle.ClientService, params *bundle.GetBundlesParams) *BundleIterator {
+	if params == nil {
+		params = bundle.NewGetBundlesParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &BundleIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetBundles(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchBundles returns an iterator over the bundles matching params.SearchKey.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchBundles(c bundle.ClientService, params *bundle.SearchBundlesParams) *BundleIterator {
+	if params == nil {
+		params = bundle.NewSearchBundlesParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &BundleIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchBundles(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// GetAccountBundlesPaginated returns an iterator over the bundles of params.AccountID.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetAccountBundlesPaginated(c account.ClientService, params *account.GetAccountBundlesPaginatedParams) *BundleIterator {
+	if params == nil {
+		params = account.NewGetAccountBundlesPaginatedParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &BundleIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetAccountBundlesPaginated(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
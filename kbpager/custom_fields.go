@@ -0,0 +1,86 @@
+package kbpager
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/custom_field"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// CustomFieldIterator iterates over custom fields.
+type CustomFieldIterator struct {
+	*Pager
+}
+
+// CustomField returns the current custom field. Only valid after Next returned true.
+func (it *CustomFieldIterator) CustomField() *kbmodel.CustomField {
+	v, _ := it.Item().(*kbmodel.CustomField)
+	return v
+}
+
+// All reads all the remaining custom fields.
+func (it *CustomFieldIterator) All(ctx context.Context) ([]*kbmodel.CustomField, error) {
+	var result []*kbmodel.CustomField
+	for it.Next(ctx) {
+		result = append(result, it.CustomField())
+	}
+	return result, it.Err()
+}
+
+// GetCustomFields returns an iterator over all the custom fields of the tenant.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetCustomFields(c custom_field.ClientService, params *custom_field.GetCustomFieldsParams) *CustomFieldIterator {
+	if params == nil {
+		params = custom_field.NewGetCustomFieldsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &CustomFieldIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetCustomFields(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchCustomFields returns an iterator over the custom fields matching params.SearchKey.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchCustomFields(c custom_field.ClientService, params *custom_field.SearchCustomFieldsParams) *CustomFieldIterator {
+	if params == nil {
+		params = custom_field.NewSearchCustomFieldsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &CustomFieldIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchCustomFields(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchCustomFieldsByTypeName returns an iterator over the custom fields matching the given object type, name and value.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchCustomFieldsByTypeName(c custom_field.ClientService, params *custom_field.SearchCustomFieldsByTypeNameParams) *CustomFieldIterator {
+	if params == nil {
+		params = custom_field.NewSearchCustomFieldsByTypeNameParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &CustomFieldIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchCustomFieldsByTypeName(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
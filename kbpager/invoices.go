@@ -0,0 +1,87 @@
+package kbpager
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/invoice"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// InvoiceIterator iterates over invoices.
+type InvoiceIterator struct {
+	*Pager
+}
+
+// Invoice returns the current invoice. Only valid after Next returned true.
+func (it *InvoiceIterator) Invoice() *kbmodel.Invoice {
+	v, _ := it.Item().(*kbmodel.Invoice)
+	return v
+}
+
+// All reads all the remaining invoices.
+func (it *InvoiceIterator) All(ctx context.Context) ([]*kbmodel.Invoice, error) {
+	var result []*kbmodel.Invoice
+	for it.Next(ctx) {
+		result = append(result, it.Invoice())
+	}
+	return result, it.Err()
+}
+
+// GetInvoices returns an iterator over all the invoices of the tenant.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetInvoices(c invoice.ClientService, params *invoice.GetInvoicesParams) *InvoiceIterator {
+	if params == nil {
+		params = invoice.NewGetInvoicesParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &InvoiceIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetInvoices(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchInvoices returns an iterator over the invoices matching params.SearchKey.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchInvoices(c invoice.ClientService, params *invoice.SearchInvoicesParams) *InvoiceIterator {
+	if params == nil {
+		params = invoice.NewSearchInvoicesParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &InvoiceIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchInvoices(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// GetInvoicesForAccountPaginated returns an iterator over the invoices of params.AccountID.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetInvoicesForAccountPaginated(c account.ClientService, params *account.GetInvoicesForAccountPaginatedParams) *InvoiceIterator {
+	if params == nil {
+		params = account.NewGetInvoicesForAccountPaginatedParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &InvoiceIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetInvoicesForAccountPaginated(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
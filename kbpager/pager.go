@@ -0,0 +1,158 @@
+// Package kbpager implements iterators over paginated kill bill operations
+// (list and search APIs that take Offset/Limit).
+//
+// Pages are fetched lazily. The next offset is read from the
+// X-Killbill-Pagination-* response headers when the server sends them,
+// and computed from offset + page size otherwise.
+//
+//	it := kbpager.GetAccounts(client.Account, nil)
+//	for it.Next(ctx) {
+//		acc := it.Account()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+package kbpager
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// DefaultPageSize - page size used when the params don't specify a limit.
+const DefaultPageSize int64 = 100
+
+// FetchFn fetches a single page starting at offset. It returns the items of the
+// page (as a slice) and the http response the page was read from.
+type FetchFn func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error)
+
+// Pager walks all the pages of a paginated operation, one item at a time.
+// Pager is not safe for concurrent use.
+type Pager struct {
+	fetch  FetchFn
+	offset int64
+	limit  int64
+
+	page reflect.Value
+	idx  int
+	item interface{}
+	info kbcommon.PaginationInfo
+	done bool
+	err  error
+}
+
+// New creates a pager that starts at offset and requests limit items per page.
+// If limit is not positive, DefaultPageSize is used.
+func New(fetch FetchFn, offset, limit int64) *Pager {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return &Pager{
+		fetch:  fetch,
+		offset: offset,
+		limit:  limit,
+	}
+}
+
+// Next advances the pager to the next item, fetching the next page if required.
+// It returns false when there are no more items, when a page could not be fetched,
+// or when ctx is done. Check Err after Next returns false.
+func (p *Pager) Next(ctx context.Context) bool {
+	for {
+		if p.err != nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		if p.page.IsValid() && p.idx < p.page.Len() {
+			p.item = p.page.Index(p.idx).Interface()
+			p.idx++
+			return true
+		}
+		if p.done {
+			p.item = nil
+			return false
+		}
+		p.fetchPage(ctx)
+	}
+}
+
+// Item returns the current item. Only valid after Next returned true.
+func (p *Pager) Item() interface{} {
+	return p.item
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Pagination returns the pagination headers of the last fetched page.
+func (p *Pager) Pagination() kbcommon.PaginationInfo {
+	return p.info
+}
+
+// fetchPage fetches the page at current offset and computes the next offset.
+func (p *Pager) fetchPage(ctx context.Context) {
+	items, resp, err := p.fetch(ctx, p.offset, p.limit)
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	page := reflect.ValueOf(items)
+	if items == nil {
+		page = reflect.ValueOf([]interface{}{})
+	} else if page.Kind() != reflect.Slice {
+		p.err = fmt.Errorf("kbpager: expecting slice of items, got %T", items)
+		return
+	}
+
+	var info kbcommon.PaginationInfo
+	if resp != nil {
+		info = kbcommon.ParsePaginationHeaders(resp)
+	}
+	p.info = info
+	p.page = page
+	p.idx = 0
+
+	n := int64(page.Len())
+	switch {
+	case n == 0:
+		p.done = true
+	case info.Found:
+		// Kill Bill only sends the next offset if there is a next page.
+		if info.HasNext && info.NextOffset > p.offset {
+			p.offset = info.NextOffset
+		} else {
+			p.done = true
+		}
+	default:
+		p.offset += n
+		if n < p.limit {
+			p.done = true
+		}
+	}
+}
+
+// pageBounds returns the offset and limit to start from given the operation params.
+func pageBounds(offset, limit *int64) (int64, int64) {
+	var o, l int64
+	if offset != nil {
+		o = *offset
+	}
+	if limit != nil {
+		l = *limit
+	}
+	return o, l
+}
@@ -0,0 +1,229 @@
+package kbpager
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/google/go-cmp/cmp"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// fakeResponse implements runtime.ClientResponse
+type fakeResponse struct {
+	header http.Header
+}
+
+func (r *fakeResponse) Code() int                       { return 200 }
+func (r *fakeResponse) Message() string                 { return "OK" }
+func (r *fakeResponse) GetHeader(name string) string    { return r.header.Get(name) }
+func (r *fakeResponse) GetHeaders(name string) []string { return r.header.Values(name) }
+func (r *fakeResponse) Body() io.ReadCloser             { return ioutil.NopCloser(strings.NewReader("")) }
+
+// fakeServer serves pages of integers out of total records.
+type fakeServer struct {
+	total       int
+	sendHeaders bool
+	calls       []int64
+}
+
+func (s *fakeServer) fetch(ctx context.Context, offset, limit int64) (interface{}, *fakeResponse, error) {
+	s.calls = append(s.calls, offset)
+	var items []int
+	for i := offset; i < offset+limit && i < int64(s.total); i++ {
+		items = append(items, int(i))
+	}
+	resp := &fakeResponse{header: http.Header{}}
+	if s.sendHeaders {
+		resp.header.Set(kbcommon.PaginationCurrentOffsetHeader, strconv.FormatInt(offset, 10))
+		resp.header.Set(kbcommon.PaginationTotalNbRecordsHeader, strconv.Itoa(s.total))
+		resp.header.Set(kbcommon.PaginationMaxNbRecordsHeader, strconv.Itoa(s.total))
+		if offset+limit < int64(s.total) {
+			resp.header.Set(kbcommon.PaginationNextOffsetHeader, strconv.FormatInt(offset+limit, 10))
+		}
+	}
+	return items, resp, nil
+}
+
+func (s *fakeServer) pager(offset, limit int64) *Pager {
+	return New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		items, resp, err := s.fetch(ctx, offset, limit)
+		return items, resp, err
+	}, offset, limit)
+}
+
+func collect(t *testing.T, p *Pager) []int {
+	var result []int
+	for p.Next(context.Background()) {
+		result = append(result, p.Item().(int))
+	}
+	if p.Err() != nil {
+		t.Fatalf("unexpected error %v", p.Err())
+	}
+	return result
+}
+
+func seq(from, to int) []int {
+	var result []int
+	for i := from; i < to; i++ {
+		result = append(result, i)
+	}
+	return result
+}
+
+func TestPager(t *testing.T) {
+	scenarios := []struct {
+		Name          string
+		Total         int
+		SendHeaders   bool
+		Offset        int64
+		Limit         int64
+		ExpectedItems []int
+		ExpectedCalls []int64
+	}{
+		{"headers", 7, true, 0, 3, seq(0, 7), []int64{0, 3, 6}},
+		{"headers exact pages", 6, true, 0, 3, seq(0, 6), []int64{0, 3}},
+		{"headers with offset", 7, true, 2, 3, seq(2, 7), []int64{2, 5}},
+		{"no headers", 7, false, 0, 3, seq(0, 7), []int64{0, 3, 6}},
+		{"no headers exact pages", 6, false, 0, 3, seq(0, 6), []int64{0, 3, 6}},
+		{"empty", 0, true, 0, 3, nil, []int64{0}},
+		{"default limit", 250, false, 0, 0, seq(0, 250), []int64{0, 100, 200}},
+	}
+
+	for _, s := range scenarios {
+		srv := &fakeServer{total: s.Total, sendHeaders: s.SendHeaders}
+		items := collect(t, srv.pager(s.Offset, s.Limit))
+		if diff := cmp.Diff(s.ExpectedItems, items); diff != "" {
+			t.Fatalf("%s: invalid items. %s", s.Name, diff)
+		}
+		if diff := cmp.Diff(s.ExpectedCalls, srv.calls); diff != "" {
+			t.Fatalf("%s: invalid calls. %s", s.Name, diff)
+		}
+	}
+}
+
+func TestPager_Pagination(t *testing.T) {
+	srv := &fakeServer{total: 5, sendHeaders: true}
+	p := srv.pager(0, 2)
+	if !p.Next(context.Background()) {
+		t.Fatalf("expecting an item")
+	}
+	info := p.Pagination()
+	if !info.Found || !info.HasNext || info.NextOffset != 2 || info.TotalNbRecords != 5 {
+		t.Fatalf("invalid pagination info %+v", info)
+	}
+}
+
+func TestPager_Error(t *testing.T) {
+	expErr := errors.New("boom")
+	var calls int
+	p := New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		calls++
+		if calls > 1 {
+			return nil, nil, expErr
+		}
+		return []int{1, 2}, nil, nil
+	}, 0, 2)
+
+	var items []int
+	for p.Next(context.Background()) {
+		items = append(items, p.Item().(int))
+	}
+	if p.Err() != expErr {
+		t.Fatalf("expecting error %v, got %v", expErr, p.Err())
+	}
+	if diff := cmp.Diff([]int{1, 2}, items); diff != "" {
+		t.Fatal(diff)
+	}
+	if p.Next(context.Background()) {
+		t.Fatalf("pager must stay stopped after an error")
+	}
+}
+
+func TestPager_ContextCancel(t *testing.T) {
+	srv := &fakeServer{total: 10}
+	p := srv.pager(0, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var items []int
+	for p.Next(ctx) {
+		items = append(items, p.Item().(int))
+		if len(items) == 3 {
+			cancel()
+		}
+	}
+	if p.Err() != context.Canceled {
+		t.Fatalf("expecting context.Canceled, got %v", p.Err())
+	}
+	if diff := cmp.Diff([]int{0, 1, 2}, items); diff != "" {
+		t.Fatal(diff)
+	}
+	if diff := cmp.Diff([]int64{0, 2}, srv.calls); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestPager_InvalidItems(t *testing.T) {
+	p := New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		return "not a slice", nil, nil
+	}, 0, 2)
+	if p.Next(context.Background()) || p.Err() == nil {
+		t.Fatalf("expecting error for non slice page")
+	}
+}
+
+// fakeAccountClient overrides GetAccounts of account.ClientService
+type fakeAccountClient struct {
+	account.ClientService
+	srv    *fakeServer
+	params []account.GetAccountsParams
+}
+
+func (c *fakeAccountClient) GetAccounts(ctx context.Context, params *account.GetAccountsParams, _ ...account.ClientOption) (*account.GetAccountsOK, error) {
+	c.params = append(c.params, *params)
+	items, resp, _ := c.srv.fetch(ctx, *params.Offset, *params.Limit)
+	var payload []*kbmodel.Account
+	for _, i := range items.([]int) {
+		payload = append(payload, &kbmodel.Account{ExternalKey: strconv.Itoa(i)})
+	}
+	return &account.GetAccountsOK{Payload: payload, HttpResponse: resp}, nil
+}
+
+func TestGetAccounts(t *testing.T) {
+	c := &fakeAccountClient{srv: &fakeServer{total: 5, sendHeaders: true}}
+	limit := int64(2)
+	withBalance := true
+	params := &account.GetAccountsParams{Limit: &limit, AccountWithBalance: &withBalance}
+
+	accounts, err := GetAccounts(c, params).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, a := range accounts {
+		keys = append(keys, a.ExternalKey)
+	}
+	if diff := cmp.Diff([]string{"0", "1", "2", "3", "4"}, keys); diff != "" {
+		t.Fatal(diff)
+	}
+	if len(c.params) != 3 {
+		t.Fatalf("expecting 3 calls, got %d", len(c.params))
+	}
+	for _, p := range c.params {
+		if p.AccountWithBalance == nil || !*p.AccountWithBalance {
+			t.Fatalf("params not propagated to every page")
+		}
+	}
+	if params.Offset != nil {
+		t.Fatalf("caller params must not be modified")
+	}
+}
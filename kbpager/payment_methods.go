@@ -0,0 +1,67 @@
+package kbpager
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/payment_method"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// PaymentMethodIterator iterates over payment methods.
+type PaymentMethodIterator struct {
+	*Pager
+}
+
+// PaymentMethod returns the current payment method. Only valid after Next returned true.
+func (it *PaymentMethodIterator) PaymentMethod() *kbmodel.PaymentMethod {
+	v, _ := it.Item().(*kbmodel.PaymentMethod)
+	return v
+}
+
+// All reads all the remaining payment methods.
+func (it *PaymentMethodIterator) All(ctx context.Context) ([]*kbmodel.PaymentMethod, error) {
+	var result []*kbmodel.PaymentMethod
+	for it.Next(ctx) {
+		result = append(result, it.PaymentMethod())
+	}
+	return result, it.Err()
+}
+
+// GetPaymentMethods returns an iterator over all the payment methods of the tenant.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetPaymentMethods(c payment_method.ClientService, params *payment_method.GetPaymentMethodsParams) *PaymentMethodIterator {
+	if params == nil {
+		params = payment_method.NewGetPaymentMethodsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &PaymentMethodIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetPaymentMethods(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchPaymentMethods returns an iterator over the payment methods matching params.SearchKey.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchPaymentMethods(c payment_method.ClientService, params *payment_method.SearchPaymentMethodsParams) *PaymentMethodIterator {
+	if params == nil {
+		params = payment_method.NewSearchPaymentMethodsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &PaymentMethodIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchPaymentMethods(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
@@ -0,0 +1,67 @@
+package kbpager
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/payment"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// PaymentIterator iterates over payments.
+type PaymentIterator struct {
+	*Pager
+}
+
+// Payment returns the current payment. Only valid after Next returned true.
+func (it *PaymentIterator) Payment() *kbmodel.Payment {
+	v, _ := it.Item().(*kbmodel.Payment)
+	return v
+}
+
+// All reads all the remaining payments.
+func (it *PaymentIterator) All(ctx context.Context) ([]*kbmodel.Payment, error) {
+	var result []*kbmodel.Payment
+	for it.Next(ctx) {
+		result = append(result, it.Payment())
+	}
+	return result, it.Err()
+}
+
+// GetPayments returns an iterator over all the payments of the tenant.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetPayments(c payment.ClientService, params *payment.GetPaymentsParams) *PaymentIterator {
+	if params == nil {
+		params = payment.NewGetPaymentsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &PaymentIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetPayments(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchPayments returns an iterator over the payments matching params.SearchKey.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchPayments(c payment.ClientService, params *payment.SearchPaymentsParams) *PaymentIterator {
+	if params == nil {
+		params = payment.NewSearchPaymentsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &PaymentIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchPayments(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
@@ -0,0 +1,67 @@
+package kbpager
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/tag"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// TagIterator iterates over tags.
+type TagIterator struct {
+	*Pager
+}
+
+// Tag returns the current tag. Only valid after Next returned true.
+func (it *TagIterator) Tag() *kbmodel.Tag {
+	v, _ := it.Item().(*kbmodel.Tag)
+	return v
+}
+
+// All reads all the remaining tags.
+func (it *TagIterator) All(ctx context.Context) ([]*kbmodel.Tag, error) {
+	var result []*kbmodel.Tag
+	for it.Next(ctx) {
+		result = append(result, it.Tag())
+	}
+	return result, it.Err()
+}
+
+// GetTags returns an iterator over all the tags of the tenant.
+// Offset and Limit of params are used as the starting offset and page size.
+func GetTags(c tag.ClientService, params *tag.GetTagsParams) *TagIterator {
+	if params == nil {
+		params = tag.NewGetTagsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &TagIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.GetTags(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
+
+// SearchTags returns an iterator over the tags matching params.SearchKey.
+// Offset and Limit of params are used as the starting offset and page size.
+func SearchTags(c tag.ClientService, params *tag.SearchTagsParams) *TagIterator {
+	if params == nil {
+		params = tag.NewSearchTagsParams()
+	}
+	offset, limit := pageBounds(params.Offset, params.Limit)
+	return &TagIterator{New(func(ctx context.Context, offset, limit int64) (interface{}, runtime.ClientResponse, error) {
+		p := *params
+		p.Offset = &offset
+		p.Limit = &limit
+		resp, err := c.SearchTags(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Payload, resp.HttpResponse, nil
+	}, offset, limit)}
+}
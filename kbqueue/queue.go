@@ -0,0 +1,262 @@
+// Package kbqueue decodes the bus events and notifications returned by admin.GetQueueEntries.
+//
+// The generated client discards the response of GetQueueEntries, which can be large: it lists
+// every entry of the bus and notification queues, and their history. GetQueueEntries decodes the
+// entries one at a time, and Summary aggregates them to diagnose stuck queues:
+//
+//	sum := kbqueue.NewSummary(time.Now())
+//	_, err := kbqueue.GetQueueEntries(ctx, client.Admin, &admin.GetQueueEntriesParams{}, sum.Handler())
+//	...
+//	for _, info := range sum.Stuck {
+//		fmt.Println(info.Service, info.Queue, info.RecordID, info.ProcessingOwner)
+//	}
+package kbqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbclient/admin"
+	"github.com/killbill/kbcli/v3/kbstream"
+)
+
+// ProcessingState is the processing state of a queue entry.
+type ProcessingState string
+
+// Processing states of the queue entries.
+const (
+	StateAvailable    ProcessingState = "AVAILABLE"
+	StateInProcessing ProcessingState = "IN_PROCESSING"
+	StateProcessed    ProcessingState = "PROCESSED"
+	StateFailed       ProcessingState = "FAILED"
+	StateRemoved      ProcessingState = "REMOVED"
+	StateReaped       ProcessingState = "REAPED"
+)
+
+// IsHistory returns true for the states of the entries moved to the history tables.
+func (s ProcessingState) IsHistory() bool {
+	switch s {
+	case StateProcessed, StateFailed, StateRemoved, StateReaped:
+		return true
+	}
+	return false
+}
+
+// Entry holds the fields common to bus events and notifications.
+type Entry struct {
+	// RecordID - id of the entry in its queue table.
+	RecordID int64 `json:"recordId"`
+
+	// ClassName - java class of the event, for ex. org.killbill.billing.invoice.notification.NextBillingDateNotificationKey.
+	ClassName string `json:"className,omitempty"`
+
+	// Event - the event, as sent by kill bill.
+	Event json.RawMessage `json:"event,omitempty"`
+
+	UserToken   string          `json:"userToken,omitempty"`
+	CreatedDate strfmt.DateTime `json:"createdDate,omitempty"`
+
+	// CreatingOwner - node that created the entry.
+	CreatingOwner string `json:"creatingOwner,omitempty"`
+
+	// ProcessingOwner - node that claimed the entry, if any.
+	ProcessingOwner string `json:"processingOwner,omitempty"`
+
+	// ProcessingAvailableDate - end of the claim of ProcessingOwner. Past this date, the entry can be reaped.
+	ProcessingAvailableDate *strfmt.DateTime `json:"processingAvailableDate,omitempty"`
+
+	ProcessingState ProcessingState `json:"processingState,omitempty"`
+	ErrorCount      int64           `json:"errorCount,omitempty"`
+
+	// SearchKey1 and SearchKey2 - record ids of the account and tenant of the entry.
+	SearchKey1 int64 `json:"searchKey1,omitempty"`
+	SearchKey2 int64 `json:"searchKey2,omitempty"`
+}
+
+// IsHistory returns true for the entries of the history tables.
+func (e *Entry) IsHistory() bool {
+	return e.ProcessingState.IsHistory()
+}
+
+// BusEvent is an entry of the persistent bus.
+type BusEvent struct {
+	Entry
+}
+
+// Notification is an entry of a notification queue.
+type Notification struct {
+	Entry
+
+	// QueueName - service and queue of the notification, for ex. invoice-service:next-billing-date-queue.
+	QueueName string `json:"queueName,omitempty"`
+
+	FutureUserToken string `json:"futureUserToken,omitempty"`
+
+	// EffectiveDate - date the notification is due.
+	EffectiveDate strfmt.DateTime `json:"effectiveDate,omitempty"`
+}
+
+// Service returns the service part of the queue name.
+func (n *Notification) Service() string {
+	if i := strings.Index(n.QueueName, ":"); i >= 0 {
+		return n.QueueName[:i]
+	}
+	return ""
+}
+
+// Queue returns the queue part of the queue name.
+func (n *Notification) Queue() string {
+	if i := strings.Index(n.QueueName, ":"); i >= 0 {
+		return n.QueueName[i+1:]
+	}
+	return n.QueueName
+}
+
+// QueueEntries is the response of admin.GetQueueEntries.
+type QueueEntries struct {
+	BusEvents     []*BusEvent     `json:"busEvents"`
+	Notifications []*Notification `json:"notifications"`
+}
+
+// Handler receives the decoded entries. Nil functions skip the corresponding entries.
+// Errors returned by the functions stop the decoding, and are returned as is.
+type Handler struct {
+	BusEvent     func(*BusEvent) error
+	Notification func(*Notification) error
+}
+
+// Collect returns a handler appending the entries to entries.
+func Collect(entries *QueueEntries) Handler {
+	return Handler{
+		BusEvent: func(e *BusEvent) error {
+			entries.BusEvents = append(entries.BusEvents, e)
+			return nil
+		},
+		Notification: func(n *Notification) error {
+			entries.Notifications = append(entries.Notifications, n)
+			return nil
+		},
+	}
+}
+
+// handlerError marks the errors returned by the handler.
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string {
+	return e.err.Error()
+}
+
+// Decode decodes the entries read from r one at a time, and passes them to h.
+func Decode(r io.Reader, h Handler) error {
+	dec := json.NewDecoder(r)
+	_, err := decode(dec, h)
+	if hErr, ok := err.(*handlerError); ok {
+		return hErr.err
+	}
+	return err
+}
+
+// decode returns the number of entries passed to h.
+func decode(dec *json.Decoder, h Handler) (int, error) {
+	tok, err := dec.Token()
+	if err == io.EOF || (err == nil && tok == nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return 0, fmt.Errorf("kbqueue: expecting a JSON object, got %v", tok)
+	}
+	var n int
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return n, err
+		}
+		var decodeEntry func() error
+		switch tok {
+		case "busEvents":
+			decodeEntry = func() error {
+				e := &BusEvent{}
+				if err := dec.Decode(e); err != nil {
+					return err
+				}
+				if h.BusEvent == nil {
+					return nil
+				}
+				return wrapHandlerError(h.BusEvent(e))
+			}
+		case "notifications":
+			decodeEntry = func() error {
+				e := &Notification{}
+				if err := dec.Decode(e); err != nil {
+					return err
+				}
+				if h.Notification == nil {
+					return nil
+				}
+				return wrapHandlerError(h.Notification(e))
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return n, err
+			}
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return n, err
+		}
+		if tok == nil {
+			continue
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return n, fmt.Errorf("kbqueue: expecting a JSON array, got %v", tok)
+		}
+		for dec.More() {
+			if err := decodeEntry(); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if _, err := dec.Token(); err != nil {
+			return n, err
+		}
+	}
+	_, err = dec.Token()
+	return n, err
+}
+
+func wrapHandlerError(err error) error {
+	if err != nil {
+		return &handlerError{err}
+	}
+	return nil
+}
+
+// GetQueueEntries sends admin.GetQueueEntries, and passes the entries to h as they are decoded.
+func GetQueueEntries(ctx context.Context, c admin.ClientService, params *admin.GetQueueEntriesParams, h Handler,
+	opts ...admin.ClientOption) (*admin.GetQueueEntriesOK, error) {
+	opts = append(opts, kbstream.ReadBody(func(body io.Reader) error {
+		n, err := decode(json.NewDecoder(body), h)
+		if hErr, ok := err.(*handlerError); ok {
+			return hErr.err
+		}
+		if err != nil && n > 0 {
+			// Don't let the entries be delivered twice by a retry.
+			return fmt.Errorf("%w: %v", kbstream.ErrInterrupted, err)
+		}
+		return err
+	}))
+	return c.GetQueueEntries(ctx, params, opts...)
+}
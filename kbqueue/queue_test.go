@@ -0,0 +1,111 @@
+package kbqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient/admin"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+const queueEntries = `{
+  "busEvents": [
+    {"recordId": 1, "className": "org.killbill.billing.invoice.api.user.DefaultInvoiceCreationEvent",
+     "event": {"invoiceId": "i1"}, "createdDate": "2024-01-01T10:00:00.000Z", "processingState": "AVAILABLE"},
+    {"recordId": 2, "className": "org.killbill.billing.invoice.api.user.DefaultInvoiceCreationEvent",
+     "createdDate": "2024-01-01T09:00:00.000Z", "processingState": "PROCESSED"}
+  ],
+  "notifications": [
+    {"recordId": 7, "className": "org.killbill.billing.invoice.notification.NextBillingDateNotificationKey",
+     "queueName": "invoice-service:next-billing-date-queue", "effectiveDate": "2024-01-01T08:00:00.000Z",
+     "processingState": "IN_PROCESSING", "processingOwner": "kb-1", "processingAvailableDate": "2024-01-01T08:05:00.000Z"},
+    {"recordId": 8, "className": "org.killbill.billing.invoice.notification.NextBillingDateNotificationKey",
+     "queueName": "invoice-service:next-billing-date-queue", "effectiveDate": "2024-01-01T09:30:00.000Z",
+     "processingState": "AVAILABLE"},
+    {"recordId": 9, "className": "org.killbill.billing.invoice.notification.NextBillingDateNotificationKey",
+     "queueName": "invoice-service:next-billing-date-queue", "effectiveDate": "2024-02-01T00:00:00.000Z",
+     "processingState": "AVAILABLE", "errorCount": 2}
+  ],
+  "unknown": {"ignored": true}
+}`
+
+func TestDecode(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		body          string
+		busEvents     []int64
+		notifications []int64
+		err           bool
+	}{
+		{name: "empty", body: ""},
+		{name: "null", body: "null"},
+		{name: "null sections", body: `{"busEvents": null, "notifications": null}`},
+		{name: "entries", body: queueEntries, busEvents: []int64{1, 2}, notifications: []int64{7, 8, 9}},
+		{name: "not an object", body: "[]", err: true},
+		{name: "truncated", body: queueEntries[:500], busEvents: []int64{1, 2}, err: true},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			var entries QueueEntries
+			err := Decode(strings.NewReader(s.body), Collect(&entries))
+			if s.err != (err != nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			var busEvents, notifications []int64
+			for _, e := range entries.BusEvents {
+				busEvents = append(busEvents, e.RecordID)
+			}
+			for _, n := range entries.Notifications {
+				notifications = append(notifications, n.RecordID)
+			}
+			if diff := cmp.Diff(s.busEvents, busEvents); diff != "" {
+				t.Fatalf("unexpected bus events (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(s.notifications, notifications); diff != "" {
+				t.Fatalf("unexpected notifications (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetQueueEntries(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	srv.SetQueueEntries(json.RawMessage(queueEntries))
+	client := srv.NewClient("bob", "lazar")
+	ctx := context.Background()
+
+	sum := NewSummary(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	if _, err := GetQueueEntries(ctx, client.Admin, &admin.GetQueueEntriesParams{}, sum.Handler()); err != nil {
+		t.Fatal(err)
+	}
+	expected := []*QueueSummary{
+		{Service: BusService, Queue: "DefaultInvoiceCreationEvent", Ready: 1, Processed: 1},
+		{Service: "invoice-service", Queue: "next-billing-date-queue", Ready: 1, Future: 1, InProcessing: 1, Stuck: 1},
+	}
+	if diff := cmp.Diff(expected, sum.Queues); diff != "" {
+		t.Fatalf("unexpected queues (-want +got):\n%s", diff)
+	}
+	if sum.OldestReady == nil || sum.OldestReady.RecordID != 8 {
+		t.Fatalf("unexpected oldest ready entry %+v", sum.OldestReady)
+	}
+	if len(sum.Stuck) != 1 || sum.Stuck[0].RecordID != 7 || sum.Stuck[0].ProcessingOwner != "kb-1" {
+		t.Fatalf("unexpected stuck entries %+v", sum.Stuck)
+	}
+
+	// Handler errors stop the decoding.
+	errStop := errors.New("stop")
+	_, err := GetQueueEntries(ctx, client.Admin, &admin.GetQueueEntriesParams{}, Handler{
+		Notification: func(*Notification) error { return errStop },
+	})
+	if err != errStop {
+		t.Fatalf("expecting the handler error, got %v", err)
+	}
+}
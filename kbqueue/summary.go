@@ -0,0 +1,153 @@
+package kbqueue
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// BusService is the service of the bus events in summaries. Their queue is the event class.
+const BusService = "bus"
+
+// Info is the flattened view of a bus event or notification, used by summaries.
+type Info struct {
+	Service  string `json:"service"`
+	Queue    string `json:"queue"`
+	RecordID int64  `json:"recordId"`
+
+	// ClassName - java class of the event, without its package.
+	ClassName string          `json:"className"`
+	State     ProcessingState `json:"state"`
+
+	// Date - effective date of notifications, creation date of bus events.
+	Date                    strfmt.DateTime  `json:"date"`
+	ProcessingOwner         string           `json:"processingOwner,omitempty"`
+	ProcessingAvailableDate *strfmt.DateTime `json:"processingAvailableDate,omitempty"`
+	ErrorCount              int64            `json:"errorCount"`
+}
+
+// Info returns the summary view of the bus event.
+func (e *BusEvent) Info() *Info {
+	return e.Entry.info(BusService, shortClassName(e.ClassName), e.CreatedDate)
+}
+
+// Info returns the summary view of the notification.
+func (n *Notification) Info() *Info {
+	return n.Entry.info(n.Service(), n.Queue(), n.EffectiveDate)
+}
+
+func (e *Entry) info(service, queue string, date strfmt.DateTime) *Info {
+	return &Info{
+		Service:                 service,
+		Queue:                   queue,
+		RecordID:                e.RecordID,
+		ClassName:               shortClassName(e.ClassName),
+		State:                   e.ProcessingState,
+		Date:                    date,
+		ProcessingOwner:         e.ProcessingOwner,
+		ProcessingAvailableDate: e.ProcessingAvailableDate,
+		ErrorCount:              e.ErrorCount,
+	}
+}
+
+func shortClassName(className string) string {
+	return className[strings.LastIndex(className, ".")+1:]
+}
+
+// QueueSummary counts the entries of a queue by state.
+type QueueSummary struct {
+	Service string `json:"service"`
+	Queue   string `json:"queue"`
+
+	// Ready - available entries that are due.
+	Ready int `json:"ready"`
+
+	// Future - available notifications that are not due yet.
+	Future       int `json:"future"`
+	InProcessing int `json:"inProcessing"`
+	Stuck        int `json:"stuck"`
+	Processed    int `json:"processed"`
+	Failed       int `json:"failed"`
+
+	// Other - removed and reaped entries.
+	Other int `json:"other"`
+}
+
+// Summary aggregates queue entries to diagnose stuck queues.
+type Summary struct {
+	// Now - reference time of the summary.
+	Now time.Time `json:"now"`
+
+	// Queues - counts per service and queue, sorted by service and queue.
+	Queues []*QueueSummary `json:"queues"`
+
+	// OldestReady - the ready entry that has been due for the longest time, if any.
+	OldestReady *Info `json:"oldestReady,omitempty"`
+
+	// Stuck - entries in processing whose claim expired: their owner likely died or hung.
+	Stuck []*Info `json:"stuck"`
+
+	queues map[string]*QueueSummary
+}
+
+// NewSummary returns an empty summary. now is used to tell whether entries are due or stuck.
+func NewSummary(now time.Time) *Summary {
+	return &Summary{Now: now, queues: map[string]*QueueSummary{}}
+}
+
+// Handler returns a handler adding the entries to the summary.
+func (s *Summary) Handler() Handler {
+	return Handler{
+		BusEvent: func(e *BusEvent) error {
+			s.Add(e.Info())
+			return nil
+		},
+		Notification: func(n *Notification) error {
+			s.Add(n.Info())
+			return nil
+		},
+	}
+}
+
+// Add adds an entry to the summary.
+func (s *Summary) Add(info *Info) {
+	key := info.Service + ":" + info.Queue
+	q, ok := s.queues[key]
+	if !ok {
+		q = &QueueSummary{Service: info.Service, Queue: info.Queue}
+		s.queues[key] = q
+		s.Queues = append(s.Queues, q)
+		sort.Slice(s.Queues, func(i, j int) bool {
+			if s.Queues[i].Service != s.Queues[j].Service {
+				return s.Queues[i].Service < s.Queues[j].Service
+			}
+			return s.Queues[i].Queue < s.Queues[j].Queue
+		})
+	}
+
+	switch info.State {
+	case StateAvailable:
+		if time.Time(info.Date).After(s.Now) {
+			q.Future++
+			break
+		}
+		q.Ready++
+		if s.OldestReady == nil || time.Time(info.Date).Before(time.Time(s.OldestReady.Date)) {
+			s.OldestReady = info
+		}
+	case StateInProcessing:
+		q.InProcessing++
+		if info.ProcessingAvailableDate != nil && time.Time(*info.ProcessingAvailableDate).Before(s.Now) {
+			q.Stuck++
+			s.Stuck = append(s.Stuck, info)
+		}
+	case StateProcessed:
+		q.Processed++
+	case StateFailed:
+		q.Failed++
+	default:
+		q.Other++
+	}
+}
@@ -0,0 +1,74 @@
+package kbstream
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/export"
+	"github.com/killbill/kbcli/v3/kbclient/invoice"
+	"github.com/killbill/kbcli/v3/kbclient/payment"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// GetAccounts sends account.GetAccounts, and calls fn for each account of the page as it is decoded.
+func GetAccounts(ctx context.Context, c account.ClientService, params *account.GetAccountsParams,
+	fn func(*kbmodel.Account) error, opts ...account.ClientOption) (*account.GetAccountsOK, error) {
+	opts = append(opts, DecodeArray(
+		func() interface{} { return &kbmodel.Account{} },
+		func(elem interface{}) error { return fn(elem.(*kbmodel.Account)) }))
+	return c.GetAccounts(ctx, params, opts...)
+}
+
+// GetInvoices sends invoice.GetInvoices, and calls fn for each invoice of the page as it is decoded.
+func GetInvoices(ctx context.Context, c invoice.ClientService, params *invoice.GetInvoicesParams,
+	fn func(*kbmodel.Invoice) error, opts ...invoice.ClientOption) (*invoice.GetInvoicesOK, error) {
+	opts = append(opts, DecodeArray(
+		func() interface{} { return &kbmodel.Invoice{} },
+		func(elem interface{}) error { return fn(elem.(*kbmodel.Invoice)) }))
+	return c.GetInvoices(ctx, params, opts...)
+}
+
+// SearchPayments sends payment.SearchPayments, and calls fn for each payment of the page as it is decoded.
+func SearchPayments(ctx context.Context, c payment.ClientService, params *payment.SearchPaymentsParams,
+	fn func(*kbmodel.Payment) error, opts ...payment.ClientOption) (*payment.SearchPaymentsOK, error) {
+	opts = append(opts, DecodeArray(
+		func() interface{} { return &kbmodel.Payment{} },
+		func(elem interface{}) error { return fn(elem.(*kbmodel.Payment)) }))
+	return c.SearchPayments(ctx, params, opts...)
+}
+
+// ExportDataForAccount sends export.ExportDataForAccount, and returns the export as it is received.
+// The error responses are returned right away, the errors occurring while reading the export are
+// returned by Read. The reader must be closed, which aborts the download if it was not fully read.
+func ExportDataForAccount(ctx context.Context, c export.ClientService, params *export.ExportDataForAccountParams,
+	opts ...export.ClientOption) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+	started := make(chan struct{})
+	var once sync.Once
+	opts = append(opts, func(op *runtime.ClientOperation) {
+		op.Reader = &streamReader{next: op.Reader, read: func(body io.Reader) (int, error) {
+			once.Do(func() { close(started) })
+			n, err := io.Copy(pw, body)
+			return int(n), err
+		}}
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.ExportDataForAccount(ctx, params, opts...)
+		pw.CloseWithError(err)
+		done <- err
+	}()
+	select {
+	case <-started:
+		return pr, nil
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+		return pr, nil
+	}
+}
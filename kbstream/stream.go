@@ -0,0 +1,144 @@
+// Package kbstream decodes large kill bill responses incrementally.
+//
+// The generated clients decode list responses into a slice, so GetAccounts with a large limit
+// holds every account in memory before returning. The functions of this package send the same
+// operations, but decode the JSON array one element at a time and hand each element to a
+// callback, which keeps memory flat whatever the size of the response:
+//
+//	resp, err := kbstream.GetAccounts(ctx, client.Account, &account.GetAccountsParams{Limit: &limit},
+//		func(acc *kbmodel.Account) error {
+//			fmt.Println(acc.AccountID)
+//			return nil
+//		})
+//
+// The returned response has an empty Payload, but its HttpResponse carries the pagination
+// headers. Returning an error from the callback stops the decoding, and is returned as is.
+//
+// To consume the elements from another goroutine, forward them to a channel from the callback.
+// Note that a kbtransport.DebugTransport buffers the bodies it logs.
+package kbstream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+)
+
+// ErrInterrupted is matched by the errors reading a response after part of it was delivered.
+// Such errors are not retried by kbtransport.RetryTransport, as the elements would be delivered twice.
+var ErrInterrupted = errors.New("kbstream: response interrupted")
+
+// DecodeArray returns a client option that decodes the successful response of a list operation
+// one element at a time: each element is decoded into a new value returned by newElem, and passed
+// to fn. The response returned by the client has an empty Payload.
+//
+// It is assignable to the ClientOption of every kbclient package.
+func DecodeArray(newElem func() interface{}, fn func(elem interface{}) error) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.Reader = &streamReader{next: op.Reader, empty: "[]", read: func(body io.Reader) (int, error) {
+			return decodeArray(body, newElem, fn)
+		}}
+	}
+}
+
+// CopyBody returns a client option that copies the successful response body of an operation
+// to w, for ex. the octet-stream of export.ExportDataForAccount.
+func CopyBody(w io.Writer) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.Reader = &streamReader{next: op.Reader, read: func(body io.Reader) (int, error) {
+			n, err := io.Copy(w, body)
+			return int(n), err
+		}}
+	}
+}
+
+// ReadBody returns a client option that reads the successful response body of an operation
+// with read, which errors are returned as is. Use it for responses that are not plain arrays.
+func ReadBody(read func(body io.Reader) error) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.Reader = &streamReader{next: op.Reader, read: func(body io.Reader) (int, error) {
+			return 0, read(body)
+		}}
+	}
+}
+
+// streamReader reads the 2xx responses with read, and lets next build the result from an empty body.
+// Error responses are handled by next.
+type streamReader struct {
+	next runtime.ClientResponseReader
+	// empty is the body passed to next once the response was read.
+	empty string
+	// read reads the body, and returns the number of elements (or bytes) delivered.
+	read func(body io.Reader) (int, error)
+}
+
+func (r *streamReader) ReadResponse(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if resp.Code()/100 != 2 {
+		return r.next.ReadResponse(resp, consumer)
+	}
+	n, err := r.read(resp.Body())
+	if cbErr, ok := err.(*callbackError); ok {
+		return nil, cbErr.err
+	}
+	if err != nil && n > 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInterrupted, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ReadResponse(&emptyResponse{ClientResponse: resp, body: r.empty}, consumer)
+}
+
+// emptyResponse replaces the body of a response that was already read.
+type emptyResponse struct {
+	runtime.ClientResponse
+	body string
+}
+
+func (r *emptyResponse) Body() io.ReadCloser {
+	return ioutil.NopCloser(strings.NewReader(r.body))
+}
+
+// callbackError marks the errors returned by the callbacks, which are returned unchanged.
+type callbackError struct {
+	err error
+}
+
+func (e *callbackError) Error() string {
+	return e.err.Error()
+}
+
+// decodeArray calls fn for each element of the JSON array read from body. A null body is an empty array.
+func decodeArray(body io.Reader, newElem func() interface{}, fn func(elem interface{}) error) (int, error) {
+	dec := json.NewDecoder(body)
+	tok, err := dec.Token()
+	if err == io.EOF || (err == nil && tok == nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("kbstream: expecting a JSON array, got %v", tok)
+	}
+	var n int
+	for dec.More() {
+		elem := newElem()
+		if err := dec.Decode(elem); err != nil {
+			return n, err
+		}
+		if err := fn(elem); err != nil {
+			return n, &callbackError{err}
+		}
+		n++
+	}
+	if _, err := dec.Token(); err != nil {
+		return n, err
+	}
+	return n, nil
+}
@@ -0,0 +1,127 @@
+package kbstream
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/export"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestGetAccounts(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	ctx := context.Background()
+	var expected []string
+	for _, key := range []string{"john", "jane", "jack"} {
+		_, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+			Body: &kbmodel.Account{ExternalKey: key, Currency: kbmodel.AccountCurrencyUSD},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected = append(expected, key)
+	}
+
+	var keys []string
+	resp, err := GetAccounts(ctx, client.Account, &account.GetAccountsParams{}, func(acc *kbmodel.Account) error {
+		keys = append(keys, acc.ExternalKey)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, keys); diff != "" {
+		t.Fatalf("unexpected accounts (-want +got):\n%s", diff)
+	}
+	if len(resp.Payload) != 0 || kbcommon.ParsePaginationHeaders(resp.HttpResponse).TotalNbRecords != 3 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+
+	// Callback errors stop the decoding.
+	errStop := errors.New("stop")
+	keys = nil
+	_, err = GetAccounts(ctx, client.Account, &account.GetAccountsParams{}, func(acc *kbmodel.Account) error {
+		keys = append(keys, acc.ExternalKey)
+		return errStop
+	})
+	if err != errStop || len(keys) != 1 {
+		t.Fatalf("expecting the callback error after the first account, got %v and %v", err, keys)
+	}
+}
+
+func TestDecodeArray(t *testing.T) {
+	scenarios := []struct {
+		name        string
+		body        string
+		expected    []string
+		interrupted bool
+	}{
+		{name: "empty", body: "[]"},
+		{name: "null", body: "null"},
+		{name: "elements", body: `[{"externalKey":"a"}, {"externalKey":"b"}]`, expected: []string{"a", "b"}},
+		{name: "truncated", body: `[{"externalKey":"a"}, {"externalKe`, expected: []string{"a"}, interrupted: true},
+		{name: "not an array", body: `{"externalKey":"a"}`},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			var keys []string
+			n, err := decodeArray(strings.NewReader(s.body),
+				func() interface{} { return &kbmodel.Account{} },
+				func(elem interface{}) error {
+					keys = append(keys, elem.(*kbmodel.Account).ExternalKey)
+					return nil
+				})
+			if diff := cmp.Diff(s.expected, keys); diff != "" {
+				t.Fatalf("unexpected elements (-want +got):\n%s", diff)
+			}
+			if s.interrupted != (err != nil && n > 0) {
+				t.Fatalf("unexpected error %v after %d elements", err, n)
+			}
+		})
+	}
+}
+
+func TestExportDataForAccount(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	ctx := context.Background()
+	acc, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+		Body:                  &kbmodel.Account{ExternalKey: "john", Currency: kbmodel.AccountCurrencyUSD},
+		ProcessLocationHeader: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ExportDataForAccount(ctx, client.Export, &export.ExportDataForAccountParams{AccountID: acc.Payload.AccountID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "-- accounts record_id|id|external_key") || !strings.Contains(string(data), "|john|") {
+		t.Fatalf("unexpected export %q", data)
+	}
+
+	_, err = ExportDataForAccount(ctx, client.Export, &export.ExportDataForAccountParams{AccountID: strfmt.UUID("8b5a3c1e-0000-0000-0000-000000000000")})
+	if kbErr, ok := err.(*kbcommon.KillbillError); !ok || kbErr.HTTPCode != 404 {
+		t.Fatalf("expecting not found, got %v", err)
+	}
+}
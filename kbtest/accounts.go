@@ -0,0 +1,286 @@
+package kbtest
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+func (t *tenantData) account(id strfmt.UUID) *kbmodel.Account {
+	for _, a := range t.accounts {
+		if a.AccountID == id {
+			return a
+		}
+	}
+	return nil
+}
+
+func (t *tenantData) accountByKey(key string) *kbmodel.Account {
+	for _, a := range t.accounts {
+		if a.ExternalKey == key {
+			return a
+		}
+	}
+	return nil
+}
+
+// accountParam returns the account of the given path parameter. If it doesn't exist,
+// a 404 response is written and nil is returned.
+func (r *request) accountParam(name string) *kbmodel.Account {
+	acc := r.tenant.account(r.uuidParam(name))
+	if acc == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeAccountDoesNotExistForID, "Account does not exist for id %s", r.param(name))
+	}
+	return acc
+}
+
+// accountBalance returns the sum of the balances of the committed invoices of the account.
+func (s *Server) accountBalance(t *tenantData, acc *kbmodel.Account) kbcommon.Decimal {
+	var res kbcommon.Decimal
+	for _, inv := range t.invoices {
+		if inv.AccountID == acc.AccountID {
+			res = res.Add(s.invoiceBalance(t, inv))
+		}
+	}
+	return res
+}
+
+func (s *Server) accountView(r *request, acc *kbmodel.Account) *kbmodel.Account {
+	res := *acc
+	if r.queryBool("accountWithBalance", false) || r.queryBool("accountWithBalanceAndCBA", false) {
+		res.AccountBalance = s.accountBalance(r.tenant, acc).Float64()
+	}
+	res.AuditLogs = r.auditLogs(acc.AccountID)
+	return &res
+}
+
+// POST /1.0/kb/accounts
+func (s *Server) createAccount(r *request) {
+	var body kbmodel.Account
+	if !r.decode(&body) {
+		return
+	}
+	body.AccountID = newID()
+	if body.ExternalKey == "" {
+		body.ExternalKey = string(body.AccountID)
+	}
+	if r.tenant.accountByKey(body.ExternalKey) != nil {
+		r.error(http.StatusConflict, kbcommon.ErrorCodeAccountAlreadyExists, "Account already exists for key %s", body.ExternalKey)
+		return
+	}
+	if body.ReferenceTime.IsZero() {
+		body.ReferenceTime = strfmt.DateTime(s.now)
+	}
+	if body.TimeZone == "" {
+		body.TimeZone = "UTC"
+	}
+	body.AccountBalance, body.AccountCBA, body.AuditLogs = 0, 0, nil
+	r.tenant.accounts = append(r.tenant.accounts, &body)
+	s.audit(r, body.AccountID, "ACCOUNT", "INSERT")
+	r.created("/1.0/kb/accounts/" + string(body.AccountID))
+}
+
+// GET /1.0/kb/accounts/{accountId}
+func (s *Server) getAccount(r *request) {
+	if acc := r.accountParam("accountId"); acc != nil {
+		r.json(http.StatusOK, s.accountView(r, acc))
+	}
+}
+
+// GET /1.0/kb/accounts?externalKey=
+func (s *Server) getAccountByKey(r *request) {
+	acc := r.tenant.accountByKey(r.query("externalKey"))
+	if acc == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeAccountDoesNotExistForKey, "Account does not exist for key %s", r.query("externalKey"))
+		return
+	}
+	r.json(http.StatusOK, s.accountView(r, acc))
+}
+
+// accountReadOnlyFields can't be updated.
+var accountReadOnlyFields = map[string]bool{
+	"AccountID":      true,
+	"ExternalKey":    true,
+	"AccountBalance": true,
+	"AccountCBA":     true,
+	"AuditLogs":      true,
+	"ReferenceTime":  true,
+}
+
+// PUT /1.0/kb/accounts/{accountId}
+func (s *Server) updateAccount(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	var body kbmodel.Account
+	if !r.decode(&body) {
+		return
+	}
+	if body.ExternalKey != "" && body.ExternalKey != acc.ExternalKey {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeAccountCannotChangeExternalKey, "Account external key can't be updated")
+		return
+	}
+	// Only the fields set in the body are updated.
+	src, dst := reflect.ValueOf(body), reflect.ValueOf(acc).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		name := src.Type().Field(i).Name
+		if !accountReadOnlyFields[name] && !src.Field(i).IsZero() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+	s.audit(r, acc.AccountID, "ACCOUNT", "UPDATE")
+	r.noContent()
+}
+
+// GET /1.0/kb/accounts/pagination
+func (s *Server) getAccounts(r *request) {
+	from, to := r.page(len(r.tenant.accounts))
+	res := []*kbmodel.Account{}
+	for _, acc := range r.tenant.accounts[from:to] {
+		res = append(res, s.accountView(r, acc))
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/accounts/search/{searchKey}
+func (s *Server) searchAccounts(r *request) {
+	key := strings.ToLower(r.param("searchKey"))
+	var found []*kbmodel.Account
+	for _, acc := range r.tenant.accounts {
+		for _, v := range []string{string(acc.AccountID), acc.ExternalKey, acc.Name, acc.Email, acc.Company} {
+			if v != "" && strings.Contains(strings.ToLower(v), key) {
+				found = append(found, acc)
+				break
+			}
+		}
+	}
+	from, to := r.page(len(found))
+	res := []*kbmodel.Account{}
+	for _, acc := range found[from:to] {
+		res = append(res, s.accountView(r, acc))
+	}
+	r.json(http.StatusOK, res)
+}
+
+func (t *tenantData) paymentMethod(id strfmt.UUID) *kbmodel.PaymentMethod {
+	for _, pm := range t.paymentMethods {
+		if pm.PaymentMethodID == id {
+			return pm
+		}
+	}
+	return nil
+}
+
+func (s *Server) paymentMethodView(r *request, pm *kbmodel.PaymentMethod) *kbmodel.PaymentMethod {
+	res := *pm
+	if acc := r.tenant.account(pm.AccountID); acc != nil {
+		res.IsDefault = acc.PaymentMethodID == pm.PaymentMethodID
+	}
+	res.AuditLogs = r.auditLogs(pm.PaymentMethodID)
+	return &res
+}
+
+// POST /1.0/kb/accounts/{accountId}/paymentMethods
+func (s *Server) createPaymentMethod(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	var body kbmodel.PaymentMethod
+	if !r.decode(&body) {
+		return
+	}
+	if body.PluginName == "" {
+		r.error(http.StatusBadRequest, 0, "PaymentMethod pluginName needs to be set")
+		return
+	}
+	body.PaymentMethodID = newID()
+	body.AccountID = acc.AccountID
+	if body.ExternalKey == "" {
+		body.ExternalKey = string(body.PaymentMethodID)
+	}
+	if r.queryBool("isDefault", body.IsDefault) {
+		acc.PaymentMethodID = body.PaymentMethodID
+	}
+	body.IsDefault, body.AuditLogs = false, nil
+	r.tenant.paymentMethods = append(r.tenant.paymentMethods, &body)
+	s.audit(r, body.PaymentMethodID, "PAYMENT_METHOD", "INSERT")
+
+	if r.queryBool("payAllUnpaidInvoices", false) {
+		for _, inv := range r.tenant.invoices {
+			if inv.AccountID == acc.AccountID {
+				s.autoPay(r.tenant, acc, inv)
+			}
+		}
+	}
+	r.created("/1.0/kb/paymentMethods/" + string(body.PaymentMethodID))
+}
+
+// GET /1.0/kb/accounts/{accountId}/paymentMethods
+func (s *Server) getPaymentMethodsForAccount(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	res := []*kbmodel.PaymentMethod{}
+	for _, pm := range r.tenant.paymentMethods {
+		if pm.AccountID == acc.AccountID {
+			res = append(res, s.paymentMethodView(r, pm))
+		}
+	}
+	r.json(http.StatusOK, res)
+}
+
+// PUT /1.0/kb/accounts/{accountId}/paymentMethods/{paymentMethodId}/setDefault
+func (s *Server) setDefaultPaymentMethod(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	pm := r.tenant.paymentMethod(r.uuidParam("paymentMethodId"))
+	if pm == nil || pm.AccountID != acc.AccountID {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodePaymentNoSuchPaymentMethod, "Payment method %s does not exist", r.param("paymentMethodId"))
+		return
+	}
+	acc.PaymentMethodID = pm.PaymentMethodID
+	s.audit(r, acc.AccountID, "ACCOUNT", "UPDATE")
+	r.noContent()
+}
+
+// GET /1.0/kb/paymentMethods/{paymentMethodId}
+func (s *Server) getPaymentMethod(r *request) {
+	pm := r.tenant.paymentMethod(r.uuidParam("paymentMethodId"))
+	if pm == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodePaymentNoSuchPaymentMethod, "Payment method %s does not exist", r.param("paymentMethodId"))
+		return
+	}
+	r.json(http.StatusOK, s.paymentMethodView(r, pm))
+}
+
+// DELETE /1.0/kb/paymentMethods/{paymentMethodId}
+func (s *Server) deletePaymentMethod(r *request) {
+	id := r.uuidParam("paymentMethodId")
+	for i, pm := range r.tenant.paymentMethods {
+		if pm.PaymentMethodID != id {
+			continue
+		}
+		if acc := r.tenant.account(pm.AccountID); acc != nil && acc.PaymentMethodID == id {
+			if !r.queryBool("deleteDefaultPmWithAutoPayOff", false) && !r.queryBool("forceDefaultPmDeletion", false) {
+				r.error(http.StatusBadRequest, 0, "Cannot delete default payment method %s", id)
+				return
+			}
+			acc.PaymentMethodID = ""
+		}
+		r.tenant.paymentMethods = append(r.tenant.paymentMethods[:i], r.tenant.paymentMethods[i+1:]...)
+		s.audit(r, id, "PAYMENT_METHOD", "DELETE")
+		r.noContent()
+		return
+	}
+	r.error(http.StatusNotFound, kbcommon.ErrorCodePaymentNoSuchPaymentMethod, "Payment method %s does not exist", id)
+}
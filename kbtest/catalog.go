@@ -0,0 +1,360 @@
+package kbtest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// catalog is a catalog version. Only the parts of the catalog used
+// to bill subscriptions are kept (products, plans, phases and price lists).
+type catalog struct {
+	raw           string
+	name          string
+	effectiveDate time.Time
+	currencies    []string
+	products      []*product
+	plans         []*plan
+	priceLists    []*priceList
+}
+
+type product struct {
+	name     string
+	category string
+}
+
+type plan struct {
+	name      string
+	product   *product
+	priceList string
+	phases    []*phase
+}
+
+type phase struct {
+	name          string
+	typ           string
+	unit          string
+	number        int
+	billingPeriod string
+	fixed         map[string]kbcommon.Decimal
+	recurring     map[string]kbcommon.Decimal
+}
+
+type priceList struct {
+	name  string
+	plans []string
+}
+
+// xml representation, see https://docs.killbill.io/latest/catalog.xsd
+type xmlCatalog struct {
+	EffectiveDate string   `xml:"effectiveDate"`
+	CatalogName   string   `xml:"catalogName"`
+	Currencies    []string `xml:"currencies>currency"`
+	Products      []struct {
+		Name     string `xml:"name,attr"`
+		Category string `xml:"category"`
+	} `xml:"products>product"`
+	Plans []struct {
+		Name          string     `xml:"name,attr"`
+		Product       string     `xml:"product"`
+		InitialPhases []xmlPhase `xml:"initialPhases>phase"`
+		FinalPhase    xmlPhase   `xml:"finalPhase"`
+	} `xml:"plans>plan"`
+	DefaultPriceList xmlPriceList   `xml:"priceLists>defaultPriceList"`
+	ChildPriceLists  []xmlPriceList `xml:"priceLists>childPriceList"`
+}
+
+type xmlPhase struct {
+	Type     string `xml:"type,attr"`
+	Duration struct {
+		Unit   string `xml:"unit"`
+		Number int    `xml:"number"`
+	} `xml:"duration"`
+	FixedPrices []xmlPrice `xml:"fixed>fixedPrice>price"`
+	Recurring   *struct {
+		BillingPeriod string     `xml:"billingPeriod"`
+		Prices        []xmlPrice `xml:"recurringPrice>price"`
+	} `xml:"recurring"`
+}
+
+type xmlPrice struct {
+	Currency string `xml:"currency"`
+	Value    string `xml:"value"`
+}
+
+type xmlPriceList struct {
+	Name  string   `xml:"name,attr"`
+	Plans []string `xml:"plans>plan"`
+}
+
+func parsePrices(prices []xmlPrice) (map[string]kbcommon.Decimal, error) {
+	res := map[string]kbcommon.Decimal{}
+	for _, p := range prices {
+		d, err := kbcommon.ParseDecimal(p.Value)
+		if err != nil {
+			return nil, err
+		}
+		res[p.Currency] = d
+	}
+	return res, nil
+}
+
+// parseCatalog parses a catalog xml.
+func parseCatalog(raw string) (*catalog, error) {
+	var x xmlCatalog
+	if err := xml.Unmarshal([]byte(raw), &x); err != nil {
+		return nil, err
+	}
+	effectiveDate, err := parseTime(strings.TrimSpace(x.EffectiveDate))
+	if err != nil {
+		return nil, fmt.Errorf("invalid effectiveDate: %v", err)
+	}
+	c := &catalog{
+		raw:           raw,
+		name:          x.CatalogName,
+		effectiveDate: effectiveDate,
+		currencies:    x.Currencies,
+	}
+	for _, p := range x.Products {
+		c.products = append(c.products, &product{name: p.Name, category: p.Category})
+	}
+	for _, pl := range append([]xmlPriceList{x.DefaultPriceList}, x.ChildPriceLists...) {
+		c.priceLists = append(c.priceLists, &priceList{name: pl.Name, plans: pl.Plans})
+	}
+	for _, xp := range x.Plans {
+		p := &plan{name: xp.Name, priceList: "DEFAULT"}
+		for _, pr := range c.products {
+			if pr.name == xp.Product {
+				p.product = pr
+			}
+		}
+		if p.product == nil {
+			return nil, fmt.Errorf("plan %s: unknown product %s", xp.Name, xp.Product)
+		}
+		for _, pl := range c.priceLists {
+			for _, name := range pl.plans {
+				if name == xp.Name {
+					p.priceList = pl.name
+				}
+			}
+		}
+		for _, xph := range append(xp.InitialPhases, xp.FinalPhase) {
+			ph := &phase{
+				name:   xp.Name + "-" + strings.ToLower(xph.Type),
+				typ:    xph.Type,
+				unit:   xph.Duration.Unit,
+				number: xph.Duration.Number,
+			}
+			if ph.fixed, err = parsePrices(xph.FixedPrices); err != nil {
+				return nil, fmt.Errorf("plan %s: %v", xp.Name, err)
+			}
+			if xph.Recurring != nil {
+				ph.billingPeriod = xph.Recurring.BillingPeriod
+				if ph.recurring, err = parsePrices(xph.Recurring.Prices); err != nil {
+					return nil, fmt.Errorf("plan %s: %v", xp.Name, err)
+				}
+			}
+			p.phases = append(p.phases, ph)
+		}
+		c.plans = append(c.plans, p)
+	}
+	return c, nil
+}
+
+// billingPeriod returns the billing period of the final phase.
+func (p *plan) billingPeriod() string {
+	if bp := p.phases[len(p.phases)-1].billingPeriod; bp != "" {
+		return bp
+	}
+	return "NO_BILLING_PERIOD"
+}
+
+// phaseAt returns the phase active at the given date for a subscription started at start,
+// along with the phase boundaries. end is nil for the final phase.
+func (p *plan) phaseAt(start, at time.Time) (ph *phase, phaseStart time.Time, end *time.Time) {
+	phaseStart = start
+	for i, ph := range p.phases {
+		if i == len(p.phases)-1 || ph.unit == "UNLIMITED" {
+			return ph, phaseStart, nil
+		}
+		phaseEnd := addDuration(phaseStart, ph.unit, ph.number)
+		if at.Before(phaseEnd) {
+			return ph, phaseStart, &phaseEnd
+		}
+		phaseStart = phaseEnd
+	}
+	return nil, phaseStart, nil
+}
+
+// addMonths adds months to t, the same way joda-time does: the day is
+// clamped to the end of the resulting month.
+func addMonths(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
+
+func addDuration(t time.Time, unit string, number int) time.Time {
+	switch unit {
+	case "DAYS":
+		return t.AddDate(0, 0, number)
+	case "WEEKS":
+		return t.AddDate(0, 0, 7*number)
+	case "MONTHS":
+		return addMonths(t, number)
+	case "YEARS":
+		return addMonths(t, 12*number)
+	}
+	return t
+}
+
+// billing periods as (months, days)
+var billingPeriods = map[string][2]int{
+	"DAILY":           {0, 1},
+	"WEEKLY":          {0, 7},
+	"BIWEEKLY":        {0, 14},
+	"THIRTY_DAYS":     {0, 30},
+	"THIRTY_ONE_DAYS": {0, 31},
+	"SIXTY_DAYS":      {0, 60},
+	"NINETY_DAYS":     {0, 90},
+	"MONTHLY":         {1, 0},
+	"BIMESTRIAL":      {2, 0},
+	"QUARTERLY":       {3, 0},
+	"TRIANNUAL":       {4, 0},
+	"BIANNUAL":        {6, 0},
+	"ANNUAL":          {12, 0},
+	"SESQUIENNIAL":    {18, 0},
+	"BIENNIAL":        {24, 0},
+	"TRIENNIAL":       {36, 0},
+}
+
+// addPeriod adds a billing period to t. ok is false for NO_BILLING_PERIOD.
+func addPeriod(t time.Time, billingPeriod string) (res time.Time, ok bool) {
+	p, ok := billingPeriods[billingPeriod]
+	if !ok {
+		return t, false
+	}
+	return addMonths(t, p[0]).AddDate(0, 0, p[1]), true
+}
+
+// currentCatalog returns the last uploaded catalog, if any.
+func (t *tenantData) currentCatalog() *catalog {
+	if len(t.catalogs) == 0 {
+		return nil
+	}
+	return t.catalogs[len(t.catalogs)-1]
+}
+
+// findPlan returns the plan with the given name or, if the name is empty, the plan
+// matching the product, billing period and price list (DEFAULT if empty).
+func (t *tenantData) findPlan(name string, productName string, billingPeriod string, priceListName string) *plan {
+	c := t.currentCatalog()
+	if c == nil {
+		return nil
+	}
+	if priceListName == "" {
+		priceListName = "DEFAULT"
+	}
+	for _, p := range c.plans {
+		if name != "" && p.name == name {
+			return p
+		}
+		if name == "" && p.product.name == productName && p.billingPeriod() == billingPeriod && p.priceList == priceListName {
+			return p
+		}
+	}
+	return nil
+}
+
+func pricesView(prices map[string]kbcommon.Decimal) []*kbmodel.Price {
+	res := []*kbmodel.Price{}
+	for cur, v := range prices {
+		res = append(res, &kbmodel.Price{Currency: kbmodel.PriceCurrencyEnum(cur), Value: v.Float64()})
+	}
+	return res
+}
+
+func catalogView(c *catalog) *kbmodel.Catalog {
+	res := &kbmodel.Catalog{
+		Name:          c.name,
+		EffectiveDate: strfmt.DateTime(c.effectiveDate),
+	}
+	for _, cur := range c.currencies {
+		res.Currencies = append(res.Currencies, kbmodel.CatalogCurrenciesEnum(cur))
+	}
+	for _, pr := range c.products {
+		p := &kbmodel.Product{Name: pr.name, PrettyName: pr.name, Type: pr.category}
+		for _, pl := range c.plans {
+			if pl.product != pr {
+				continue
+			}
+			plv := &kbmodel.Plan{
+				Name:          pl.name,
+				PrettyName:    pl.name,
+				BillingPeriod: kbmodel.PlanBillingPeriodEnum(pl.billingPeriod()),
+			}
+			for _, ph := range pl.phases {
+				plv.Phases = append(plv.Phases, &kbmodel.Phase{
+					Type:        ph.typ,
+					Duration:    &kbmodel.Duration{Unit: kbmodel.DurationUnitEnum(ph.unit), Number: int32(ph.number)},
+					FixedPrices: pricesView(ph.fixed),
+					Prices:      pricesView(ph.recurring),
+				})
+			}
+			p.Plans = append(p.Plans, plv)
+		}
+		res.Products = append(res.Products, p)
+	}
+	for _, pl := range c.priceLists {
+		res.PriceLists = append(res.PriceLists, &kbmodel.PriceList{Name: pl.name, Plans: pl.plans})
+	}
+	return res
+}
+
+// POST /1.0/kb/catalog/xml
+func (s *Server) uploadCatalogXML(r *request) {
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		r.error(http.StatusBadRequest, 0, "Invalid body: %s", err)
+		return
+	}
+	c, err := parseCatalog(string(raw))
+	if err != nil {
+		r.error(http.StatusBadRequest, 0, "Invalid catalog: %s", err)
+		return
+	}
+	r.tenant.catalogs = append(r.tenant.catalogs, c)
+	r.created("/1.0/kb/catalog")
+}
+
+// GET /1.0/kb/catalog/xml
+func (s *Server) getCatalogXML(r *request) {
+	c := r.tenant.currentCatalog()
+	if c == nil {
+		r.error(http.StatusNotFound, 0, "No existing catalog for tenant %s", r.tenant.tenant.TenantID)
+		return
+	}
+	r.w.Header().Set("Content-Type", "text/xml")
+	r.w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(r.w, c.raw)
+}
+
+// GET /1.0/kb/catalog
+func (s *Server) getCatalogJSON(r *request) {
+	res := []*kbmodel.Catalog{}
+	for _, c := range r.tenant.catalogs {
+		res = append(res, catalogView(c))
+	}
+	r.json(http.StatusOK, res)
+}
@@ -0,0 +1,97 @@
+package kbtest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// exportTable is a table of the account export.
+type exportTable struct {
+	name    string
+	columns []string
+	rows    [][]interface{}
+}
+
+// write writes the table the way Kill Bill does: a "-- table col1|col2" header line followed
+// by one CSV record per row, values separated by '|' and quoted when they hold '|', quotes or
+// line breaks. Tables without records are skipped.
+func (t *exportTable) write(w io.Writer) {
+	if len(t.rows) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "-- %s %s\n", t.name, strings.Join(t.columns, "|"))
+	cw := csv.NewWriter(w)
+	cw.Comma = '|'
+	for _, row := range t.rows {
+		values := make([]string, len(row))
+		for i, v := range row {
+			values[i] = fmt.Sprint(v)
+		}
+		cw.Write(values)
+	}
+	cw.Flush()
+}
+
+// GET /1.0/kb/export/{accountId}
+func (s *Server) exportDataForAccount(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	accounts := &exportTable{
+		name:    "accounts",
+		columns: []string{"record_id", "id", "external_key", "email", "name", "currency", "billing_cycle_day_local", "time_zone"},
+		rows: [][]interface{}{
+			{1, acc.AccountID, acc.ExternalKey, acc.Email, acc.Name, acc.Currency, acc.BillCycleDayLocal, acc.TimeZone},
+		},
+	}
+	bundles := &exportTable{
+		name:    "bundles",
+		columns: []string{"record_id", "id", "external_key", "account_id"},
+	}
+	for _, b := range r.tenant.bundles {
+		if b.AccountID != nil && *b.AccountID == acc.AccountID {
+			bundles.rows = append(bundles.rows, []interface{}{len(bundles.rows) + 1, b.BundleID, b.ExternalKey, acc.AccountID})
+		}
+	}
+	invoices := &exportTable{
+		name:    "invoices",
+		columns: []string{"record_id", "id", "account_id", "invoice_date", "target_date", "currency", "status"},
+	}
+	items := &exportTable{
+		name:    "invoice_items",
+		columns: []string{"record_id", "id", "type", "invoice_id", "account_id", "bundle_id", "subscription_id", "description", "plan_name", "phase_name", "start_date", "end_date", "amount", "currency"},
+	}
+	for _, inv := range r.tenant.invoices {
+		if inv.AccountID != acc.AccountID {
+			continue
+		}
+		invoices.rows = append(invoices.rows, []interface{}{len(invoices.rows) + 1, inv.InvoiceID, acc.AccountID, inv.InvoiceDate, inv.TargetDate, inv.Currency, inv.Status})
+		for _, item := range inv.Items {
+			var id string
+			if item.InvoiceItemID != nil {
+				id = string(*item.InvoiceItemID)
+			}
+			items.rows = append(items.rows, []interface{}{len(items.rows) + 1, id, item.ItemType, inv.InvoiceID, acc.AccountID, item.BundleID,
+				item.SubscriptionID, item.Description, item.PlanName, item.PhaseName, item.StartDate, item.EndDate, item.Amount, item.Currency})
+		}
+	}
+	payments := &exportTable{
+		name:    "payments",
+		columns: []string{"record_id", "id", "account_id", "payment_method_id", "external_key"},
+	}
+	for _, p := range r.tenant.payments {
+		if p.model.AccountID == acc.AccountID {
+			payments.rows = append(payments.rows, []interface{}{len(payments.rows) + 1, p.model.PaymentID, acc.AccountID, p.model.PaymentMethodID, p.model.PaymentExternalKey})
+		}
+	}
+
+	r.w.Header().Set("Content-Type", "application/octet-stream")
+	r.w.WriteHeader(http.StatusOK)
+	for _, t := range []*exportTable{accounts, bundles, invoices, items, payments} {
+		t.write(r.w)
+	}
+}
@@ -0,0 +1,425 @@
+package kbtest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+func (t *tenantData) invoice(id strfmt.UUID) *kbmodel.Invoice {
+	for _, inv := range t.invoices {
+		if inv.InvoiceID == id {
+			return inv
+		}
+	}
+	return nil
+}
+
+func (t *tenantData) invoiceItem(id strfmt.UUID) (*kbmodel.Invoice, *kbmodel.InvoiceItem) {
+	for _, inv := range t.invoices {
+		for _, item := range inv.Items {
+			if *item.InvoiceItemID == id {
+				return inv, item
+			}
+		}
+	}
+	return nil, nil
+}
+
+// invoiceParam returns the invoice of the given path parameter. If it doesn't exist,
+// a 404 response is written and nil is returned.
+func (r *request) invoiceParam(name string) *kbmodel.Invoice {
+	inv := r.tenant.invoice(r.uuidParam(name))
+	if inv == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeInvoiceNotFound, "No invoice could be found for id %s.", r.param(name))
+	}
+	return inv
+}
+
+func newInvoiceItem(acc *kbmodel.Account, itemType kbmodel.InvoiceItemItemTypeEnum, amount kbcommon.Decimal) *kbmodel.InvoiceItem {
+	id := newID()
+	return &kbmodel.InvoiceItem{
+		InvoiceItemID: &id,
+		AccountID:     &acc.AccountID,
+		ItemType:      itemType,
+		Currency:      kbmodel.InvoiceItemCurrencyEnum(acc.Currency),
+		Amount:        amount.Float64(),
+	}
+}
+
+func subscriptionItem(acc *kbmodel.Account, sub *subscription, p *plan, ph *phase, itemType kbmodel.InvoiceItemItemTypeEnum, amount kbcommon.Decimal, start time.Time) *kbmodel.InvoiceItem {
+	item := newInvoiceItem(acc, itemType, amount)
+	item.BundleID = sub.bundleID
+	item.SubscriptionID = sub.id
+	item.ProductName, item.PrettyProductName = p.product.name, p.product.name
+	item.PlanName, item.PrettyPlanName = p.name, p.name
+	item.PhaseName, item.PrettyPhaseName = ph.name, ph.name
+	item.Description = ph.name
+	item.StartDate = strfmt.Date(start)
+	return item
+}
+
+// billSubscription returns the items to invoice for the subscription up to the target date, in advance,
+// and moves its billedThrough date accordingly.
+func (s *Server) billSubscription(acc *kbmodel.Account, sub *subscription, target time.Time, fixedBilled map[string]bool) []*kbmodel.InvoiceItem {
+	currency := string(acc.Currency)
+	var items []*kbmodel.InvoiceItem
+	next := sub.billedThrough
+	for !sub.billingDone && !next.After(target) {
+		if sub.billingEndDate != nil && !next.Before(*sub.billingEndDate) {
+			sub.billingDone = true
+			break
+		}
+		p := sub.planAt(next)
+		ph, phaseStart, phaseEnd := p.phaseAt(sub.startDate, next)
+		blocked := sub.isBlocked(next, true)
+
+		key := string(sub.id) + "/" + p.name + "/" + ph.name
+		if fixed, ok := ph.fixed[currency]; ok && !fixedBilled[key] && !blocked {
+			items = append(items, subscriptionItem(acc, sub, p, ph, kbmodel.InvoiceItemItemTypeFIXED, fixed, phaseStart))
+		}
+		fixedBilled[key] = true
+
+		// The period ends at the end of the billing period, or before if the phase, plan or billing ends.
+		price, recurring := ph.recurring[currency]
+		periodEnd, hasPeriod := addPeriod(next, ph.billingPeriod)
+		recurring = recurring && hasPeriod
+		var end *time.Time
+		if recurring {
+			end = &periodEnd
+		}
+		for _, boundary := range []*time.Time{phaseEnd, sub.nextPlanChange(next), sub.billingEndDate} {
+			if boundary != nil && boundary.After(next) && (end == nil || boundary.Before(*end)) {
+				end = boundary
+			}
+		}
+		if end == nil {
+			// Nothing left to bill.
+			sub.billingDone = true
+			break
+		}
+
+		if recurring && !blocked {
+			amount := price
+			if end.Before(periodEnd) {
+				ratio := end.Sub(next).Hours() / periodEnd.Sub(next).Hours()
+				amount = kbcommon.NewDecimalFromFloat(price.Float64() * ratio)
+			}
+			amount = amount.Round(kbcommon.CurrencyDigits(currency))
+			item := subscriptionItem(acc, sub, p, ph, kbmodel.InvoiceItemItemTypeRECURRING, amount, next)
+			item.EndDate = strfmt.Date(*end)
+			item.Rate = price.Float64()
+			items = append(items, item)
+		}
+		next = *end
+	}
+	sub.billedThrough = next
+	return items
+}
+
+// invoiceAccount generates the invoice of the account for the target date. If there is
+// nothing to invoice, nil is returned.
+func (s *Server) invoiceAccount(t *tenantData, acc *kbmodel.Account, target time.Time) *kbmodel.Invoice {
+	if acc == nil || t.hasTag(acc.AccountID, autoInvoicingOffTag) {
+		return nil
+	}
+	// fixed prices already billed, by subscription/plan/phase
+	fixedBilled := map[string]bool{}
+	for _, inv := range t.invoices {
+		for _, item := range inv.Items {
+			if item.ItemType == kbmodel.InvoiceItemItemTypeFIXED && inv.Status != kbmodel.InvoiceStatusVOID {
+				fixedBilled[string(item.SubscriptionID)+"/"+item.PlanName+"/"+item.PhaseName] = true
+			}
+		}
+	}
+
+	// As in kill bill, the target date is a day: everything starting on that day is billed.
+	upTo := day(target).AddDate(0, 0, 1).Add(-time.Nanosecond)
+	var items []*kbmodel.InvoiceItem
+	for _, sub := range t.subscriptions {
+		if sub.accountID == acc.AccountID {
+			items = append(items, s.billSubscription(acc, sub, upTo, fixedBilled)...)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	status := kbmodel.InvoiceStatusCOMMITTED
+	if t.hasTag(acc.AccountID, autoInvoicingDraftTag) {
+		status = kbmodel.InvoiceStatusDRAFT
+	}
+	inv := s.addInvoice(t, acc, status, target, items)
+	s.autoPay(t, acc, inv)
+	return inv
+}
+
+func (s *Server) addInvoice(t *tenantData, acc *kbmodel.Account, status kbmodel.InvoiceStatusEnum, target time.Time, items []*kbmodel.InvoiceItem) *kbmodel.Invoice {
+	s.invoiceNumber++
+	inv := &kbmodel.Invoice{
+		InvoiceID:     newID(),
+		InvoiceNumber: strconv.Itoa(s.invoiceNumber),
+		AccountID:     acc.AccountID,
+		Currency:      kbmodel.InvoiceCurrencyEnum(acc.Currency),
+		InvoiceDate:   strfmt.Date(s.now),
+		TargetDate:    strfmt.Date(target),
+		Status:        status,
+	}
+	for _, item := range items {
+		item.InvoiceID = inv.InvoiceID
+	}
+	inv.Items = items
+	t.invoices = append(t.invoices, inv)
+	return inv
+}
+
+func invoiceAmount(inv *kbmodel.Invoice) kbcommon.Decimal {
+	var res kbcommon.Decimal
+	for _, item := range inv.Items {
+		res = res.Add(kbcommon.NewDecimalFromFloat(item.Amount))
+	}
+	return res
+}
+
+// invoicePaid returns the amount paid and refunded for the invoice.
+func (t *tenantData) invoicePaid(inv *kbmodel.Invoice) (paid kbcommon.Decimal, refunded kbcommon.Decimal) {
+	for _, p := range t.payments {
+		if p.invoiceID != inv.InvoiceID {
+			continue
+		}
+		for _, tx := range p.model.Transactions {
+			if tx.Status != kbmodel.PaymentTransactionStatusSUCCESS {
+				continue
+			}
+			amount := kbcommon.NewDecimalFromFloat(tx.Amount)
+			switch tx.TransactionType {
+			case kbmodel.PaymentTransactionTransactionTypePURCHASE, kbmodel.PaymentTransactionTransactionTypeCAPTURE:
+				paid = paid.Add(amount)
+			case kbmodel.PaymentTransactionTransactionTypeREFUND, kbmodel.PaymentTransactionTransactionTypeCHARGEBACK:
+				refunded = refunded.Add(amount)
+			}
+		}
+	}
+	return paid, refunded
+}
+
+// invoiceBalance returns the amount left to pay. DRAFT and VOID invoices have a 0 balance.
+func (s *Server) invoiceBalance(t *tenantData, inv *kbmodel.Invoice) kbcommon.Decimal {
+	if inv.Status != kbmodel.InvoiceStatusCOMMITTED {
+		return kbcommon.Decimal{}
+	}
+	paid, refunded := t.invoicePaid(inv)
+	return invoiceAmount(inv).Sub(paid).Add(refunded)
+}
+
+func (s *Server) invoiceView(r *request, inv *kbmodel.Invoice, withItems bool) *kbmodel.Invoice {
+	res := *inv
+	_, refunded := r.tenant.invoicePaid(inv)
+	res.Amount = invoiceAmount(inv).Float64()
+	res.Balance = s.invoiceBalance(r.tenant, inv).Float64()
+	res.RefundAdj = refunded.Neg().Float64()
+	res.Items = nil
+	if withItems {
+		res.Items = []*kbmodel.InvoiceItem{}
+		for _, item := range inv.Items {
+			c := *item
+			c.AuditLogs = r.auditLogs(*item.InvoiceItemID)
+			res.Items = append(res.Items, &c)
+		}
+	}
+	res.AuditLogs = r.auditLogs(inv.InvoiceID)
+	return &res
+}
+
+// GET /1.0/kb/invoices/{invoiceId}
+func (s *Server) getInvoice(r *request) {
+	if inv := r.invoiceParam("invoiceId"); inv != nil {
+		r.json(http.StatusOK, s.invoiceView(r, inv, true))
+	}
+}
+
+// GET /1.0/kb/invoices/byNumber/{invoiceNumber}
+func (s *Server) getInvoiceByNumber(r *request) {
+	for _, inv := range r.tenant.invoices {
+		if inv.InvoiceNumber == r.param("invoiceNumber") {
+			r.json(http.StatusOK, s.invoiceView(r, inv, true))
+			return
+		}
+	}
+	r.error(http.StatusNotFound, kbcommon.ErrorCodeInvoiceNotFound, "No invoice could be found for number %s.", r.param("invoiceNumber"))
+}
+
+// GET /1.0/kb/invoices/pagination
+func (s *Server) getInvoices(r *request) {
+	from, to := r.page(len(r.tenant.invoices))
+	res := []*kbmodel.Invoice{}
+	for _, inv := range r.tenant.invoices[from:to] {
+		res = append(res, s.invoiceView(r, inv, false))
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/accounts/{accountId}/invoices
+func (s *Server) getInvoicesForAccount(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	startDate, ok := r.queryTime("startDate", time.Time{})
+	if !ok {
+		return
+	}
+	endDate, ok := r.queryTime("endDate", time.Time{})
+	if !ok {
+		return
+	}
+	res := []*kbmodel.Invoice{}
+	for _, inv := range r.tenant.invoices {
+		target := time.Time(inv.TargetDate)
+		if inv.AccountID != acc.AccountID ||
+			(r.query("startDate") != "" && target.Before(day(startDate))) ||
+			(r.query("endDate") != "" && target.After(day(endDate))) ||
+			(inv.Status == kbmodel.InvoiceStatusVOID && !r.queryBool("includeVoidedInvoices", false)) ||
+			(r.queryBool("unpaidInvoicesOnly", false) && s.invoiceBalance(r.tenant, inv).Sign() <= 0) {
+			continue
+		}
+		res = append(res, s.invoiceView(r, inv, r.queryBool("includeInvoiceComponents", false)))
+	}
+	r.json(http.StatusOK, res)
+}
+
+// POST /1.0/kb/invoices?accountId=&targetDate=
+func (s *Server) createFutureInvoice(r *request) {
+	acc := r.tenant.account(strfmt.UUID(r.query("accountId")))
+	if acc == nil {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeInvoiceAccountIDInvalid, "No account could be retrieved for id %s", r.query("accountId"))
+		return
+	}
+	target, ok := r.queryTime("targetDate", s.now)
+	if !ok {
+		return
+	}
+	inv := s.invoiceAccount(r.tenant, acc, s.atDate(target))
+	if inv == nil {
+		r.error(http.StatusNotFound, 0, "No invoice to generate for account %s and date %s", acc.AccountID, strfmt.Date(target))
+		return
+	}
+	r.created("/1.0/kb/invoices/" + string(inv.InvoiceID))
+}
+
+// POST /1.0/kb/invoices/charges/{accountId}
+func (s *Server) createExternalCharges(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	var body []*kbmodel.InvoiceItem
+	if !r.decode(&body) {
+		return
+	}
+	status := kbmodel.InvoiceStatusDRAFT
+	if r.queryBool("autoCommit", false) {
+		status = kbmodel.InvoiceStatusCOMMITTED
+	}
+
+	var items []*kbmodel.InvoiceItem
+	var existing []*kbmodel.Invoice
+	for _, charge := range body {
+		if charge == nil {
+			continue
+		}
+		if charge.Currency != "" && string(charge.Currency) != string(acc.Currency) {
+			r.error(http.StatusBadRequest, 0, "Currency %s doesn't match account currency %s", charge.Currency, acc.Currency)
+			return
+		}
+		item := newInvoiceItem(acc, kbmodel.InvoiceItemItemTypeEXTERNALCHARGE, kbcommon.NewDecimalFromFloat(charge.Amount))
+		item.Description = charge.Description
+		item.ItemDetails = charge.ItemDetails
+		item.BundleID = charge.BundleID
+		item.SubscriptionID = charge.SubscriptionID
+		item.PlanName, item.PhaseName, item.ProductName = charge.PlanName, charge.PhaseName, charge.ProductName
+		item.Quantity = charge.Quantity
+		item.Rate = charge.Rate
+		item.StartDate, item.EndDate = charge.StartDate, charge.EndDate
+		if time.Time(item.StartDate).IsZero() {
+			item.StartDate = strfmt.Date(s.now)
+		}
+		if charge.InvoiceID == "" {
+			items = append(items, item)
+			continue
+		}
+		inv := r.tenant.invoice(charge.InvoiceID)
+		if inv == nil || inv.AccountID != acc.AccountID {
+			r.error(http.StatusNotFound, kbcommon.ErrorCodeInvoiceNotFound, "No invoice could be found for id %s.", charge.InvoiceID)
+			return
+		}
+		if inv.Status != kbmodel.InvoiceStatusDRAFT {
+			r.error(http.StatusBadRequest, kbcommon.ErrorCodeInvoiceInvalidTransition, "Invoice %s is not in DRAFT status", inv.InvoiceID)
+			return
+		}
+		item.InvoiceID = inv.InvoiceID
+		inv.Items = append(inv.Items, item)
+		existing = append(existing, inv)
+	}
+
+	var res []*kbmodel.InvoiceItem
+	if len(items) > 0 {
+		inv := s.addInvoice(r.tenant, acc, kbmodel.InvoiceStatusDRAFT, s.now, items)
+		existing = append(existing, inv)
+	}
+	for _, inv := range existing {
+		if status == kbmodel.InvoiceStatusCOMMITTED && inv.Status == kbmodel.InvoiceStatusDRAFT {
+			inv.Status = status
+			s.autoPay(r.tenant, acc, inv)
+		}
+	}
+	for _, inv := range r.tenant.invoices {
+		for _, item := range inv.Items {
+			if item.ItemType == kbmodel.InvoiceItemItemTypeEXTERNALCHARGE {
+				for _, c := range existing {
+					if c == inv {
+						res = append(res, item)
+						break
+					}
+				}
+			}
+		}
+	}
+	r.json(http.StatusCreated, res)
+}
+
+// PUT /1.0/kb/invoices/{invoiceId}/voidInvoice
+func (s *Server) voidInvoice(r *request) {
+	inv := r.invoiceParam("invoiceId")
+	if inv == nil {
+		return
+	}
+	if paid, refunded := r.tenant.invoicePaid(inv); paid.Sub(refunded).Sign() > 0 {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeInvoiceInvalidTransition, "Cannot void invoice %s: it has payments", inv.InvoiceID)
+		return
+	}
+	inv.Status = kbmodel.InvoiceStatusVOID
+	s.audit(r, inv.InvoiceID, "INVOICE", "UPDATE")
+	r.noContent()
+}
+
+// PUT /1.0/kb/invoices/{invoiceId}/commitInvoice
+func (s *Server) commitInvoice(r *request) {
+	inv := r.invoiceParam("invoiceId")
+	if inv == nil {
+		return
+	}
+	if inv.Status != kbmodel.InvoiceStatusDRAFT {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeInvoiceInvalidTransition, "Invoice %s is not in DRAFT status", inv.InvoiceID)
+		return
+	}
+	inv.Status = kbmodel.InvoiceStatusCOMMITTED
+	s.audit(r, inv.InvoiceID, "INVOICE", "UPDATE")
+	s.autoPay(r.tenant, r.tenant.account(inv.AccountID), inv)
+	r.noContent()
+}
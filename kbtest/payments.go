@@ -0,0 +1,352 @@
+package kbtest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// externalPaymentPlugin is the plugin of the payment method used for external payments.
+const externalPaymentPlugin = "__EXTERNAL_PAYMENT__"
+
+// payment is a payment, and the invoice it pays if any.
+type payment struct {
+	model     *kbmodel.Payment
+	invoiceID strfmt.UUID
+}
+
+func (t *tenantData) payment(id strfmt.UUID) *payment {
+	for _, p := range t.payments {
+		if p.model.PaymentID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// paymentParam returns the payment of the given path parameter. If it doesn't exist,
+// a 404 response is written and nil is returned.
+func (r *request) paymentParam(name string) *payment {
+	p := r.tenant.payment(r.uuidParam(name))
+	if p == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodePaymentNoSuchPayment, "Payment %s does not exist", r.param(name))
+	}
+	return p
+}
+
+// addTransaction adds a successful transaction to the payment, and updates its amounts.
+func (s *Server) addTransaction(p *payment, typ kbmodel.PaymentTransactionTransactionTypeEnum, amount kbcommon.Decimal, externalKey string) *kbmodel.PaymentTransaction {
+	id := newID()
+	if externalKey == "" {
+		externalKey = string(id)
+	}
+	tx := &kbmodel.PaymentTransaction{
+		TransactionID:          id,
+		TransactionExternalKey: externalKey,
+		PaymentID:              p.model.PaymentID,
+		PaymentExternalKey:     p.model.PaymentExternalKey,
+		TransactionType:        typ,
+		Amount:                 amount.Float64(),
+		Currency:               kbmodel.PaymentTransactionCurrencyEnum(p.model.Currency),
+		ProcessedAmount:        amount.Float64(),
+		ProcessedCurrency:      kbmodel.PaymentTransactionProcessedCurrencyEnum(p.model.Currency),
+		EffectiveDate:          strfmt.DateTime(s.now),
+		Status:                 kbmodel.PaymentTransactionStatusSUCCESS,
+	}
+	p.model.Transactions = append(p.model.Transactions, tx)
+
+	add := func(v float64) float64 {
+		return kbcommon.NewDecimalFromFloat(v).Add(amount).Float64()
+	}
+	switch typ {
+	case kbmodel.PaymentTransactionTransactionTypeAUTHORIZE:
+		p.model.AuthAmount = add(p.model.AuthAmount)
+	case kbmodel.PaymentTransactionTransactionTypeCAPTURE:
+		p.model.CapturedAmount = add(p.model.CapturedAmount)
+	case kbmodel.PaymentTransactionTransactionTypePURCHASE:
+		p.model.PurchasedAmount = add(p.model.PurchasedAmount)
+	case kbmodel.PaymentTransactionTransactionTypeCREDIT:
+		p.model.CreditedAmount = add(p.model.CreditedAmount)
+	case kbmodel.PaymentTransactionTransactionTypeREFUND:
+		p.model.RefundedAmount = add(p.model.RefundedAmount)
+	}
+	return tx
+}
+
+// newPayment creates a payment with a single successful transaction.
+func (s *Server) newPayment(t *tenantData, acc *kbmodel.Account, paymentMethodID strfmt.UUID, invoiceID strfmt.UUID,
+	typ kbmodel.PaymentTransactionTransactionTypeEnum, amount kbcommon.Decimal, externalKey string) *payment {
+
+	s.paymentNumber++
+	id := newID()
+	if externalKey == "" {
+		externalKey = string(id)
+	}
+	p := &payment{
+		model: &kbmodel.Payment{
+			PaymentID:          id,
+			PaymentNumber:      strconv.Itoa(s.paymentNumber),
+			PaymentExternalKey: externalKey,
+			AccountID:          acc.AccountID,
+			PaymentMethodID:    paymentMethodID,
+			Currency:           kbmodel.PaymentCurrencyEnum(acc.Currency),
+		},
+		invoiceID: invoiceID,
+	}
+	s.addTransaction(p, typ, amount, "")
+	t.payments = append(t.payments, p)
+	return p
+}
+
+// autoPay pays the balance of a committed invoice with the default payment method of the account,
+// unless the account is tagged AUTO_PAY_OFF or MANUAL_PAY.
+func (s *Server) autoPay(t *tenantData, acc *kbmodel.Account, inv *kbmodel.Invoice) {
+	if acc == nil || inv == nil || inv.Status != kbmodel.InvoiceStatusCOMMITTED || acc.PaymentMethodID == "" ||
+		t.hasTag(acc.AccountID, autoPayOffTag) || t.hasTag(acc.AccountID, manualPayTag) {
+		return
+	}
+	balance := s.invoiceBalance(t, inv)
+	if balance.Sign() <= 0 {
+		return
+	}
+	s.newPayment(t, acc, acc.PaymentMethodID, inv.InvoiceID, kbmodel.PaymentTransactionTransactionTypePURCHASE, balance, "")
+}
+
+func (s *Server) paymentView(r *request, p *payment) *kbmodel.Payment {
+	res := *p.model
+	res.Transactions = []*kbmodel.PaymentTransaction{}
+	for _, tx := range p.model.Transactions {
+		c := *tx
+		c.AuditLogs = r.auditLogs(tx.TransactionID)
+		res.Transactions = append(res.Transactions, &c)
+	}
+	res.AuditLogs = r.auditLogs(p.model.PaymentID)
+	return &res
+}
+
+func (s *Server) invoicePaymentView(r *request, p *payment) *kbmodel.InvoicePayment {
+	v := s.paymentView(r, p)
+	return &kbmodel.InvoicePayment{
+		AccountID:          v.AccountID,
+		AuditLogs:          v.AuditLogs,
+		AuthAmount:         v.AuthAmount,
+		CapturedAmount:     v.CapturedAmount,
+		CreditedAmount:     v.CreditedAmount,
+		Currency:           kbmodel.InvoicePaymentCurrencyEnum(v.Currency),
+		PaymentExternalKey: v.PaymentExternalKey,
+		PaymentID:          v.PaymentID,
+		PaymentMethodID:    v.PaymentMethodID,
+		PaymentNumber:      v.PaymentNumber,
+		PurchasedAmount:    v.PurchasedAmount,
+		RefundedAmount:     v.RefundedAmount,
+		TargetInvoiceID:    p.invoiceID,
+		Transactions:       v.Transactions,
+	}
+}
+
+// POST /1.0/kb/accounts/{accountId}/payments
+func (s *Server) processPayment(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	var body kbmodel.PaymentTransaction
+	if !r.decode(&body) {
+		return
+	}
+	switch body.TransactionType {
+	case kbmodel.PaymentTransactionTransactionTypeAUTHORIZE, kbmodel.PaymentTransactionTransactionTypePURCHASE, kbmodel.PaymentTransactionTransactionTypeCREDIT:
+	default:
+		r.error(http.StatusBadRequest, 0, "TransactionType needs to be one of AUTHORIZE, PURCHASE or CREDIT")
+		return
+	}
+	pmID := strfmt.UUID(r.query("paymentMethodId"))
+	if pmID == "" {
+		pmID = acc.PaymentMethodID
+	}
+	if pmID == "" {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodePaymentNoPaymentMethods, "No payment method have been set for account %s", acc.AccountID)
+		return
+	}
+	if pm := r.tenant.paymentMethod(pmID); pm == nil || pm.AccountID != acc.AccountID {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodePaymentNoSuchPaymentMethod, "Payment method %s does not exist", pmID)
+		return
+	}
+	p := s.newPayment(r.tenant, acc, pmID, "", body.TransactionType, kbcommon.NewDecimalFromFloat(body.Amount), body.PaymentExternalKey)
+	s.audit(r, p.model.PaymentID, "PAYMENT", "INSERT")
+	r.created("/1.0/kb/payments/" + string(p.model.PaymentID))
+}
+
+// GET /1.0/kb/accounts/{accountId}/payments
+func (s *Server) getPaymentsForAccount(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	res := []*kbmodel.Payment{}
+	for _, p := range r.tenant.payments {
+		if p.model.AccountID == acc.AccountID {
+			res = append(res, s.paymentView(r, p))
+		}
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/accounts/{accountId}/invoicePayments
+func (s *Server) getInvoicePaymentsForAccount(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	res := []*kbmodel.InvoicePayment{}
+	for _, p := range r.tenant.payments {
+		if p.model.AccountID == acc.AccountID && p.invoiceID != "" {
+			res = append(res, s.invoicePaymentView(r, p))
+		}
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/payments/{paymentId}
+func (s *Server) getPayment(r *request) {
+	if p := r.paymentParam("paymentId"); p != nil {
+		r.json(http.StatusOK, s.paymentView(r, p))
+	}
+}
+
+// GET /1.0/kb/payments/pagination
+func (s *Server) getPayments(r *request) {
+	from, to := r.page(len(r.tenant.payments))
+	res := []*kbmodel.Payment{}
+	for _, p := range r.tenant.payments[from:to] {
+		res = append(res, s.paymentView(r, p))
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/payments/search/{searchKey}
+func (s *Server) searchPayments(r *request) {
+	key := strings.ToLower(r.param("searchKey"))
+	var found []*payment
+	for _, p := range r.tenant.payments {
+		for _, v := range []string{string(p.model.PaymentID), string(p.model.AccountID), p.model.PaymentExternalKey} {
+			if v != "" && strings.Contains(strings.ToLower(v), key) {
+				found = append(found, p)
+				break
+			}
+		}
+	}
+	from, to := r.page(len(found))
+	res := []*kbmodel.Payment{}
+	for _, p := range found[from:to] {
+		res = append(res, s.paymentView(r, p))
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/invoices/{invoiceId}/payments
+func (s *Server) getPaymentsForInvoice(r *request) {
+	inv := r.invoiceParam("invoiceId")
+	if inv == nil {
+		return
+	}
+	res := []*kbmodel.InvoicePayment{}
+	for _, p := range r.tenant.payments {
+		if p.invoiceID == inv.InvoiceID {
+			res = append(res, s.invoicePaymentView(r, p))
+		}
+	}
+	r.json(http.StatusOK, res)
+}
+
+// externalPaymentMethod returns the external payment method of the account, creating it if needed.
+func (s *Server) externalPaymentMethod(t *tenantData, acc *kbmodel.Account) *kbmodel.PaymentMethod {
+	for _, pm := range t.paymentMethods {
+		if pm.AccountID == acc.AccountID && pm.PluginName == externalPaymentPlugin {
+			return pm
+		}
+	}
+	id := newID()
+	pm := &kbmodel.PaymentMethod{
+		PaymentMethodID: id,
+		ExternalKey:     string(id),
+		AccountID:       acc.AccountID,
+		PluginName:      externalPaymentPlugin,
+	}
+	t.paymentMethods = append(t.paymentMethods, pm)
+	return pm
+}
+
+// POST /1.0/kb/invoices/{invoiceId}/payments
+func (s *Server) createInstantPayment(r *request) {
+	inv := r.invoiceParam("invoiceId")
+	if inv == nil {
+		return
+	}
+	var body kbmodel.InvoicePayment
+	if !r.decode(&body) {
+		return
+	}
+	acc := r.tenant.account(inv.AccountID)
+	balance := s.invoiceBalance(r.tenant, inv)
+	if balance.Sign() <= 0 {
+		r.noContent()
+		return
+	}
+	amount := balance
+	if body.PurchasedAmount > 0 {
+		amount = kbcommon.NewDecimalFromFloat(body.PurchasedAmount)
+	}
+
+	pmID := body.PaymentMethodID
+	if r.queryBool("externalPayment", false) {
+		pmID = s.externalPaymentMethod(r.tenant, acc).PaymentMethodID
+	} else if pmID == "" {
+		pmID = acc.PaymentMethodID
+	}
+	if pmID == "" {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodePaymentNoPaymentMethods, "No payment method have been set for account %s", acc.AccountID)
+		return
+	}
+	p := s.newPayment(r.tenant, acc, pmID, inv.InvoiceID, kbmodel.PaymentTransactionTransactionTypePURCHASE, amount, body.PaymentExternalKey)
+	s.audit(r, p.model.PaymentID, "PAYMENT", "INSERT")
+	r.w.Header().Set("Location", r.location("/1.0/kb/invoicePayments/"+string(p.model.PaymentID)))
+	r.json(http.StatusCreated, s.invoicePaymentView(r, p))
+}
+
+// GET /1.0/kb/invoicePayments/{paymentId}
+func (s *Server) getInvoicePayment(r *request) {
+	if p := r.paymentParam("paymentId"); p != nil {
+		r.json(http.StatusOK, s.invoicePaymentView(r, p))
+	}
+}
+
+// POST /1.0/kb/payments/{paymentId}/refunds
+func (s *Server) refundPayment(r *request) {
+	p := r.paymentParam("paymentId")
+	if p == nil {
+		return
+	}
+	var body kbmodel.PaymentTransaction
+	if !r.decode(&body) {
+		return
+	}
+	paid := kbcommon.NewDecimalFromFloat(p.model.PurchasedAmount).Add(kbcommon.NewDecimalFromFloat(p.model.CapturedAmount))
+	refundable := paid.Sub(kbcommon.NewDecimalFromFloat(p.model.RefundedAmount))
+	amount := refundable
+	if body.Amount > 0 {
+		amount = kbcommon.NewDecimalFromFloat(body.Amount)
+	}
+	if amount.Cmp(refundable) > 0 {
+		r.error(http.StatusBadRequest, 0, "Refund amount %s exceeds the refundable amount %s", amount, refundable)
+		return
+	}
+	tx := s.addTransaction(p, kbmodel.PaymentTransactionTransactionTypeREFUND, amount, body.TransactionExternalKey)
+	s.audit(r, tx.TransactionID, "TRANSACTION", "INSERT")
+	r.created("/1.0/kb/payments/" + string(p.model.PaymentID))
+}
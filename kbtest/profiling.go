@@ -0,0 +1,32 @@
+package kbtest
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// profilingData returns the profiling data of a request asking for the given features, for ex.
+// "JAXRS,DAO". The fake doesn't measure anything: the call tree is the same for every route, with
+// fixed durations.
+func profilingData(rt *route, method, info string) string {
+	features := map[string]bool{}
+	for _, f := range strings.Split(info, ",") {
+		features[strings.TrimSpace(f)] = true
+	}
+	call := func(kind, name string, usec int64, calls ...*kbcommon.ProfilingCall) []*kbcommon.ProfilingCall {
+		if !features[kind] {
+			return calls
+		}
+		return []*kbcommon.ProfilingCall{{Name: kind + ":" + name, DurationUsec: usec, Calls: calls}}
+	}
+	pattern := "/" + strings.Join(rt.segments, "/")
+	calls := call(kbcommon.ProfilingJAXRS, method+" "+pattern, 3000,
+		call(kbcommon.ProfilingAPI, "kbtest", 2000,
+			append(call(kbcommon.ProfilingDAO, "kbtest: read", 500),
+				call(kbcommon.ProfilingPlugin, "kbtest", 1000,
+					call(kbcommon.ProfilingDAO, "kbtest: plugin", 200)...)...)...)...)
+	data, _ := json.Marshal(map[string]interface{}{"rawData": calls})
+	return string(data)
+}
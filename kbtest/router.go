@@ -0,0 +1,257 @@
+package kbtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// route is a REST endpoint. Path segments in braces ({accountId}) match any value.
+type route struct {
+	method   string
+	segments []string
+	// auth is true if basic auth is required.
+	auth bool
+	// tenant is true if the tenant api key and secret are required.
+	tenant  bool
+	handler func(r *request)
+}
+
+func (s *Server) handle(method, pattern string, tenant bool, handler func(r *request)) *route {
+	rt := &route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		auth:     true,
+		tenant:   tenant,
+		handler:  handler,
+	}
+	s.routes = append(s.routes, rt)
+	return rt
+}
+
+// match returns the first route matching the method and path, along with the path parameters.
+func (s *Server) match(method, path string) (*route, map[string]string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, rt := range s.routes {
+		if rt.method != method || len(rt.segments) != len(segments) {
+			continue
+		}
+		params := map[string]string{}
+		matched := true
+		for i, seg := range rt.segments {
+			if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+				params[seg[1:len(seg)-1]] = segments[i]
+			} else if seg != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return rt, params
+		}
+	}
+	return nil, nil
+}
+
+// request is the context of the request being served.
+type request struct {
+	*http.Request
+	w      http.ResponseWriter
+	params map[string]string
+	// tenant is nil for cross tenant operations
+	tenant *tenantData
+}
+
+// param returns the given path parameter.
+func (r *request) param(name string) string {
+	return r.params[name]
+}
+
+// uuidParam returns the given path parameter as a uuid.
+func (r *request) uuidParam(name string) strfmt.UUID {
+	return strfmt.UUID(r.params[name])
+}
+
+func (r *request) query(name string) string {
+	return r.URL.Query().Get(name)
+}
+
+// queryList returns all the values of the given query parameter. Comma separated values are split.
+func (r *request) queryList(name string) []string {
+	var res []string
+	for _, v := range r.URL.Query()[name] {
+		for _, item := range strings.Split(v, ",") {
+			if item != "" {
+				res = append(res, item)
+			}
+		}
+	}
+	return res
+}
+
+func (r *request) queryBool(name string, def bool) bool {
+	b, err := strconv.ParseBool(r.query(name))
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+func (r *request) queryInt(name string, def int) int {
+	i, err := strconv.Atoi(r.query(name))
+	if err != nil {
+		return def
+	}
+	return i
+}
+
+// queryTime parses the given date or date-time query parameter. If the parameter is missing, def is returned.
+// On error, a 400 response is written and ok is false.
+func (r *request) queryTime(name string, def time.Time) (t time.Time, ok bool) {
+	v := r.query(name)
+	if v == "" {
+		return def, true
+	}
+	t, err := parseTime(v)
+	if err != nil {
+		r.error(http.StatusBadRequest, 0, "Invalid %s %q", name, v)
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// audit returns the audit level requested (NONE, MINIMAL or FULL).
+func (r *request) audit() string {
+	if a := strings.ToUpper(r.query("audit")); a != "" {
+		return a
+	}
+	return "NONE"
+}
+
+// decode decodes the json body into v. On error, a 400 response is written and false is returned.
+func (r *request) decode(v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		r.error(http.StatusBadRequest, 0, "Invalid body: %s", err)
+		return false
+	}
+	return true
+}
+
+// location returns the absolute url of the given path.
+func (r *request) location(path string) string {
+	return "http://" + r.Host + path
+}
+
+func (r *request) json(code int, v interface{}) {
+	r.w.Header().Set("Content-Type", "application/json")
+	r.w.WriteHeader(code)
+	_ = json.NewEncoder(r.w).Encode(v)
+}
+
+// created writes a 201 response pointing to the given path.
+func (r *request) created(path string) {
+	r.w.Header().Set("Location", r.location(path))
+	r.w.WriteHeader(http.StatusCreated)
+}
+
+func (r *request) noContent() {
+	r.w.WriteHeader(http.StatusNoContent)
+}
+
+// page writes the pagination headers for a list of total records and returns
+// the bounds of the requested page.
+func (r *request) page(total int) (from, to int) {
+	offset := r.queryInt("offset", 0)
+	limit := r.queryInt("limit", 100)
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+	to = offset + limit
+	if limit < 0 || to > total {
+		to = total
+	}
+
+	h := r.w.Header()
+	h.Set(kbcommon.PaginationCurrentOffsetHeader, strconv.Itoa(offset))
+	h.Set(kbcommon.PaginationTotalNbRecordsHeader, strconv.Itoa(total))
+	h.Set(kbcommon.PaginationMaxNbRecordsHeader, strconv.Itoa(total))
+	if to < total {
+		h.Set(kbcommon.PaginationNextOffsetHeader, strconv.Itoa(to))
+		q := r.URL.Query()
+		q.Set("offset", strconv.Itoa(to))
+		q.Set("limit", strconv.Itoa(limit))
+		h.Set(kbcommon.PaginationNextPageURIHeader, r.URL.Path+"?"+q.Encode())
+	}
+	return offset, to
+}
+
+// errorBody is the json representation of kill bill errors.
+type errorBody struct {
+	ClassName  string        `json:"className,omitempty"`
+	Code       int           `json:"code,omitempty"`
+	Message    string        `json:"message,omitempty"`
+	StackTrace []interface{} `json:"stackTrace"`
+}
+
+// error writes a kill bill error.
+func (r *request) error(httpCode int, code kbcommon.ErrorCode, format string, args ...interface{}) {
+	r.json(httpCode, &errorBody{
+		ClassName:  exceptionClass(code),
+		Code:       int(code),
+		Message:    fmt.Sprintf(format, args...),
+		StackTrace: []interface{}{},
+	})
+}
+
+// exceptionClass returns the java exception kill bill would throw for the given code.
+func exceptionClass(code kbcommon.ErrorCode) string {
+	switch {
+	case code >= 1000 && code < 2000:
+		return "org.killbill.billing.entitlement.api.EntitlementApiException"
+	case code >= 2000 && code < 3000:
+		return "org.killbill.billing.catalog.api.CatalogApiException"
+	case code >= 3000 && code < 3900:
+		return "org.killbill.billing.account.api.AccountApiException"
+	case code >= 3900 && code < 4000:
+		return "org.killbill.billing.util.api.TagApiException"
+	case code >= 4000 && code < 5000:
+		return "org.killbill.billing.invoice.api.InvoiceApiException"
+	case code >= 7000 && code < 8000:
+		return "org.killbill.billing.payment.api.PaymentApiException"
+	case code >= 10000 && code < 11000:
+		return "org.killbill.billing.tenant.api.TenantApiException"
+	}
+	return "java.lang.IllegalArgumentException"
+}
+
+// parseTime parses a date (2006-01-02) or a date-time.
+func parseTime(v string) (time.Time, error) {
+	if t, err := time.Parse(strfmt.RFC3339FullDate, v); err == nil {
+		return t, nil
+	}
+	dt, err := strfmt.ParseDateTime(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Time(dt).UTC(), nil
+}
+
+// day returns the beginning of the day of t.
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func newID() strfmt.UUID {
+	return strfmt.UUID(uuid.New().String())
+}
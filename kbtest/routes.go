@@ -0,0 +1,101 @@
+package kbtest
+
+import "net/http"
+
+// registerRoutes registers the operations supported by the fake server.
+// Routes are matched in order, so literal routes need to be registered before parameterized ones.
+func (s *Server) registerRoutes() {
+	const tenant, global = true, false
+
+	s.handle(http.MethodGet, "/1.0/healthcheck", global, s.healthcheck).auth = false
+	s.handle(http.MethodDelete, "/1.0/kb/admin/cache/tenants", tenant, s.invalidatesCacheByTenant)
+	s.handle(http.MethodPut, "/1.0/kb/admin/healthcheck", global, s.putInRotation)
+	s.handle(http.MethodDelete, "/1.0/kb/admin/healthcheck", global, s.putOutOfRotation)
+	s.handle(http.MethodGet, "/1.0/kb/nodesInfo", global, s.getNodesInfo)
+	s.handle(http.MethodGet, "/1.0/kb/admin/queues", tenant, s.getQueueEntries)
+	s.handle(http.MethodGet, "/1.0/kb/test/clock", global, s.getClock)
+	s.handle(http.MethodPost, "/1.0/kb/test/clock", global, s.setClockHandler)
+
+	s.handle(http.MethodGet, "/1.0/kb/security/subject", global, s.getCurrentUserSubject)
+
+	// Tenants
+	s.handle(http.MethodPost, "/1.0/kb/tenants", global, s.createTenant)
+	s.handle(http.MethodGet, "/1.0/kb/tenants", global, s.getTenantByAPIKey)
+	s.handle(http.MethodGet, "/1.0/kb/tenants/{tenantId}", global, s.getTenant)
+
+	// Catalog
+	s.handle(http.MethodPost, "/1.0/kb/catalog/xml", tenant, s.uploadCatalogXML)
+	s.handle(http.MethodGet, "/1.0/kb/catalog/xml", tenant, s.getCatalogXML)
+	s.handle(http.MethodGet, "/1.0/kb/catalog", tenant, s.getCatalogJSON)
+
+	// Accounts
+	s.handle(http.MethodPost, "/1.0/kb/accounts", tenant, s.createAccount)
+	s.handle(http.MethodGet, "/1.0/kb/accounts", tenant, s.getAccountByKey)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/pagination", tenant, s.getAccounts)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/search/{searchKey}", tenant, s.searchAccounts)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/{accountId}", tenant, s.getAccount)
+	s.handle(http.MethodPut, "/1.0/kb/accounts/{accountId}", tenant, s.updateAccount)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/{accountId}/bundles", tenant, s.getAccountBundles)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/{accountId}/invoices", tenant, s.getInvoicesForAccount)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/{accountId}/invoicePayments", tenant, s.getInvoicePaymentsForAccount)
+	s.handle(http.MethodPost, "/1.0/kb/accounts/{accountId}/payments", tenant, s.processPayment)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/{accountId}/payments", tenant, s.getPaymentsForAccount)
+
+	// Payment methods
+	s.handle(http.MethodPost, "/1.0/kb/accounts/{accountId}/paymentMethods", tenant, s.createPaymentMethod)
+	s.handle(http.MethodGet, "/1.0/kb/accounts/{accountId}/paymentMethods", tenant, s.getPaymentMethodsForAccount)
+	s.handle(http.MethodPut, "/1.0/kb/accounts/{accountId}/paymentMethods/{paymentMethodId}/setDefault", tenant, s.setDefaultPaymentMethod)
+	s.handle(http.MethodGet, "/1.0/kb/paymentMethods/{paymentMethodId}", tenant, s.getPaymentMethod)
+	s.handle(http.MethodDelete, "/1.0/kb/paymentMethods/{paymentMethodId}", tenant, s.deletePaymentMethod)
+
+	// Subscriptions and bundles
+	s.handle(http.MethodPost, "/1.0/kb/subscriptions", tenant, s.createSubscription)
+	s.handle(http.MethodGet, "/1.0/kb/subscriptions", tenant, s.getSubscriptionByKey)
+	s.handle(http.MethodPost, "/1.0/kb/subscriptions/createSubscriptionWithAddOns", tenant, s.createSubscriptionWithAddOns)
+	s.handle(http.MethodPost, "/1.0/kb/subscriptions/createSubscriptionsWithAddOns", tenant, s.createSubscriptionsWithAddOns)
+	s.handle(http.MethodGet, "/1.0/kb/subscriptions/{subscriptionId}", tenant, s.getSubscription)
+	s.handle(http.MethodPut, "/1.0/kb/subscriptions/{subscriptionId}", tenant, s.changeSubscriptionPlan)
+	s.handle(http.MethodDelete, "/1.0/kb/subscriptions/{subscriptionId}", tenant, s.cancelSubscriptionPlan)
+	s.handle(http.MethodPut, "/1.0/kb/subscriptions/{subscriptionId}/uncancel", tenant, s.uncancelSubscriptionPlan)
+	s.handle(http.MethodPut, "/1.0/kb/subscriptions/{subscriptionId}/bcd", tenant, s.updateSubscriptionBCD)
+	s.handle(http.MethodPost, "/1.0/kb/subscriptions/{subscriptionId}/block", tenant, s.addSubscriptionBlockingState)
+	s.handle(http.MethodGet, "/1.0/kb/bundles", tenant, s.getBundleByKey)
+	s.handle(http.MethodGet, "/1.0/kb/bundles/pagination", tenant, s.getBundles)
+	s.handle(http.MethodGet, "/1.0/kb/bundles/{bundleId}", tenant, s.getBundle)
+
+	// Invoices
+	s.handle(http.MethodPost, "/1.0/kb/invoices", tenant, s.createFutureInvoice)
+	s.handle(http.MethodGet, "/1.0/kb/invoices/pagination", tenant, s.getInvoices)
+	s.handle(http.MethodGet, "/1.0/kb/invoices/byNumber/{invoiceNumber}", tenant, s.getInvoiceByNumber)
+	s.handle(http.MethodPost, "/1.0/kb/invoices/charges/{accountId}", tenant, s.createExternalCharges)
+	s.handle(http.MethodGet, "/1.0/kb/invoices/{invoiceId}", tenant, s.getInvoice)
+	s.handle(http.MethodPut, "/1.0/kb/invoices/{invoiceId}/voidInvoice", tenant, s.voidInvoice)
+	s.handle(http.MethodPut, "/1.0/kb/invoices/{invoiceId}/commitInvoice", tenant, s.commitInvoice)
+	s.handle(http.MethodGet, "/1.0/kb/invoices/{invoiceId}/payments", tenant, s.getPaymentsForInvoice)
+	s.handle(http.MethodPost, "/1.0/kb/invoices/{invoiceId}/payments", tenant, s.createInstantPayment)
+
+	// Payments
+	s.handle(http.MethodGet, "/1.0/kb/payments/pagination", tenant, s.getPayments)
+	s.handle(http.MethodGet, "/1.0/kb/payments/search/{searchKey}", tenant, s.searchPayments)
+	s.handle(http.MethodGet, "/1.0/kb/payments/{paymentId}", tenant, s.getPayment)
+	s.handle(http.MethodPost, "/1.0/kb/payments/{paymentId}/refunds", tenant, s.refundPayment)
+	s.handle(http.MethodGet, "/1.0/kb/invoicePayments/{paymentId}", tenant, s.getInvoicePayment)
+
+	// Export
+	s.handle(http.MethodGet, "/1.0/kb/export/{accountId}", tenant, s.exportDataForAccount)
+
+	// Tags and custom fields
+	s.handle(http.MethodGet, "/1.0/kb/tagDefinitions", tenant, s.getTagDefinitions)
+	s.handle(http.MethodPost, "/1.0/kb/tagDefinitions", tenant, s.createTagDefinition)
+	s.handle(http.MethodGet, "/1.0/kb/tagDefinitions/{tagDefinitionId}", tenant, s.getTagDefinition)
+	s.handle(http.MethodDelete, "/1.0/kb/tagDefinitions/{tagDefinitionId}", tenant, s.deleteTagDefinition)
+	s.handle(http.MethodGet, "/1.0/kb/tags/pagination", tenant, s.getTags)
+	s.handle(http.MethodGet, "/1.0/kb/customFields/pagination", tenant, s.getCustomFields)
+	s.handle(http.MethodGet, "/1.0/kb/{objects}/{objectId}/tags", tenant, s.getObjectTags)
+	s.handle(http.MethodPost, "/1.0/kb/{objects}/{objectId}/tags", tenant, s.createObjectTags)
+	s.handle(http.MethodDelete, "/1.0/kb/{objects}/{objectId}/tags", tenant, s.deleteObjectTags)
+	s.handle(http.MethodGet, "/1.0/kb/{objects}/{objectId}/customFields", tenant, s.getObjectCustomFields)
+	s.handle(http.MethodPost, "/1.0/kb/{objects}/{objectId}/customFields", tenant, s.createObjectCustomFields)
+	s.handle(http.MethodPut, "/1.0/kb/{objects}/{objectId}/customFields", tenant, s.modifyObjectCustomFields)
+	s.handle(http.MethodDelete, "/1.0/kb/{objects}/{objectId}/customFields", tenant, s.deleteObjectCustomFields)
+}
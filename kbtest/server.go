@@ -0,0 +1,253 @@
+// Package kbtest implements an in-memory fake Kill Bill server for hermetic tests.
+//
+// The fake covers the core REST surface of kbswagger.yaml: tenants, accounts, payment methods,
+// catalog upload, subscriptions and bundles, invoices, external charges, payments, tags and
+// custom fields, the account export, the healthcheck and nodes-info, profiling, as well as the test clock
+// (debug.GetClock / debug.SetClock). It speaks the same protocol as Kill Bill, so both kbclient.New and
+// wrapper.NewKBClient work against it unchanged:
+//
+//	srv := kbtest.NewServer()
+//	defer srv.Close()
+//
+//	client := killbill.NewKBClient(&killbill.Config{
+//		Url:        srv.Host(),
+//		Username:   kbtest.DefaultUsername,
+//		Password:   kbtest.DefaultPassword,
+//		ApiKey:     "bob",
+//		ApiSecret:  "lazar",
+//		TimeoutSec: 5,
+//	})
+//
+// Billing is deliberately simple: subscriptions are billed in advance, one period at a time from
+// their billing start date, and phases are aligned on the subscription start date. There is no
+// proration nor repair when a subscription is cancelled or changes plan mid-period: the change
+// takes effect for billing at the next period. Invoices are generated synchronously when a
+// subscription is created and when the clock moves forward. Committed invoices of accounts with
+// a default payment method are paid right away, unless the account is tagged AUTO_PAY_OFF.
+package kbtest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// Default credentials of the fake server.
+const (
+	DefaultUsername = "admin"
+	DefaultPassword = "password"
+)
+
+// Server is a fake Kill Bill server.
+type Server struct {
+	srv    *httptest.Server
+	routes []*route
+
+	// mu protects everything below.
+	mu      sync.Mutex
+	now     time.Time
+	tenants []*tenantData
+	// Invoice and payment numbers are global, as in Kill Bill.
+	invoiceNumber int
+	paymentNumber int
+	// sessions are the ids of the active sessions.
+	sessions []string
+	// outOfRotation is set by putOutOfRotation: the healthcheck fails.
+	outOfRotation bool
+	// nodes are the node names returned by nodes-info.
+	nodes []string
+	// queueEntries is the response of the admin queues api.
+	queueEntries interface{}
+}
+
+// NewServer starts a new fake Kill Bill server. The clock is set to the current time,
+// use SetClock to move it. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		now: time.Now().UTC().Truncate(time.Second),
+	}
+	s.registerRoutes()
+	s.srv = httptest.NewServer(s)
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// URL returns the base URL of the server, for ex. http://127.0.0.1:34567
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Host returns the host:port of the server, as expected by wrapper.Config.Url
+// or the kbcmd --host flag.
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.srv.URL, "http://")
+}
+
+// NewTransport returns a new transport to the server.
+func (s *Server) NewTransport() *httptransport.Runtime {
+	trp := httptransport.New(s.Host(), "", []string{"http"})
+	trp.Producers["text/xml"] = runtime.TextProducer()
+	return trp
+}
+
+// NewClient returns a new kill bill client for the given tenant,
+// using the default credentials. apiKey and apiSecret may be empty for cross tenant operations.
+func (s *Server) NewClient(apiKey, apiSecret string) *kbclient.KillBill {
+	authWriter := runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		if err := httptransport.BasicAuth(DefaultUsername, DefaultPassword).AuthenticateRequest(r, nil); err != nil {
+			return err
+		}
+		if apiKey != "" {
+			if err := r.SetHeaderParam("X-KillBill-ApiKey", apiKey); err != nil {
+				return err
+			}
+		}
+		if apiSecret != "" {
+			if err := r.SetHeaderParam("X-KillBill-ApiSecret", apiSecret); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	createdBy := "kbtest"
+	return kbclient.New(s.NewTransport(), strfmt.Default, authWriter, kbclient.KillbillDefaults{
+		CreatedBy: &createdBy,
+	})
+}
+
+// AddTenant creates a tenant, as POST /1.0/kb/tenants would, and returns its id.
+// If a tenant with the same api key exists already, its id is returned.
+func (s *Server) AddTenant(apiKey, apiSecret string) strfmt.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t := s.tenantByAPIKey(apiKey); t != nil {
+		return t.tenant.TenantID
+	}
+	return s.addTenant(apiKey, apiSecret, apiKey).tenant.TenantID
+}
+
+// SetNodes sets the node names returned by nodes-info. Defaults to the host of the server.
+func (s *Server) SetNodes(names ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes = append([]string(nil), names...)
+}
+
+// SetQueueEntries sets the response of admin.GetQueueEntries, for ex. a kbqueue.QueueEntries.
+// The fake has no queues of its own: the response defaults to empty bus events and notifications.
+func (s *Server) SetQueueEntries(entries interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queueEntries = entries
+}
+
+// SetInRotation puts the server in or out of rotation, as the admin healthcheck api does.
+// The healthcheck fails while the server is out of rotation.
+func (s *Server) SetInRotation(inRotation bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.outOfRotation = !inRotation
+}
+
+// Now returns the current time of the server clock.
+func (s *Server) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// SetClock moves the server clock, as debug.SetClock would. Moving the clock forward
+// generates (and pays) the invoices that became due in the meantime.
+func (s *Server) SetClock(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setClock(t)
+}
+
+// AddDays moves the server clock by the given number of days.
+func (s *Server) AddDays(days int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setClock(s.now.AddDate(0, 0, days))
+}
+
+func (s *Server) setClock(t time.Time) {
+	forward := t.After(s.now)
+	s.now = t.UTC()
+	if !forward {
+		return
+	}
+	for _, td := range s.tenants {
+		for _, acc := range td.accounts {
+			s.invoiceAccount(td, acc, s.now)
+		}
+	}
+}
+
+// ExpireSessions expires the sessions, so that requests using them are rejected with 401.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = nil
+}
+
+// authenticated returns true if the request carries the default credentials or a valid session cookie.
+func (s *Server) authenticated(req *http.Request) bool {
+	if user, pwd, ok := req.BasicAuth(); ok {
+		return user == DefaultUsername && pwd == DefaultPassword
+	}
+	if c, err := req.Cookie(sessionCookie); err == nil {
+		for _, id := range s.sessions {
+			if id == c.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r := &request{Request: req, w: w}
+
+	rt, params := s.match(req.Method, req.URL.Path)
+	if rt == nil {
+		r.error(http.StatusNotImplemented, kbcommon.ErrorCodeNotImplemented, "kbtest: %s %s is not supported", req.Method, req.URL.Path)
+		return
+	}
+	r.params = params
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rt.auth && !s.authenticated(req) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if rt.tenant {
+		t := s.tenantByAPIKey(req.Header.Get("X-KillBill-ApiKey"))
+		if t == nil || t.tenant.APISecret == nil || *t.tenant.APISecret != req.Header.Get("X-KillBill-ApiSecret") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		r.tenant = t
+	}
+	if info := req.Header.Get(kbcommon.ProfilingRequestHeader); info != "" {
+		w.Header().Set(kbcommon.ProfilingResponseHeader, profilingData(rt, req.Method, info))
+	}
+	rt.handler(r)
+}
@@ -0,0 +1,309 @@
+package kbtest_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/catalog"
+	"github.com/killbill/kbcli/v3/kbclient/invoice"
+	"github.com/killbill/kbcli/v3/kbclient/subscription"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+	killbill "github.com/killbill/kbcli/v3/wrapper"
+)
+
+var startDate = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+func readCatalog(t *testing.T) string {
+	b, err := os.ReadFile("../wrapper/resource/catalog.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func invoiceAmounts(invs []*kbmodel.Invoice) []float64 {
+	var res []float64
+	for _, inv := range invs {
+		res = append(res, inv.Amount)
+	}
+	return res
+}
+
+// TestWrapper runs the wrapper subscription scenario against the fake server.
+func TestWrapper(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.SetClock(startDate)
+
+	cli := killbill.NewKBClient(&killbill.Config{
+		Url:        srv.Host(),
+		Username:   kbtest.DefaultUsername,
+		Password:   kbtest.DefaultPassword,
+		ApiKey:     "bob",
+		ApiSecret:  "lazar",
+		TimeoutSec: 5,
+	})
+	ctx := context.Background()
+
+	if _, err := cli.CreateTenant(ctx, "bob", "lazar", "bob"); err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	if _, err := cli.CreateTenant(ctx, "bob", "lazar", "bob"); !errors.Is(err, kbcommon.ErrTenantAlreadyExists) {
+		t.Fatalf("expected ErrTenantAlreadyExists, got %v", err)
+	}
+	if err := cli.UploadCatalogXML(ctx, "../wrapper/resource/catalog.xml"); err != nil {
+		t.Fatalf("UploadCatalogXML: %v", err)
+	}
+
+	accountID, err := cli.CreateAccount(ctx, "account-1", "USD", "en_US", 0)
+	if err != nil {
+		t.Fatalf("CreateAccount: %v", err)
+	}
+	if _, err := cli.GetAccountByKey(ctx, "unknown"); !errors.Is(err, kbcommon.ErrAccountNotFound) {
+		t.Fatalf("expected ErrAccountNotFound, got %v", err)
+	}
+
+	effDt := strfmt.Date(startDate)
+	subID, err := cli.CreateSubscription(ctx, accountID, "sub-1", &effDt, &effDt, "standard-monthly", 0, true)
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	sub, err := cli.GetSubscriptionByKey(ctx, "sub-1")
+	if err != nil {
+		t.Fatalf("GetSubscriptionByKey: %v", err)
+	}
+	if sub.SubscriptionID != subID || sub.State != kbmodel.SubscriptionStateACTIVE {
+		t.Fatalf("unexpected subscription %+v", sub)
+	}
+
+	invs, err := cli.GetAllInvoices(ctx, accountID)
+	if err != nil {
+		t.Fatalf("GetAllInvoices: %v", err)
+	}
+	if diff := cmp.Diff([]float64{4.95}, invoiceAmounts(invs)); diff != "" {
+		t.Fatalf("unexpected invoices (-want +got):\n%s", diff)
+	}
+
+	// The discount phase lasts 3 months, the April invoice is for the first evergreen period.
+	srv.SetClock(startDate.AddDate(0, 1, 0))
+	srv.SetClock(startDate.AddDate(0, 3, 0))
+	invs, err = cli.GetAllInvoices(ctx, accountID)
+	if err != nil {
+		t.Fatalf("GetAllInvoices: %v", err)
+	}
+	if diff := cmp.Diff([]float64{4.95, 4.95, 29.9}, invoiceAmounts(invs)); diff != "" {
+		t.Fatalf("unexpected invoices (-want +got):\n%s", diff)
+	}
+	for _, inv := range invs {
+		if inv.Balance != inv.Amount {
+			t.Fatalf("expected unpaid invoice %s, got balance %v", inv.InvoiceNumber, inv.Balance)
+		}
+	}
+
+	if err := cli.PayInvoice(ctx, invs[0].InvoiceID, accountID, 0, true); err != nil {
+		t.Fatalf("PayInvoice: %v", err)
+	}
+	inv, err := cli.GetInvoice(ctx, invs[0].InvoiceID)
+	if err != nil {
+		t.Fatalf("GetInvoice: %v", err)
+	}
+	if inv.Balance != 0 {
+		t.Fatalf("expected paid invoice, got balance %v", inv.Balance)
+	}
+	acc, err := cli.GetAccount(ctx, accountID, true)
+	if err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if acc.AccountBalance != 34.85 {
+		t.Fatalf("expected account balance 34.85, got %v", acc.AccountBalance)
+	}
+
+	if err := cli.CancelSubscription(ctx, subID, nil); err != nil {
+		t.Fatalf("CancelSubscription: %v", err)
+	}
+	srv.AddDays(60)
+	invs, err = cli.GetAllInvoices(ctx, accountID)
+	if err != nil {
+		t.Fatalf("GetAllInvoices: %v", err)
+	}
+	if len(invs) != 3 {
+		t.Fatalf("expected no invoice after cancellation, got %d invoices", len(invs))
+	}
+}
+
+func TestKBClient(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.SetClock(startDate)
+	srv.AddTenant("bob", "lazar")
+
+	ctx := context.Background()
+	client := srv.NewClient("bob", "lazar")
+
+	if _, err := srv.NewClient("bob", "wrong").Catalog.GetCatalogXML(ctx, &catalog.GetCatalogXMLParams{}); err == nil {
+		t.Fatal("expected an authentication error")
+	}
+	if _, err := client.Catalog.UploadCatalogXML(ctx, &catalog.UploadCatalogXMLParams{
+		Body: readCatalog(t),
+	}); err != nil {
+		t.Fatalf("UploadCatalogXML: %v", err)
+	}
+
+	var accounts []*kbmodel.Account
+	for _, key := range []string{"a", "b", "c"} {
+		resp, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+			Body:                  &kbmodel.Account{ExternalKey: key, Currency: kbmodel.AccountCurrencyUSD},
+			ProcessLocationHeader: true,
+		})
+		if err != nil {
+			t.Fatalf("CreateAccount: %v", err)
+		}
+		accounts = append(accounts, resp.Payload)
+	}
+
+	page, err := client.Account.GetAccounts(ctx, &account.GetAccountsParams{Offset: swag.Int64(1), Limit: swag.Int64(1)})
+	if err != nil {
+		t.Fatalf("GetAccounts: %v", err)
+	}
+	if len(page.Payload) != 1 || page.Payload[0].ExternalKey != "b" {
+		t.Fatalf("unexpected page %+v", page.Payload)
+	}
+	if got := page.HttpResponse.GetHeader(kbcommon.PaginationTotalNbRecordsHeader); got != "3" {
+		t.Fatalf("expected 3 records, got %q", got)
+	}
+	if got := page.HttpResponse.GetHeader(kbcommon.PaginationNextOffsetHeader); got != "2" {
+		t.Fatalf("expected next offset 2, got %q", got)
+	}
+
+	// Invoices of accounts with a default payment method are paid, unless they are tagged AUTO_PAY_OFF.
+	acc, other := accounts[0], accounts[1]
+	for _, a := range []*kbmodel.Account{acc, other} {
+		if _, err := client.Account.CreatePaymentMethod(ctx, &account.CreatePaymentMethodParams{
+			AccountID: a.AccountID,
+			Body:      &kbmodel.PaymentMethod{PluginName: "__EXTERNAL_PAYMENT__"},
+			IsDefault: swag.Bool(true),
+		}); err != nil {
+			t.Fatalf("CreatePaymentMethod: %v", err)
+		}
+	}
+	if _, err := client.Account.CreateAccountTags(ctx, &account.CreateAccountTagsParams{
+		AccountID: other.AccountID,
+		Body:      []strfmt.UUID{killbill.AUTO_PAY_OFF},
+	}); err != nil {
+		t.Fatalf("CreateAccountTags: %v", err)
+	}
+
+	for _, a := range []*kbmodel.Account{acc, other} {
+		if _, err := client.Subscription.CreateSubscription(ctx, &subscription.CreateSubscriptionParams{
+			Body: &kbmodel.Subscription{AccountID: a.AccountID, PlanName: swag.String("standard-monthly")},
+		}); err != nil {
+			t.Fatalf("CreateSubscription: %v", err)
+		}
+	}
+
+	scenarios := []struct {
+		account *kbmodel.Account
+		balance float64
+	}{
+		{account: acc, balance: 0},
+		{account: other, balance: 4.95},
+	}
+	for _, s := range scenarios {
+		resp, err := client.Account.GetAccount(ctx, &account.GetAccountParams{
+			AccountID:          s.account.AccountID,
+			AccountWithBalance: swag.Bool(true),
+		})
+		if err != nil {
+			t.Fatalf("GetAccount: %v", err)
+		}
+		if resp.Payload.AccountBalance != s.balance {
+			t.Fatalf("account %s: expected balance %v, got %v", s.account.ExternalKey, s.balance, resp.Payload.AccountBalance)
+		}
+	}
+
+	payments, err := client.Account.GetPaymentsForAccount(ctx, &account.GetPaymentsForAccountParams{AccountID: acc.AccountID})
+	if err != nil {
+		t.Fatalf("GetPaymentsForAccount: %v", err)
+	}
+	if len(payments.Payload) != 1 || payments.Payload[0].PurchasedAmount != 4.95 {
+		t.Fatalf("unexpected payments %+v", payments.Payload)
+	}
+
+	charges, err := client.Invoice.CreateExternalCharges(ctx, &invoice.CreateExternalChargesParams{
+		AccountID: other.AccountID,
+		Body: []*kbmodel.InvoiceItem{
+			{Amount: 10, Description: "setup"},
+			{Amount: 2.5, Description: "shipping"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateExternalCharges: %v", err)
+	}
+	if len(charges.Payload) != 2 {
+		t.Fatalf("expected 2 charges, got %d", len(charges.Payload))
+	}
+	invoiceID := charges.Payload[0].InvoiceID
+	inv, err := client.Invoice.GetInvoice(ctx, &invoice.GetInvoiceParams{InvoiceID: invoiceID})
+	if err != nil {
+		t.Fatalf("GetInvoice: %v", err)
+	}
+	if inv.Payload.Status != kbmodel.InvoiceStatusDRAFT || inv.Payload.Amount != 12.5 || inv.Payload.Balance != 0 {
+		t.Fatalf("unexpected invoice %+v", inv.Payload)
+	}
+	if _, err := client.Invoice.CommitInvoice(ctx, &invoice.CommitInvoiceParams{InvoiceID: invoiceID}); err != nil {
+		t.Fatalf("CommitInvoice: %v", err)
+	}
+	if _, err := client.Invoice.CommitInvoice(ctx, &invoice.CommitInvoiceParams{InvoiceID: invoiceID}); !errors.Is(err, kbcommon.ErrValidation) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+
+	fields := []*kbmodel.CustomField{{Name: swag.String("color"), Value: swag.String("blue")}}
+	if _, err := client.Account.CreateAccountCustomFields(ctx, &account.CreateAccountCustomFieldsParams{
+		AccountID: acc.AccountID,
+		Body:      fields,
+	}); err != nil {
+		t.Fatalf("CreateAccountCustomFields: %v", err)
+	}
+	cfs, err := client.Account.GetAccountCustomFields(ctx, &account.GetAccountCustomFieldsParams{AccountID: acc.AccountID})
+	if err != nil {
+		t.Fatalf("GetAccountCustomFields: %v", err)
+	}
+	if len(cfs.Payload) != 1 || *cfs.Payload[0].Value != "blue" {
+		t.Fatalf("unexpected custom fields %+v", cfs.Payload)
+	}
+
+	tags, err := client.Account.GetAccountTags(ctx, &account.GetAccountTagsParams{AccountID: other.AccountID})
+	if err != nil {
+		t.Fatalf("GetAccountTags: %v", err)
+	}
+	if len(tags.Payload) != 1 || tags.Payload[0].TagDefinitionName != "AUTO_PAY_OFF" {
+		t.Fatalf("unexpected tags %+v", tags.Payload)
+	}
+	// Removing AUTO_PAY_OFF pays the outstanding invoices.
+	if _, err := client.Account.DeleteAccountTags(ctx, &account.DeleteAccountTagsParams{
+		AccountID: other.AccountID,
+		TagDef:    []strfmt.UUID{killbill.AUTO_PAY_OFF},
+	}); err != nil {
+		t.Fatalf("DeleteAccountTags: %v", err)
+	}
+	resp, err := client.Account.GetAccount(ctx, &account.GetAccountParams{
+		AccountID:          other.AccountID,
+		AccountWithBalance: swag.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if resp.Payload.AccountBalance != 0 {
+		t.Fatalf("expected paid account, got balance %v", resp.Payload.AccountBalance)
+	}
+}
@@ -0,0 +1,659 @@
+package kbtest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+type subscription struct {
+	id               strfmt.UUID
+	bundleID         strfmt.UUID
+	accountID        strfmt.UUID
+	externalKey      string
+	startDate        time.Time
+	billingStartDate time.Time
+	cancelledDate    *time.Time
+	billingEndDate   *time.Time
+	bcd              int32
+	// plans in effective date order, the first one is the initial plan.
+	plans          []planChange
+	blockingStates []*kbmodel.BlockingState
+
+	// billedThrough is the date up to which the subscription has been invoiced.
+	billedThrough time.Time
+	// billingDone is set once there is nothing left to bill.
+	billingDone bool
+}
+
+type planChange struct {
+	plan          *plan
+	effectiveDate time.Time
+}
+
+// planAt returns the plan in effect at the given date.
+func (sub *subscription) planAt(at time.Time) *plan {
+	res := sub.plans[0].plan
+	for _, c := range sub.plans[1:] {
+		if !c.effectiveDate.After(at) {
+			res = c.plan
+		}
+	}
+	return res
+}
+
+// nextPlanChange returns the date of the first plan change after the given date, if any.
+func (sub *subscription) nextPlanChange(after time.Time) *time.Time {
+	for _, c := range sub.plans[1:] {
+		if c.effectiveDate.After(after) {
+			d := c.effectiveDate
+			return &d
+		}
+	}
+	return nil
+}
+
+// chargedThrough returns the end of the current billing period, or now if nothing was billed yet.
+func (sub *subscription) chargedThrough(now time.Time) time.Time {
+	if sub.billedThrough.After(now) {
+		return sub.billedThrough
+	}
+	return now
+}
+
+// isBlocked returns true if any service blocks billing (or entitlement) at the given date.
+func (sub *subscription) isBlocked(at time.Time, billing bool) bool {
+	latest := map[string]*kbmodel.BlockingState{}
+	for _, bs := range sub.blockingStates {
+		if !time.Time(bs.EffectiveDate).After(at) {
+			latest[bs.Service] = bs
+		}
+	}
+	for _, bs := range latest {
+		if (billing && bs.IsBlockBilling) || (!billing && bs.IsBlockEntitlement) {
+			return true
+		}
+	}
+	return false
+}
+
+func (sub *subscription) state(now time.Time) kbmodel.SubscriptionStateEnum {
+	switch {
+	case sub.startDate.After(now):
+		return kbmodel.SubscriptionStatePENDING
+	case sub.cancelledDate != nil && !sub.cancelledDate.After(now):
+		return kbmodel.SubscriptionStateCANCELLED
+	case sub.isBlocked(now, false):
+		return kbmodel.SubscriptionStateBLOCKED
+	}
+	return kbmodel.SubscriptionStateACTIVE
+}
+
+func (t *tenantData) subscription(id strfmt.UUID) *subscription {
+	for _, sub := range t.subscriptions {
+		if sub.id == id {
+			return sub
+		}
+	}
+	return nil
+}
+
+func (t *tenantData) bundle(id strfmt.UUID) *kbmodel.Bundle {
+	for _, b := range t.bundles {
+		if b.BundleID == id {
+			return b
+		}
+	}
+	return nil
+}
+
+// isActiveBundle returns true if the bundle has a subscription that isn't cancelled.
+func (s *Server) isActiveBundle(t *tenantData, b *kbmodel.Bundle) bool {
+	for _, sub := range t.subscriptions {
+		if sub.bundleID == b.BundleID && sub.state(s.now) != kbmodel.SubscriptionStateCANCELLED {
+			return true
+		}
+	}
+	return false
+}
+
+// subscriptionParam returns the subscription of the given path parameter. If it doesn't exist,
+// a 404 response is written and nil is returned.
+func (r *request) subscriptionParam(name string) *subscription {
+	sub := r.tenant.subscription(r.uuidParam(name))
+	if sub == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeSubInvalidSubscriptionID, "Object id=%s type=SUBSCRIPTION doesn't exist!", r.param(name))
+	}
+	return sub
+}
+
+// atDate returns t, or now if t is a date matching today.
+func (s *Server) atDate(t time.Time) time.Time {
+	if t.Equal(day(s.now)) {
+		return s.now
+	}
+	return t
+}
+
+func event(typ kbmodel.EventSubscriptionEventTypeEnum, date time.Time, service string, p *plan, ph *phase) *kbmodel.EventSubscription {
+	ev := &kbmodel.EventSubscription{
+		EventID:       newID(),
+		EventType:     typ,
+		EffectiveDate: strfmt.DateTime(date),
+		ServiceName:   service,
+	}
+	if p != nil {
+		ev.Plan, ev.Product, ev.PriceList = p.name, p.product.name, p.priceList
+		ev.BillingPeriod = kbmodel.EventSubscriptionBillingPeriodEnum(p.billingPeriod())
+	}
+	if ph != nil {
+		ev.Phase = ph.name
+	}
+	return ev
+}
+
+func (sub *subscription) events() []*kbmodel.EventSubscription {
+	first := sub.plans[0].plan
+	res := []*kbmodel.EventSubscription{
+		event(kbmodel.EventSubscriptionEventTypeSTARTENTITLEMENT, sub.startDate, "entitlement-service", first, first.phases[0]),
+		event(kbmodel.EventSubscriptionEventTypeSTARTBILLING, sub.billingStartDate, "billing-service", first, first.phases[0]),
+	}
+	// Phase transitions of the initial plan.
+	phaseStart := sub.startDate
+	for _, ph := range first.phases[:len(first.phases)-1] {
+		if ph.unit == "UNLIMITED" {
+			break
+		}
+		phaseStart = addDuration(phaseStart, ph.unit, ph.number)
+		next, _, _ := first.phaseAt(sub.startDate, phaseStart)
+		res = append(res, event(kbmodel.EventSubscriptionEventTypePHASE, phaseStart, "entitlement+billing-service", first, next))
+	}
+	for _, c := range sub.plans[1:] {
+		ph, _, _ := c.plan.phaseAt(sub.startDate, c.effectiveDate)
+		res = append(res, event(kbmodel.EventSubscriptionEventTypeCHANGE, c.effectiveDate, "entitlement+billing-service", c.plan, ph))
+	}
+	for _, bs := range sub.blockingStates {
+		ev := event(kbmodel.EventSubscriptionEventTypeSERVICESTATECHANGE, time.Time(bs.EffectiveDate), bs.Service, nil, nil)
+		ev.ServiceStateName, ev.IsBlockedBilling, ev.IsBlockedEntitlement = bs.StateName, bs.IsBlockBilling, bs.IsBlockEntitlement
+		res = append(res, ev)
+	}
+	if sub.cancelledDate != nil {
+		res = append(res, event(kbmodel.EventSubscriptionEventTypeSTOPENTITLEMENT, *sub.cancelledDate, "entitlement-service", sub.planAt(*sub.cancelledDate), nil))
+	}
+	if sub.billingEndDate != nil {
+		res = append(res, event(kbmodel.EventSubscriptionEventTypeSTOPBILLING, *sub.billingEndDate, "billing-service", sub.planAt(*sub.billingEndDate), nil))
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return time.Time(res[i].EffectiveDate).Before(time.Time(res[j].EffectiveDate))
+	})
+	return res
+}
+
+func (s *Server) subscriptionView(r *request, sub *subscription) *kbmodel.Subscription {
+	at := s.now
+	if at.Before(sub.startDate) {
+		at = sub.startDate
+	}
+	p := sub.planAt(at)
+	ph, _, _ := p.phaseAt(sub.startDate, at)
+	billingPeriod := kbmodel.SubscriptionBillingPeriodEnum(p.billingPeriod())
+	res := &kbmodel.Subscription{
+		AccountID:         sub.accountID,
+		BundleID:          sub.bundleID,
+		SubscriptionID:    sub.id,
+		ExternalKey:       sub.externalKey,
+		StartDate:         strfmt.DateTime(sub.startDate),
+		BillingStartDate:  strfmt.DateTime(sub.billingStartDate),
+		BillCycleDayLocal: sub.bcd,
+		PlanName:          swag.String(p.name),
+		ProductName:       swag.String(p.product.name),
+		PriceList:         swag.String(p.priceList),
+		BillingPeriod:     &billingPeriod,
+		PhaseType:         kbmodel.SubscriptionPhaseTypeEnum(ph.typ),
+		ProductCategory:   kbmodel.SubscriptionProductCategoryEnum(p.product.category),
+		SourceType:        kbmodel.SubscriptionSourceTypeNATIVE,
+		State:             sub.state(s.now),
+		Quantity:          1,
+		Events:            sub.events(),
+		AuditLogs:         r.auditLogs(sub.id),
+	}
+	if b := r.tenant.bundle(sub.bundleID); b != nil {
+		res.BundleExternalKey = b.ExternalKey
+	}
+	if sub.billedThrough.After(sub.billingStartDate) {
+		res.ChargedThroughDate = strfmt.Date(sub.billedThrough)
+	}
+	if sub.cancelledDate != nil {
+		res.CancelledDate = strfmt.DateTime(*sub.cancelledDate)
+	}
+	if sub.billingEndDate != nil {
+		res.BillingEndDate = strfmt.DateTime(*sub.billingEndDate)
+	}
+	return res
+}
+
+func (s *Server) bundleView(r *request, b *kbmodel.Bundle) *kbmodel.Bundle {
+	res := *b
+	res.Subscriptions = []*kbmodel.Subscription{}
+	for _, sub := range r.tenant.subscriptions {
+		if sub.bundleID == b.BundleID {
+			res.Subscriptions = append(res.Subscriptions, s.subscriptionView(r, sub))
+		}
+	}
+	res.AuditLogs = r.auditLogs(b.BundleID)
+	return &res
+}
+
+// addSubscription creates a subscription from the given body, in a new bundle unless the body
+// references an existing one. On error, the response is written and nil is returned.
+func (s *Server) addSubscription(r *request, body *kbmodel.Subscription, entitlementDate, billingDate time.Time) *subscription {
+	t := r.tenant
+	var b *kbmodel.Bundle
+	if body.BundleID != "" {
+		if b = t.bundle(body.BundleID); b == nil {
+			r.error(http.StatusNotFound, kbcommon.ErrorCodeSubGetInvalidBundleID, "Object id=%s type=BUNDLE doesn't exist!", body.BundleID)
+			return nil
+		}
+		body.AccountID = *b.AccountID
+	}
+	acc := t.account(body.AccountID)
+	if acc == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeAccountDoesNotExistForID, "Account does not exist for id %s", body.AccountID)
+		return nil
+	}
+
+	var billingPeriod string
+	if body.BillingPeriod != nil {
+		billingPeriod = string(*body.BillingPeriod)
+	}
+	p := t.findPlan(swag.StringValue(body.PlanName), swag.StringValue(body.ProductName), billingPeriod, swag.StringValue(body.PriceList))
+	if p == nil {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeCatNoSuchPlan, "Could not find any plans named '%s'", swag.StringValue(body.PlanName))
+		return nil
+	}
+
+	if b != nil {
+		for _, sub := range t.subscriptions {
+			if sub.bundleID == b.BundleID && p.product.category == "BASE" && sub.planAt(s.now).product.category == "BASE" && sub.state(s.now) != kbmodel.SubscriptionStateCANCELLED {
+				r.error(http.StatusConflict, kbcommon.ErrorCodeSubCreateBpExists, "Subscription bundle %s already has a base subscription", b.BundleID)
+				return nil
+			}
+		}
+	} else {
+		if p.product.category == "ADD_ON" {
+			r.error(http.StatusBadRequest, kbcommon.ErrorCodeSubCreateNoBp, "Missing Base Subscription for bundle %s", body.BundleID)
+			return nil
+		}
+		key := body.BundleExternalKey
+		if key == "" {
+			key = body.ExternalKey
+		}
+		for _, other := range t.bundles {
+			if key != "" && other.ExternalKey == key && s.isActiveBundle(t, other) {
+				r.error(http.StatusConflict, kbcommon.ErrorCodeSubCreateBpExists, "Cannot create a bundle with the key %s since there is already an active bundle with this key", key)
+				return nil
+			}
+		}
+		id := newID()
+		if key == "" {
+			key = string(id)
+		}
+		b = &kbmodel.Bundle{BundleID: id, AccountID: &acc.AccountID, ExternalKey: key}
+		t.bundles = append(t.bundles, b)
+		s.audit(r, b.BundleID, "BUNDLE", "INSERT")
+	}
+
+	sub := &subscription{
+		id:               newID(),
+		bundleID:         b.BundleID,
+		accountID:        acc.AccountID,
+		externalKey:      body.ExternalKey,
+		startDate:        entitlementDate,
+		billingStartDate: billingDate,
+		bcd:              body.BillCycleDayLocal,
+		plans:            []planChange{{plan: p, effectiveDate: entitlementDate}},
+		billedThrough:    billingDate,
+	}
+	if sub.externalKey == "" {
+		sub.externalKey = string(sub.id)
+	}
+	t.subscriptions = append(t.subscriptions, sub)
+	s.audit(r, sub.id, "SUBSCRIPTION", "INSERT")
+	return sub
+}
+
+// subscriptionDates returns the entitlement and billing dates of the request.
+func (s *Server) subscriptionDates(r *request) (entitlementDate, billingDate time.Time, ok bool) {
+	if entitlementDate, ok = r.queryTime("entitlementDate", s.now); !ok {
+		return
+	}
+	if billingDate, ok = r.queryTime("billingDate", entitlementDate); !ok {
+		return
+	}
+	return s.atDate(entitlementDate), s.atDate(billingDate), true
+}
+
+// POST /1.0/kb/subscriptions
+func (s *Server) createSubscription(r *request) {
+	var body kbmodel.Subscription
+	if !r.decode(&body) {
+		return
+	}
+	entitlementDate, billingDate, ok := s.subscriptionDates(r)
+	if !ok {
+		return
+	}
+	sub := s.addSubscription(r, &body, entitlementDate, billingDate)
+	if sub == nil {
+		return
+	}
+	s.invoiceAccount(r.tenant, r.tenant.account(sub.accountID), s.now)
+	r.created("/1.0/kb/subscriptions/" + string(sub.id))
+}
+
+// addBundle creates the base subscription and add-ons of a bundle. On error, the response is
+// written and an empty id is returned.
+func (s *Server) addBundle(r *request, subs []*kbmodel.Subscription, entitlementDate, billingDate time.Time) (accountID, bundleID strfmt.UUID) {
+	for _, body := range subs {
+		if body == nil {
+			continue
+		}
+		if bundleID != "" && body.BundleID == "" {
+			body.BundleID = bundleID
+		}
+		sub := s.addSubscription(r, body, entitlementDate, billingDate)
+		if sub == nil {
+			return "", ""
+		}
+		accountID, bundleID = sub.accountID, sub.bundleID
+	}
+	if bundleID == "" {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeSubCreateNoBp, "Missing Base Subscription")
+	}
+	return accountID, bundleID
+}
+
+// POST /1.0/kb/subscriptions/createSubscriptionWithAddOns
+func (s *Server) createSubscriptionWithAddOns(r *request) {
+	var body []*kbmodel.Subscription
+	if !r.decode(&body) {
+		return
+	}
+	entitlementDate, billingDate, ok := s.subscriptionDates(r)
+	if !ok {
+		return
+	}
+	accountID, bundleID := s.addBundle(r, body, entitlementDate, billingDate)
+	if bundleID == "" {
+		return
+	}
+	s.invoiceAccount(r.tenant, r.tenant.account(accountID), s.now)
+	r.created("/1.0/kb/bundles/" + string(bundleID))
+}
+
+// POST /1.0/kb/subscriptions/createSubscriptionsWithAddOns
+func (s *Server) createSubscriptionsWithAddOns(r *request) {
+	var body []*kbmodel.BulkSubscriptionsBundle
+	if !r.decode(&body) {
+		return
+	}
+	entitlementDate, billingDate, ok := s.subscriptionDates(r)
+	if !ok {
+		return
+	}
+	var accountID strfmt.UUID
+	var bundleIDs []string
+	for _, bulk := range body {
+		if bulk == nil {
+			continue
+		}
+		var bundleID strfmt.UUID
+		if accountID, bundleID = s.addBundle(r, bulk.BaseEntitlementAndAddOns, entitlementDate, billingDate); bundleID == "" {
+			return
+		}
+		bundleIDs = append(bundleIDs, string(bundleID))
+	}
+	if acc := r.tenant.account(accountID); acc != nil {
+		s.invoiceAccount(r.tenant, acc, s.now)
+	}
+	r.created("/1.0/kb/accounts/" + string(accountID) + "/bundles?bundlesFilter=" + strings.Join(bundleIDs, ","))
+}
+
+// GET /1.0/kb/subscriptions/{subscriptionId}
+func (s *Server) getSubscription(r *request) {
+	if sub := r.subscriptionParam("subscriptionId"); sub != nil {
+		r.json(http.StatusOK, s.subscriptionView(r, sub))
+	}
+}
+
+// GET /1.0/kb/subscriptions?externalKey=
+func (s *Server) getSubscriptionByKey(r *request) {
+	for _, sub := range r.tenant.subscriptions {
+		if sub.externalKey == r.query("externalKey") {
+			r.json(http.StatusOK, s.subscriptionView(r, sub))
+			return
+		}
+	}
+	r.error(http.StatusNotFound, kbcommon.ErrorCodeSubInvalidSubscriptionID, "Subscription does not exist for key %s", r.query("externalKey"))
+}
+
+// DELETE /1.0/kb/subscriptions/{subscriptionId}
+func (s *Server) cancelSubscriptionPlan(r *request) {
+	sub := r.subscriptionParam("subscriptionId")
+	if sub == nil {
+		return
+	}
+	if sub.cancelledDate != nil {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeSubCancelBadState, "Subscription %s is already cancelled", sub.id)
+		return
+	}
+	requestedDate, ok := r.queryTime("requestedDate", time.Time{})
+	if !ok {
+		return
+	}
+	policyDate := func(policy string) time.Time {
+		if policy == "IMMEDIATE" || policy == "START_OF_TERM" {
+			return s.now
+		}
+		return sub.chargedThrough(s.now)
+	}
+	cancelledDate := policyDate(r.query("entitlementPolicy"))
+	billingEndDate := policyDate(r.query("billingPolicy"))
+	if !requestedDate.IsZero() {
+		cancelledDate = s.atDate(requestedDate)
+		if r.queryBool("useRequestedDateForBilling", false) {
+			billingEndDate = cancelledDate
+		}
+	}
+
+	var cancelled []*subscription
+	if sub.planAt(s.now).product.category == "BASE" {
+		// Cancelling the base subscription cancels the add-ons.
+		for _, other := range r.tenant.subscriptions {
+			if other.bundleID == sub.bundleID && other.cancelledDate == nil {
+				cancelled = append(cancelled, other)
+			}
+		}
+	} else {
+		cancelled = []*subscription{sub}
+	}
+	for _, c := range cancelled {
+		entitlementEnd, billingEnd := cancelledDate, billingEndDate
+		c.cancelledDate, c.billingEndDate = &entitlementEnd, &billingEnd
+		s.audit(r, c.id, "SUBSCRIPTION", "UPDATE")
+	}
+	r.noContent()
+}
+
+// PUT /1.0/kb/subscriptions/{subscriptionId}/uncancel
+func (s *Server) uncancelSubscriptionPlan(r *request) {
+	sub := r.subscriptionParam("subscriptionId")
+	if sub == nil {
+		return
+	}
+	if sub.cancelledDate == nil || !sub.cancelledDate.After(s.now) {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeSubUncancelBadState, "Subscription %s was not in a cancelled state", sub.id)
+		return
+	}
+	sub.cancelledDate, sub.billingEndDate = nil, nil
+	s.audit(r, sub.id, "SUBSCRIPTION", "UPDATE")
+	r.noContent()
+}
+
+// PUT /1.0/kb/subscriptions/{subscriptionId}
+func (s *Server) changeSubscriptionPlan(r *request) {
+	sub := r.subscriptionParam("subscriptionId")
+	if sub == nil {
+		return
+	}
+	var body kbmodel.Subscription
+	if !r.decode(&body) {
+		return
+	}
+	if sub.state(s.now) == kbmodel.SubscriptionStateCANCELLED {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeSubChangeNonActive, "Subscription %s is in state CANCELLED", sub.id)
+		return
+	}
+	if sub.cancelledDate != nil {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeSubChangeFutureCancelled, "Subscription %s is future cancelled", sub.id)
+		return
+	}
+	var billingPeriod string
+	if body.BillingPeriod != nil {
+		billingPeriod = string(*body.BillingPeriod)
+	}
+	p := r.tenant.findPlan(swag.StringValue(body.PlanName), swag.StringValue(body.ProductName), billingPeriod, swag.StringValue(body.PriceList))
+	if p == nil {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeCatNoSuchPlan, "Could not find any plans named '%s'", swag.StringValue(body.PlanName))
+		return
+	}
+	requestedDate, ok := r.queryTime("requestedDate", time.Time{})
+	if !ok {
+		return
+	}
+	effectiveDate := sub.chargedThrough(s.now)
+	if !requestedDate.IsZero() {
+		effectiveDate = s.atDate(requestedDate)
+	} else if r.query("billingPolicy") == "IMMEDIATE" {
+		effectiveDate = s.now
+	}
+
+	// A new change overrides the pending ones.
+	var plans []planChange
+	for i, c := range sub.plans {
+		if i == 0 || c.effectiveDate.Before(effectiveDate) {
+			plans = append(plans, c)
+		}
+	}
+	if !effectiveDate.After(sub.startDate) {
+		plans[0].plan = p
+	} else {
+		plans = append(plans, planChange{plan: p, effectiveDate: effectiveDate})
+	}
+	sub.plans = plans
+	s.audit(r, sub.id, "SUBSCRIPTION", "UPDATE")
+	s.invoiceAccount(r.tenant, r.tenant.account(sub.accountID), s.now)
+	r.noContent()
+}
+
+// PUT /1.0/kb/subscriptions/{subscriptionId}/bcd
+func (s *Server) updateSubscriptionBCD(r *request) {
+	sub := r.subscriptionParam("subscriptionId")
+	if sub == nil {
+		return
+	}
+	var body kbmodel.Subscription
+	if !r.decode(&body) {
+		return
+	}
+	sub.bcd = body.BillCycleDayLocal
+	s.audit(r, sub.id, "SUBSCRIPTION", "UPDATE")
+	r.noContent()
+}
+
+// POST /1.0/kb/subscriptions/{subscriptionId}/block
+func (s *Server) addSubscriptionBlockingState(r *request) {
+	sub := r.subscriptionParam("subscriptionId")
+	if sub == nil {
+		return
+	}
+	var body kbmodel.BlockingState
+	if !r.decode(&body) {
+		return
+	}
+	effectiveDate, ok := r.queryTime("requestedDate", s.now)
+	if !ok {
+		return
+	}
+	body.BlockedID = sub.id
+	body.Type = kbmodel.BlockingStateTypeSUBSCRIPTION
+	body.EffectiveDate = strfmt.DateTime(s.atDate(effectiveDate))
+	body.AuditLogs = nil
+	sub.blockingStates = append(sub.blockingStates, &body)
+	s.invoiceAccount(r.tenant, r.tenant.account(sub.accountID), s.now)
+	r.w.WriteHeader(http.StatusCreated)
+}
+
+// GET /1.0/kb/bundles/{bundleId}
+func (s *Server) getBundle(r *request) {
+	b := r.tenant.bundle(r.uuidParam("bundleId"))
+	if b == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeSubGetInvalidBundleID, "Object id=%s type=BUNDLE doesn't exist!", r.param("bundleId"))
+		return
+	}
+	r.json(http.StatusOK, s.bundleView(r, b))
+}
+
+// GET /1.0/kb/bundles?externalKey=
+func (s *Server) getBundleByKey(r *request) {
+	res := []*kbmodel.Bundle{}
+	for _, b := range r.tenant.bundles {
+		if b.ExternalKey == r.query("externalKey") && (r.queryBool("includedDeleted", false) || s.isActiveBundle(r.tenant, b)) {
+			res = append(res, s.bundleView(r, b))
+		}
+	}
+	if len(res) == 0 {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeSubGetInvalidBundleKey, "Could not find a bundle matching key %s", r.query("externalKey"))
+		return
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/bundles/pagination
+func (s *Server) getBundles(r *request) {
+	from, to := r.page(len(r.tenant.bundles))
+	res := []*kbmodel.Bundle{}
+	for _, b := range r.tenant.bundles[from:to] {
+		res = append(res, s.bundleView(r, b))
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/accounts/{accountId}/bundles
+func (s *Server) getAccountBundles(r *request) {
+	acc := r.accountParam("accountId")
+	if acc == nil {
+		return
+	}
+	filter := map[string]bool{}
+	for _, id := range r.queryList("bundlesFilter") {
+		filter[id] = true
+	}
+	res := []*kbmodel.Bundle{}
+	for _, b := range r.tenant.bundles {
+		if *b.AccountID != acc.AccountID ||
+			(r.query("externalKey") != "" && b.ExternalKey != r.query("externalKey")) ||
+			(len(filter) > 0 && !filter[string(b.BundleID)]) {
+			continue
+		}
+		res = append(res, s.bundleView(r, b))
+	}
+	r.json(http.StatusOK, res)
+}
@@ -0,0 +1,385 @@
+package kbtest
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// Control tags handled by the fake server.
+const (
+	autoPayOffTag         strfmt.UUID = "00000000-0000-0000-0000-000000000001"
+	autoInvoicingOffTag   strfmt.UUID = "00000000-0000-0000-0000-000000000002"
+	manualPayTag          strfmt.UUID = "00000000-0000-0000-0000-000000000005"
+	autoInvoicingDraftTag strfmt.UUID = "00000000-0000-0000-0000-000000000008"
+)
+
+func controlTag(id, name, description string, objectType kbmodel.TagDefinitionApplicableObjectTypesEnum) *kbmodel.TagDefinition {
+	return &kbmodel.TagDefinition{
+		ID:                    strfmt.UUID(id),
+		Name:                  swag.String(name),
+		Description:           swag.String(description),
+		IsControlTag:          true,
+		ApplicableObjectTypes: []kbmodel.TagDefinitionApplicableObjectTypesEnum{objectType},
+	}
+}
+
+// controlTags are the kill bill control tag definitions.
+var controlTags = []*kbmodel.TagDefinition{
+	controlTag("00000000-0000-0000-0000-000000000001", "AUTO_PAY_OFF", "Suspends payments until removed.", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+	controlTag("00000000-0000-0000-0000-000000000002", "AUTO_INVOICING_OFF", "Suspends invoicing until removed.", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+	controlTag("00000000-0000-0000-0000-000000000003", "OVERDUE_ENFORCEMENT_OFF", "Suspends overdue enforcement behaviour until removed.", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+	controlTag("00000000-0000-0000-0000-000000000004", "WRITTEN_OFF", "Indicates that an invoice is written off. No billing or payment effect.", kbmodel.TagDefinitionApplicableObjectTypesINVOICE),
+	controlTag("00000000-0000-0000-0000-000000000005", "MANUAL_PAY", "Indicates that Killbill doesn't process payments for that account (external payments only)", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+	controlTag("00000000-0000-0000-0000-000000000006", "TEST", "Indicates that this is a test account", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+	controlTag("00000000-0000-0000-0000-000000000007", "PARTNER", "Indicates that this is a partner account", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+	controlTag("00000000-0000-0000-0000-000000000008", "AUTO_INVOICING_DRAFT", "Generate account invoices in DRAFT mode.", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+	controlTag("00000000-0000-0000-0000-000000000009", "AUTO_INVOICING_REUSE_DRAFT", "Use existing draft invoice if exists.", kbmodel.TagDefinitionApplicableObjectTypesACCOUNT),
+}
+
+// objectTypes maps the resource path to the object type, for tags and custom fields.
+var objectTypes = map[string]string{
+	"accounts":       "ACCOUNT",
+	"bundles":        "BUNDLE",
+	"subscriptions":  "SUBSCRIPTION",
+	"invoices":       "INVOICE",
+	"invoiceItems":   "INVOICE_ITEM",
+	"payments":       "PAYMENT",
+	"paymentMethods": "PAYMENT_METHOD",
+}
+
+// objectParam returns the type and id of the object designated by the {objects}/{objectId} path.
+// If it doesn't exist, a 404 response is written and ok is false.
+func (r *request) objectParam() (objectType string, id strfmt.UUID, ok bool) {
+	objectType, id = objectTypes[r.param("objects")], r.uuidParam("objectId")
+	t := r.tenant
+	var code kbcommon.ErrorCode
+	switch objectType {
+	case "ACCOUNT":
+		ok, code = t.account(id) != nil, kbcommon.ErrorCodeAccountDoesNotExistForID
+	case "BUNDLE":
+		ok, code = t.bundle(id) != nil, kbcommon.ErrorCodeSubGetInvalidBundleID
+	case "SUBSCRIPTION":
+		ok, code = t.subscription(id) != nil, kbcommon.ErrorCodeSubInvalidSubscriptionID
+	case "INVOICE":
+		ok, code = t.invoice(id) != nil, kbcommon.ErrorCodeInvoiceNotFound
+	case "INVOICE_ITEM":
+		_, item := t.invoiceItem(id)
+		ok, code = item != nil, kbcommon.ErrorCodeInvoiceItemNotFound
+	case "PAYMENT":
+		ok, code = t.payment(id) != nil, kbcommon.ErrorCodePaymentNoSuchPayment
+	case "PAYMENT_METHOD":
+		ok, code = t.paymentMethod(id) != nil, kbcommon.ErrorCodePaymentNoSuchPaymentMethod
+	default:
+		r.error(http.StatusNotImplemented, kbcommon.ErrorCodeNotImplemented, "kbtest: %s %s is not supported", r.Method, r.URL.Path)
+		return "", "", false
+	}
+	if !ok {
+		r.error(http.StatusNotFound, code, "Object id=%s type=%s doesn't exist", id, objectType)
+	}
+	return objectType, id, ok
+}
+
+func (t *tenantData) tagDefinition(id strfmt.UUID) *kbmodel.TagDefinition {
+	for _, td := range append(controlTags, t.tagDefinitions...) {
+		if td.ID == id {
+			return td
+		}
+	}
+	return nil
+}
+
+// hasTag returns true if the object is tagged with the given tag definition.
+func (t *tenantData) hasTag(objectID strfmt.UUID, tagDefinitionID strfmt.UUID) bool {
+	for _, tag := range t.tags {
+		if tag.ObjectID == objectID && tag.TagDefinitionID == tagDefinitionID {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *request) tagView(tag *kbmodel.Tag) *kbmodel.Tag {
+	res := *tag
+	res.AuditLogs = r.auditLogs(tag.TagID)
+	return &res
+}
+
+// GET /1.0/kb/{objects}/{objectId}/tags
+func (s *Server) getObjectTags(r *request) {
+	_, id, ok := r.objectParam()
+	if !ok {
+		return
+	}
+	res := []*kbmodel.Tag{}
+	for _, tag := range r.tenant.tags {
+		if tag.ObjectID == id {
+			res = append(res, r.tagView(tag))
+		}
+	}
+	r.json(http.StatusOK, res)
+}
+
+// POST /1.0/kb/{objects}/{objectId}/tags
+func (s *Server) createObjectTags(r *request) {
+	objectType, id, ok := r.objectParam()
+	if !ok {
+		return
+	}
+	var body []strfmt.UUID
+	if !r.decode(&body) {
+		return
+	}
+	for _, defID := range body {
+		if r.tenant.tagDefinition(defID) == nil {
+			r.error(http.StatusNotFound, kbcommon.ErrorCodeTagDefinitionDoesNotExist, "The tag definition %s does not exist", defID)
+			return
+		}
+	}
+	for _, defID := range body {
+		if r.tenant.hasTag(id, defID) {
+			continue
+		}
+		tag := &kbmodel.Tag{
+			TagID:             newID(),
+			ObjectID:          id,
+			ObjectType:        kbmodel.TagObjectTypeEnum(objectType),
+			TagDefinitionID:   defID,
+			TagDefinitionName: *r.tenant.tagDefinition(defID).Name,
+		}
+		r.tenant.tags = append(r.tenant.tags, tag)
+		s.audit(r, tag.TagID, "TAG", "INSERT")
+	}
+	r.created("/1.0/kb/" + r.param("objects") + "/" + string(id) + "/tags")
+}
+
+// DELETE /1.0/kb/{objects}/{objectId}/tags?tagDef=
+func (s *Server) deleteObjectTags(r *request) {
+	objectType, id, ok := r.objectParam()
+	if !ok {
+		return
+	}
+	remove := map[strfmt.UUID]bool{}
+	for _, defID := range r.queryList("tagDef") {
+		remove[strfmt.UUID(defID)] = true
+	}
+	var kept []*kbmodel.Tag
+	for _, tag := range r.tenant.tags {
+		if tag.ObjectID == id && remove[tag.TagDefinitionID] {
+			s.audit(r, tag.TagID, "TAG", "DELETE")
+			continue
+		}
+		kept = append(kept, tag)
+	}
+	r.tenant.tags = kept
+
+	// Removing those control tags catches up with invoicing and payments.
+	if acc := r.tenant.account(id); objectType == "ACCOUNT" && acc != nil {
+		if remove[autoInvoicingOffTag] {
+			s.invoiceAccount(r.tenant, acc, s.now)
+		}
+		if remove[autoPayOffTag] {
+			for _, inv := range r.tenant.invoices {
+				if inv.AccountID == id {
+					s.autoPay(r.tenant, acc, inv)
+				}
+			}
+		}
+	}
+	r.noContent()
+}
+
+// GET /1.0/kb/tags/pagination
+func (s *Server) getTags(r *request) {
+	from, to := r.page(len(r.tenant.tags))
+	res := []*kbmodel.Tag{}
+	for _, tag := range r.tenant.tags[from:to] {
+		res = append(res, r.tagView(tag))
+	}
+	r.json(http.StatusOK, res)
+}
+
+func (r *request) tagDefinitionView(td *kbmodel.TagDefinition) *kbmodel.TagDefinition {
+	res := *td
+	res.AuditLogs = r.auditLogs(td.ID)
+	return &res
+}
+
+// GET /1.0/kb/tagDefinitions
+func (s *Server) getTagDefinitions(r *request) {
+	res := []*kbmodel.TagDefinition{}
+	for _, td := range append(controlTags, r.tenant.tagDefinitions...) {
+		res = append(res, r.tagDefinitionView(td))
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/tagDefinitions/{tagDefinitionId}
+func (s *Server) getTagDefinition(r *request) {
+	td := r.tenant.tagDefinition(r.uuidParam("tagDefinitionId"))
+	if td == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeTagDefinitionDoesNotExist, "The tag definition %s does not exist", r.param("tagDefinitionId"))
+		return
+	}
+	r.json(http.StatusOK, r.tagDefinitionView(td))
+}
+
+// POST /1.0/kb/tagDefinitions
+func (s *Server) createTagDefinition(r *request) {
+	var body kbmodel.TagDefinition
+	if !r.decode(&body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		r.error(http.StatusBadRequest, 0, "TagDefinition name needs to be set")
+		return
+	}
+	for _, td := range append(controlTags, r.tenant.tagDefinitions...) {
+		if *td.Name != *body.Name {
+			continue
+		}
+		if td.IsControlTag {
+			r.error(http.StatusBadRequest, kbcommon.ErrorCodeTagDefinitionConflictsWithControlTag, "The tag definition name conflicts with a reserved name (name %s)", *body.Name)
+		} else {
+			r.error(http.StatusConflict, kbcommon.ErrorCodeTagDefinitionAlreadyExists, "The tag definition name %s already exists", *body.Name)
+		}
+		return
+	}
+	body.ID, body.IsControlTag, body.AuditLogs = newID(), false, nil
+	r.tenant.tagDefinitions = append(r.tenant.tagDefinitions, &body)
+	s.audit(r, body.ID, "TAG_DEFINITION", "INSERT")
+	r.created("/1.0/kb/tagDefinitions/" + string(body.ID))
+}
+
+// DELETE /1.0/kb/tagDefinitions/{tagDefinitionId}
+func (s *Server) deleteTagDefinition(r *request) {
+	id := r.uuidParam("tagDefinitionId")
+	for i, td := range r.tenant.tagDefinitions {
+		if td.ID != id {
+			continue
+		}
+		for _, tag := range r.tenant.tags {
+			if tag.TagDefinitionID == id {
+				r.error(http.StatusBadRequest, kbcommon.ErrorCodeTagDefinitionInUse, "The tag definition %s is in use", id)
+				return
+			}
+		}
+		r.tenant.tagDefinitions = append(r.tenant.tagDefinitions[:i], r.tenant.tagDefinitions[i+1:]...)
+		s.audit(r, id, "TAG_DEFINITION", "DELETE")
+		r.noContent()
+		return
+	}
+	r.error(http.StatusNotFound, kbcommon.ErrorCodeTagDefinitionDoesNotExist, "The tag definition %s does not exist", id)
+}
+
+func (r *request) customFieldView(cf *kbmodel.CustomField) *kbmodel.CustomField {
+	res := *cf
+	res.AuditLogs = r.auditLogs(cf.CustomFieldID)
+	return &res
+}
+
+// GET /1.0/kb/{objects}/{objectId}/customFields
+func (s *Server) getObjectCustomFields(r *request) {
+	_, id, ok := r.objectParam()
+	if !ok {
+		return
+	}
+	res := []*kbmodel.CustomField{}
+	for _, cf := range r.tenant.customFields {
+		if cf.ObjectID == id {
+			res = append(res, r.customFieldView(cf))
+		}
+	}
+	r.json(http.StatusOK, res)
+}
+
+// POST /1.0/kb/{objects}/{objectId}/customFields
+func (s *Server) createObjectCustomFields(r *request) {
+	objectType, id, ok := r.objectParam()
+	if !ok {
+		return
+	}
+	var body []*kbmodel.CustomField
+	if !r.decode(&body) {
+		return
+	}
+	for _, cf := range body {
+		if cf == nil || cf.Name == nil || cf.Value == nil {
+			r.error(http.StatusBadRequest, 0, "CustomField name and value need to be set")
+			return
+		}
+	}
+	for _, cf := range body {
+		cf.CustomFieldID = newID()
+		cf.ObjectID = id
+		cf.ObjectType = kbmodel.CustomFieldObjectTypeEnum(objectType)
+		cf.AuditLogs = nil
+		r.tenant.customFields = append(r.tenant.customFields, cf)
+		s.audit(r, cf.CustomFieldID, "CUSTOM_FIELD", "INSERT")
+	}
+	r.created("/1.0/kb/" + r.param("objects") + "/" + string(id) + "/customFields")
+}
+
+// PUT /1.0/kb/{objects}/{objectId}/customFields
+func (s *Server) modifyObjectCustomFields(r *request) {
+	_, id, ok := r.objectParam()
+	if !ok {
+		return
+	}
+	var body []*kbmodel.CustomField
+	if !r.decode(&body) {
+		return
+	}
+	for _, update := range body {
+		found := false
+		for _, cf := range r.tenant.customFields {
+			if update != nil && cf.ObjectID == id && cf.CustomFieldID == update.CustomFieldID {
+				cf.Value = update.Value
+				s.audit(r, cf.CustomFieldID, "CUSTOM_FIELD", "UPDATE")
+				found = true
+			}
+		}
+		if !found {
+			r.error(http.StatusNotFound, 0, "The custom field does not exist for object %s", id)
+			return
+		}
+	}
+	r.noContent()
+}
+
+// DELETE /1.0/kb/{objects}/{objectId}/customFields?customField=
+// All the custom fields of the object are deleted if none is specified.
+func (s *Server) deleteObjectCustomFields(r *request) {
+	_, id, ok := r.objectParam()
+	if !ok {
+		return
+	}
+	remove := map[strfmt.UUID]bool{}
+	for _, cfID := range r.queryList("customField") {
+		remove[strfmt.UUID(cfID)] = true
+	}
+	var kept []*kbmodel.CustomField
+	for _, cf := range r.tenant.customFields {
+		if cf.ObjectID == id && (len(remove) == 0 || remove[cf.CustomFieldID]) {
+			s.audit(r, cf.CustomFieldID, "CUSTOM_FIELD", "DELETE")
+			continue
+		}
+		kept = append(kept, cf)
+	}
+	r.tenant.customFields = kept
+	r.noContent()
+}
+
+// GET /1.0/kb/customFields/pagination
+func (s *Server) getCustomFields(r *request) {
+	from, to := r.page(len(r.tenant.customFields))
+	res := []*kbmodel.CustomField{}
+	for _, cf := range r.tenant.customFields[from:to] {
+		res = append(res, r.customFieldView(cf))
+	}
+	r.json(http.StatusOK, res)
+}
@@ -0,0 +1,235 @@
+package kbtest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// tenantData holds all the objects of a tenant, in creation order.
+type tenantData struct {
+	tenant         kbmodel.Tenant
+	catalogs       []*catalog
+	accounts       []*kbmodel.Account
+	paymentMethods []*kbmodel.PaymentMethod
+	bundles        []*kbmodel.Bundle
+	subscriptions  []*subscription
+	invoices       []*kbmodel.Invoice
+	payments       []*payment
+	tagDefinitions []*kbmodel.TagDefinition
+	tags           []*kbmodel.Tag
+	customFields   []*kbmodel.CustomField
+	auditLogs      map[strfmt.UUID][]*kbmodel.AuditLog
+}
+
+func (s *Server) addTenant(apiKey, apiSecret, externalKey string) *tenantData {
+	t := &tenantData{
+		tenant: kbmodel.Tenant{
+			TenantID:    newID(),
+			APIKey:      swag.String(apiKey),
+			APISecret:   swag.String(apiSecret),
+			ExternalKey: externalKey,
+		},
+		auditLogs: map[strfmt.UUID][]*kbmodel.AuditLog{},
+	}
+	s.tenants = append(s.tenants, t)
+	return t
+}
+
+func (s *Server) tenantByAPIKey(apiKey string) *tenantData {
+	for _, t := range s.tenants {
+		if *t.tenant.APIKey == apiKey {
+			return t
+		}
+	}
+	return nil
+}
+
+// audit records an audit log for the given object.
+func (s *Server) audit(r *request, id strfmt.UUID, objectType string, changeType string) {
+	r.tenant.auditLogs[id] = append(r.tenant.auditLogs[id], &kbmodel.AuditLog{
+		ChangeDate: strfmt.DateTime(s.now),
+		ChangeType: changeType,
+		ChangedBy:  r.Header.Get("X-Killbill-CreatedBy"),
+		Comments:   r.Header.Get("X-Killbill-Comment"),
+		ObjectID:   id,
+		ObjectType: kbmodel.AuditLogObjectTypeEnum(objectType),
+		ReasonCode: r.Header.Get("X-Killbill-Reason"),
+		UserToken:  string(newID()),
+	})
+}
+
+// auditLogs returns the audit logs of the given object, for the audit level requested.
+func (r *request) auditLogs(id strfmt.UUID) []*kbmodel.AuditLog {
+	var res []*kbmodel.AuditLog
+	switch r.audit() {
+	case "FULL":
+		res = append(res, r.tenant.auditLogs[id]...)
+	case "MINIMAL":
+		for _, l := range r.tenant.auditLogs[id] {
+			if l.ChangeType == "INSERT" {
+				res = append(res, l)
+			}
+		}
+	}
+	return res
+}
+
+// POST /1.0/kb/tenants
+func (s *Server) createTenant(r *request) {
+	var body kbmodel.Tenant
+	if !r.decode(&body) {
+		return
+	}
+	if body.APIKey == nil || body.APISecret == nil || *body.APIKey == "" || *body.APISecret == "" {
+		r.error(http.StatusBadRequest, kbcommon.ErrorCodeTenantCreationFailed, "apiKey and apiSecret need to be set")
+		return
+	}
+	if s.tenantByAPIKey(*body.APIKey) != nil {
+		r.error(http.StatusConflict, kbcommon.ErrorCodeTenantAlreadyExists, "Tenant already exists for key %s", *body.APIKey)
+		return
+	}
+	t := s.addTenant(*body.APIKey, *body.APISecret, body.ExternalKey)
+	r.created("/1.0/kb/tenants/" + string(t.tenant.TenantID))
+}
+
+// tenantView returns the tenant without its secret.
+func tenantView(t *tenantData) *kbmodel.Tenant {
+	res := t.tenant
+	res.APISecret = nil
+	return &res
+}
+
+// GET /1.0/kb/tenants?apiKey=
+func (s *Server) getTenantByAPIKey(r *request) {
+	t := s.tenantByAPIKey(r.query("apiKey"))
+	if t == nil {
+		r.error(http.StatusNotFound, kbcommon.ErrorCodeTenantDoesNotExistForAPIKey, "Tenant does not exist for api key %s", r.query("apiKey"))
+		return
+	}
+	r.json(http.StatusOK, tenantView(t))
+}
+
+// GET /1.0/kb/tenants/{tenantId}
+func (s *Server) getTenant(r *request) {
+	for _, t := range s.tenants {
+		if t.tenant.TenantID == r.uuidParam("tenantId") {
+			r.json(http.StatusOK, tenantView(t))
+			return
+		}
+	}
+	r.error(http.StatusNotFound, kbcommon.ErrorCodeTenantDoesNotExistForID, "Tenant does not exist for id %s", r.param("tenantId"))
+}
+
+func (s *Server) clockView() *kbmodel.Clock {
+	return &kbmodel.Clock{
+		CurrentUtcTime: strfmt.DateTime(s.now),
+		LocalDate:      strfmt.Date(s.now),
+		TimeZone:       "UTC",
+	}
+}
+
+// GET /1.0/kb/test/clock
+func (s *Server) getClock(r *request) {
+	r.json(http.StatusOK, s.clockView())
+}
+
+// POST /1.0/kb/test/clock?requestedDate=
+func (s *Server) setClockHandler(r *request) {
+	t, ok := r.queryTime("requestedDate", s.now)
+	if !ok {
+		return
+	}
+	s.setClock(t)
+	r.json(http.StatusOK, s.clockView())
+}
+
+// sessionCookie is the name of the kill bill session cookie.
+const sessionCookie = "JSESSIONID"
+
+// GET /1.0/kb/security/subject
+func (s *Server) getCurrentUserSubject(r *request) {
+	var session *kbmodel.Session
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		session = &kbmodel.Session{ID: c.Value}
+	} else {
+		session = &kbmodel.Session{ID: string(newID())}
+		s.sessions = append(s.sessions, session.ID)
+		http.SetCookie(r.w, &http.Cookie{Name: sessionCookie, Value: session.ID, Path: "/", HttpOnly: true})
+	}
+	session.StartDate = strfmt.DateTime(s.now)
+	session.LastAccessDate = strfmt.DateTime(s.now)
+	session.Timeout = int64(time.Hour / time.Millisecond)
+	r.json(http.StatusOK, &kbmodel.Subject{
+		IsAuthenticated: true,
+		Principal:       DefaultUsername,
+		Session:         session,
+	})
+}
+
+// GET /1.0/healthcheck
+func (s *Server) healthcheck(r *request) {
+	if s.outOfRotation {
+		r.json(http.StatusServiceUnavailable, map[string]interface{}{
+			"org.killbill.billing.server.healthchecks.KillbillHealthcheck": map[string]interface{}{
+				"healthy": false,
+				"message": "Node is out of rotation",
+			},
+		})
+		return
+	}
+	r.json(http.StatusOK, map[string]interface{}{
+		"main.pool.ConnectivityCheck": map[string]interface{}{"healthy": true},
+	})
+}
+
+// PUT /1.0/kb/admin/healthcheck
+func (s *Server) putInRotation(r *request) {
+	s.outOfRotation = false
+	r.noContent()
+}
+
+// DELETE /1.0/kb/admin/healthcheck
+func (s *Server) putOutOfRotation(r *request) {
+	s.outOfRotation = true
+	r.noContent()
+}
+
+// GET /1.0/kb/nodesInfo
+func (s *Server) getNodesInfo(r *request) {
+	names := s.nodes
+	if len(names) == 0 {
+		names = []string{strings.Split(s.Host(), ":")[0]}
+	}
+	res := make([]*kbmodel.NodeInfo, 0, len(names))
+	for _, name := range names {
+		res = append(res, &kbmodel.NodeInfo{
+			NodeName:        name,
+			KbVersion:       "kbtest",
+			BootTime:        strfmt.DateTime(s.now),
+			LastUpdatedDate: strfmt.DateTime(s.now),
+			PluginsInfo:     []*kbmodel.PluginInfo{},
+		})
+	}
+	r.json(http.StatusOK, res)
+}
+
+// GET /1.0/kb/admin/queues
+func (s *Server) getQueueEntries(r *request) {
+	res := s.queueEntries
+	if res == nil {
+		res = map[string][]interface{}{"busEvents": {}, "notifications": {}}
+	}
+	r.json(http.StatusOK, res)
+}
+
+// DELETE /1.0/kb/admin/cache/tenants
+func (s *Server) invalidatesCacheByTenant(r *request) {
+	r.noContent()
+}
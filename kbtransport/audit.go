@@ -0,0 +1,33 @@
+package kbtransport
+
+import (
+	"github.com/go-openapi/runtime"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// AuditTransport sends the audit information attached to the call context with
+// kbcommon.WithAuditInfo, on mutating operations. The context values override the
+// X-Killbill-CreatedBy, X-Killbill-Reason and X-Killbill-Comment headers set from
+// the client defaults, so a single client can record the real actor of each change:
+//
+//	client.SetTransport(kbtransport.NewAuditTransport(client.Transport))
+//	ctx = kbcommon.WithAuditInfo(ctx, kbcommon.AuditInfo{CreatedBy: user, Comment: ticket})
+//	client.Account.UpdateAccount(ctx, params)
+type AuditTransport struct {
+	next runtime.ClientTransport
+}
+
+// NewAuditTransport wraps next.
+func NewAuditTransport(next runtime.ClientTransport) *AuditTransport {
+	return &AuditTransport{next: next}
+}
+
+// Submit submits the operation, with the audit headers of its context.
+func (t *AuditTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	info, ok := kbcommon.AuditInfoFromContext(op.Context)
+	if !ok || info.IsZero() || IsSafeMethod(op.Method) || op.Params == nil {
+		return t.next.Submit(op)
+	}
+	return t.next.Submit(withHeaders(op, info.Headers()))
+}
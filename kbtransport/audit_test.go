@@ -0,0 +1,65 @@
+package kbtransport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestAuditTransport(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	client.SetTransport(NewAuditTransport(client.Transport))
+
+	type auditLog struct {
+		ChangedBy, Reason, Comment string
+	}
+	scenarios := []struct {
+		Name     string
+		Info     []kbcommon.AuditInfo
+		Expected auditLog
+	}{
+		{"no audit info", nil, auditLog{ChangedBy: "kbtest"}},
+		{"full audit info", []kbcommon.AuditInfo{{CreatedBy: "jane", Reason: "SUPPORT", Comment: "TICKET-42"}},
+			auditLog{"jane", "SUPPORT", "TICKET-42"}},
+		{"defaults kept", []kbcommon.AuditInfo{{Comment: "TICKET-43"}}, auditLog{ChangedBy: "kbtest", Comment: "TICKET-43"}},
+		{"inherited", []kbcommon.AuditInfo{{CreatedBy: "jane"}, {Comment: "TICKET-44"}}, auditLog{ChangedBy: "jane", Comment: "TICKET-44"}},
+	}
+
+	for _, s := range scenarios {
+		ctx := context.Background()
+		for _, info := range s.Info {
+			ctx = kbcommon.WithAuditInfo(ctx, info)
+		}
+		created, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+			Body:                  &kbmodel.Account{Currency: kbmodel.AccountCurrencyUSD},
+			ProcessLocationHeader: true,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", s.Name, err)
+		}
+		resp, err := client.Account.GetAccount(ctx, &account.GetAccountParams{
+			AccountID: created.Payload.AccountID,
+			Audit:     swag.String("FULL"),
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", s.Name, err)
+		}
+		if len(resp.Payload.AuditLogs) != 1 {
+			t.Fatalf("%s: expecting 1 audit log, got %d", s.Name, len(resp.Payload.AuditLogs))
+		}
+		l := resp.Payload.AuditLogs[0]
+		if diff := cmp.Diff(s.Expected, auditLog{l.ChangedBy, l.ReasonCode, l.Comments}); diff != "" {
+			t.Fatalf("%s: unexpected audit log (-want +got):\n%s", s.Name, diff)
+		}
+	}
+}
@@ -0,0 +1,485 @@
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/healthcheck"
+	"github.com/killbill/kbcli/v3/kbclient/nodes_info"
+	"github.com/killbill/kbcli/v3/kbmodel"
+)
+
+// BalancePolicy selects the node serving a request.
+type BalancePolicy int
+
+const (
+	// RoundRobin sends the requests to the healthy nodes in turn.
+	RoundRobin BalancePolicy = iota
+	// LeastLatency sends the requests to the healthy node with the lowest average latency.
+	LeastLatency
+)
+
+// DefaultHealthCheckInterval - interval of the node healthchecks by default.
+const DefaultHealthCheckInterval = 10 * time.Second
+
+// DefaultEjectionBackoff - delay before an ejected node is tried again by default, when the
+// healthchecks are disabled.
+const DefaultEjectionBackoff = 10 * time.Second
+
+// maxEjectionBackoff - upper bound of the ejection backoff of the nodes failing repeatedly, unless
+// EjectionBackoff is larger.
+const maxEjectionBackoff = 5 * time.Minute
+
+// failoverStatusCodes - statuses of the nodes which can't serve requests, for ex. the proxy of a
+// node down. Requests which can be sent again fail over to the next node.
+var failoverStatusCodes = map[int]bool{
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// BalancerConfig configures Balancer.
+type BalancerConfig struct {
+	// Endpoints - kill bill nodes, in the formats of RuntimeConfig.URL. The path of an endpoint is
+	// the prefix kill bill is served under on that node: it is added to the path of the requests,
+	// so the runtime must not have a base path.
+	Endpoints []string
+
+	// Policy selecting the node of each request. Defaults to RoundRobin.
+	Policy BalancePolicy
+
+	// HealthCheckInterval - interval of the healthchecks. Nodes failing it are ejected until they
+	// pass it again. Defaults to DefaultHealthCheckInterval, negative disables the healthchecks.
+	HealthCheckInterval time.Duration
+
+	// EjectionBackoff - when the healthchecks are disabled, delay after which an ejected node is
+	// tried again. It's back in rotation once it serves a request, and the delay doubles each
+	// time it fails again. Defaults to DefaultEjectionBackoff.
+	EjectionBackoff time.Duration
+
+	// RefreshInterval - interval of the refresh of the nodes from nodes-info. 0 disables the refresh.
+	RefreshInterval time.Duration
+
+	// NodeEndpoint returns the endpoint of a node returned by nodes-info. Defaults to the node
+	// name, with the scheme and port of the first endpoint.
+	NodeEndpoint func(node *kbmodel.NodeInfo) string
+
+	// AuthInfo authenticates the nodes-info requests.
+	AuthInfo runtime.ClientAuthInfoWriter
+
+	// OnStateChange, if set, is called when a node is ejected (err is set) or back in rotation.
+	OnStateChange func(endpoint string, healthy bool, err error)
+}
+
+// NodeStatus - state of a node of a Balancer.
+type NodeStatus struct {
+	Endpoint string
+	Healthy  bool
+	// Latency - moving average of the latency of the requests.
+	Latency time.Duration
+	// Err - error which ejected the node.
+	Err error
+}
+
+// Balancer is an http.RoundTripper spreading the requests across kill bill nodes. Nodes failing
+// a request with a network error, or the healthcheck, are ejected until they pass the
+// healthcheck again, or without healthchecks, until they serve a request once their ejection
+// backoff elapsed. Requests which didn't reach a node, and safe or idempotent (see
+// WithIdempotentRequest) requests, fail over to the next node. Those also fail over on 502, 503
+// and 504 responses, which eject the node. Install it on a runtime without base path:
+//
+//	balancer, err := kbtransport.NewBalancer(trp.Transport, kbtransport.BalancerConfig{
+//		Endpoints:       []string{"kb1:8080", "kb2:8080"},
+//		RefreshInterval: time.Minute,
+//		AuthInfo:        authWriter,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer balancer.Close()
+//	trp.Transport = balancer
+type Balancer struct {
+	next http.RoundTripper
+	conf BalancerConfig
+
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+
+	mu    sync.Mutex
+	nodes []*balancerNode
+	turn  int
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+type balancerNode struct {
+	endpoint     string
+	scheme, host string
+	basePath     string
+
+	healthy bool
+	latency time.Duration
+	err     error
+
+	// ejectedAt - time of the last failure of the node, and failures - number of failures
+	// since it was last healthy. They set the ejection backoff.
+	ejectedAt time.Time
+	failures  int
+}
+
+// latencyWeight - weight of the last request in the latency moving average.
+const latencyWeight = 0.2
+
+// NewBalancer returns a balancer sending the requests through next, or http.DefaultTransport if nil,
+// and starts the healthchecks and the refresh of the nodes. Close stops them.
+func NewBalancer(next http.RoundTripper, conf BalancerConfig) (*Balancer, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if len(conf.Endpoints) == 0 {
+		return nil, errors.New("kbtransport: no kill bill endpoint")
+	}
+	if conf.HealthCheckInterval == 0 {
+		conf.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+	if conf.EjectionBackoff <= 0 {
+		conf.EjectionBackoff = DefaultEjectionBackoff
+	}
+	b := &Balancer{next: next, conf: conf, now: time.Now, done: make(chan struct{})}
+	nodes, err := b.newNodes(conf.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+	b.nodes = nodes
+	if conf.NodeEndpoint == nil {
+		first := nodes[0]
+		_, port, _ := net.SplitHostPort(first.host)
+		b.conf.NodeEndpoint = func(node *kbmodel.NodeInfo) string {
+			host := node.NodeName
+			if port != "" {
+				host = net.JoinHostPort(host, port)
+			}
+			return first.scheme + "://" + host + first.basePath
+		}
+	}
+
+	if conf.HealthCheckInterval > 0 {
+		b.every(conf.HealthCheckInterval, b.CheckHealth)
+	}
+	if conf.RefreshInterval > 0 {
+		b.every(conf.RefreshInterval, func(ctx context.Context) { b.Refresh(ctx) })
+	}
+	return b, nil
+}
+
+func (b *Balancer) newNodes(endpoints []string) ([]*balancerNode, error) {
+	res := make([]*balancerNode, 0, len(endpoints))
+	for _, e := range endpoints {
+		scheme, host, basePath, err := ParseURL(e)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &balancerNode{endpoint: e, scheme: scheme, host: host, basePath: basePath, healthy: true})
+	}
+	return res, nil
+}
+
+// every runs fn at each interval until the balancer is closed.
+func (b *Balancer) every(interval time.Duration, fn func(ctx context.Context)) {
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.done:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				fn(ctx)
+				cancel()
+			}
+		}
+	}()
+}
+
+// Close stops the healthchecks and the refresh of the nodes.
+func (b *Balancer) Close() {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+	b.wg.Wait()
+}
+
+// RoundTrip sends the request to a node, failing over to the other nodes when possible.
+func (b *Balancer) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Read the body, so that it can be sent again to another node.
+	if _, err := readRequestBody(req); err != nil {
+		return nil, err
+	}
+	resend := IsSafeMethod(req.Method) || isIdempotentRequest(req.Context())
+
+	tried := map[*balancerNode]bool{}
+	var lastErr error
+	// lastResp - failover response of the last node, returned if no other node serves the request.
+	var lastResp *http.Response
+	discardLastResp := func() {
+		if lastResp != nil {
+			lastResp.Body.Close()
+			lastResp = nil
+		}
+	}
+	for {
+		n := b.pick(tried)
+		if n == nil {
+			return lastResp, lastErr
+		}
+		tried[n] = true
+
+		nodeReq := req.Clone(req.Context())
+		nodeReq.URL.Scheme = n.scheme
+		nodeReq.URL.Host = n.host
+		nodeReq.Host = n.host
+		if n.basePath != "" {
+			nodeReq.URL.Path = n.basePath + req.URL.Path
+			if req.URL.RawPath != "" {
+				nodeReq.URL.RawPath = n.basePath + req.URL.RawPath
+			}
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				discardLastResp()
+				return nil, err
+			}
+			nodeReq.Body = body
+		}
+
+		start := time.Now()
+		resp, err := b.next.RoundTrip(nodeReq)
+		if err == nil && !(resend && failoverStatusCodes[resp.StatusCode]) {
+			discardLastResp()
+			b.observe(n, time.Since(start))
+			if b.conf.HealthCheckInterval < 0 {
+				// Puts back in rotation a node tried again after its ejection backoff.
+				b.setHealth(n, nil)
+			}
+			return resp, nil
+		}
+		if err == nil {
+			b.setHealth(n, fmt.Errorf("kbtransport: %s returned %s", n.endpoint, resp.Status))
+			discardLastResp()
+			lastResp, lastErr = resp, nil
+			continue
+		}
+		discardLastResp()
+		if req.Context().Err() != nil || !IsRetryableError(err) {
+			return nil, err
+		}
+		b.setHealth(n, err)
+		lastErr = err
+		if !isDialError(err) && !resend {
+			// The request may have been processed: don't send it again.
+			return nil, err
+		}
+	}
+}
+
+// isDialError returns whether err happened while connecting, before the request was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// pick returns the node for the next request among the nodes not tried yet. When all the
+// remaining nodes are ejected, they are tried anyway.
+func (b *Balancer) pick(tried map[*balancerNode]bool) *balancerNode {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	var healthy, ejected []*balancerNode
+	for _, n := range b.nodes {
+		switch {
+		case tried[n]:
+		case n.healthy || b.backoffElapsed(n, now):
+			healthy = append(healthy, n)
+		default:
+			ejected = append(ejected, n)
+		}
+	}
+	candidates := healthy
+	if len(candidates) == 0 {
+		candidates = ejected
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	if b.conf.Policy == LeastLatency {
+		best := candidates[0]
+		for _, n := range candidates[1:] {
+			if n.latency < best.latency {
+				best = n
+			}
+		}
+		return best
+	}
+	b.turn++
+	return candidates[b.turn%len(candidates)]
+}
+
+// backoffElapsed returns whether an ejected node can be tried again, when the healthchecks are
+// disabled. b.mu must be held.
+func (b *Balancer) backoffElapsed(n *balancerNode, now time.Time) bool {
+	if b.conf.HealthCheckInterval >= 0 {
+		return false
+	}
+	backoff := b.conf.EjectionBackoff
+	limit := maxEjectionBackoff
+	if backoff > limit {
+		limit = backoff
+	}
+	for i := 1; i < n.failures && backoff < limit; i++ {
+		backoff *= 2
+	}
+	if backoff > limit {
+		backoff = limit
+	}
+	return now.Sub(n.ejectedAt) >= backoff
+}
+
+func (b *Balancer) observe(n *balancerNode, latency time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if n.latency == 0 {
+		n.latency = latency
+		return
+	}
+	n.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(n.latency))
+}
+
+// setHealth ejects the node if err is set, and puts it back in rotation otherwise.
+func (b *Balancer) setHealth(n *balancerNode, err error) {
+	b.mu.Lock()
+	changed := n.healthy != (err == nil)
+	n.healthy = err == nil
+	n.err = err
+	if err != nil {
+		n.ejectedAt = b.now()
+		n.failures++
+	} else {
+		n.failures = 0
+	}
+	b.mu.Unlock()
+	if changed && b.conf.OnStateChange != nil {
+		b.conf.OnStateChange(n.endpoint, err == nil, err)
+	}
+}
+
+// Nodes returns the state of the nodes, sorted by endpoint.
+func (b *Balancer) Nodes() []NodeStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	res := make([]NodeStatus, 0, len(b.nodes))
+	for _, n := range b.nodes {
+		res = append(res, NodeStatus{Endpoint: n.endpoint, Healthy: n.healthy, Latency: n.latency, Err: n.err})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Endpoint < res[j].Endpoint })
+	return res
+}
+
+// CheckHealth runs the healthcheck of every node, ejecting the nodes failing it and putting
+// back the others in rotation. Nodes out of rotation (see admin.PutOutOfRotation) fail it.
+func (b *Balancer) CheckHealth(ctx context.Context) {
+	b.mu.Lock()
+	nodes := append([]*balancerNode(nil), b.nodes...)
+	b.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *balancerNode) {
+			defer wg.Done()
+			_, err := healthcheck.New(b.nodeRuntime(n), strfmt.Default, nil, kbclient.KillbillDefaults{}).
+				Healthcheck(ctx, &healthcheck.HealthcheckParams{})
+			if err != nil {
+				err = fmt.Errorf("kbtransport: healthcheck of %s failed: %w", n.endpoint, err)
+			}
+			b.setHealth(n, err)
+		}(n)
+	}
+	wg.Wait()
+}
+
+// Refresh replaces the nodes by the ones returned by nodes-info, asked to a healthy node.
+// The state of the nodes already known is kept.
+func (b *Balancer) Refresh(ctx context.Context) error {
+	var lastErr error
+	tried := map[*balancerNode]bool{}
+	for {
+		n := b.pick(tried)
+		if n == nil {
+			return lastErr
+		}
+		tried[n] = true
+		resp, err := nodes_info.New(b.nodeRuntime(n), strfmt.Default, b.conf.AuthInfo, kbclient.KillbillDefaults{}).
+			GetNodesInfo(ctx, &nodes_info.GetNodesInfoParams{})
+		if err != nil {
+			lastErr = fmt.Errorf("kbtransport: failed to get nodes info from %s: %w", n.endpoint, err)
+			continue
+		}
+		return b.setNodes(resp.Payload)
+	}
+}
+
+func (b *Balancer) setNodes(infos []*kbmodel.NodeInfo) error {
+	var endpoints []string
+	for _, info := range infos {
+		if info != nil && info.NodeName != "" {
+			endpoints = append(endpoints, b.conf.NodeEndpoint(info))
+		}
+	}
+	if len(endpoints) == 0 {
+		return errors.New("kbtransport: nodes info returned no node")
+	}
+	nodes, err := b.newNodes(endpoints)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	known := map[string]*balancerNode{}
+	for _, n := range b.nodes {
+		known[n.endpoint] = n
+	}
+	for i, n := range nodes {
+		if k, ok := known[n.endpoint]; ok {
+			nodes[i] = k
+		}
+	}
+	b.nodes = nodes
+	return nil
+}
+
+// nodeRuntime returns a runtime sending the requests of the balancer to the given node.
+func (b *Balancer) nodeRuntime(n *balancerNode) *httptransport.Runtime {
+	trp := httptransport.New(n.host, n.basePath, []string{n.scheme})
+	trp.Transport = b.next
+	return trp
+}
@@ -0,0 +1,268 @@
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+// hostRecorder counts the requests sent to each host.
+type hostRecorder struct {
+	mu    sync.Mutex
+	hosts map[string]int
+}
+
+func (r *hostRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.hosts[req.URL.Host]++
+	r.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (r *hostRecorder) reset() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := r.hosts
+	r.hosts = map[string]int{}
+	return res
+}
+
+func TestBalancer(t *testing.T) {
+	srv1, srv2, down := kbtest.NewServer(), kbtest.NewServer(), kbtest.NewServer()
+	defer srv1.Close()
+	defer srv2.Close()
+	down.Close()
+
+	rec := &hostRecorder{hosts: map[string]int{}}
+	var changes []string
+	b, err := NewBalancer(rec, BalancerConfig{
+		Endpoints:           []string{srv1.Host(), srv2.URL(), down.Host()},
+		HealthCheckInterval: -1,
+		OnStateChange: func(endpoint string, healthy bool, err error) {
+			changes = append(changes, strings.TrimPrefix(endpoint, "http://")+map[bool]string{true: " up", false: " down"}[healthy])
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	client := &http.Client{Transport: b}
+	send := func(n int) map[string]int {
+		rec.reset()
+		for i := 0; i < n; i++ {
+			resp, err := client.Get("http://kb/1.0/healthcheck")
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+		}
+		return rec.reset()
+	}
+
+	// The node down is ejected on first use, and its request fails over.
+	hosts := send(6)
+	if hosts[down.Host()] != 1 || hosts[srv1.Host()] == 0 || hosts[srv2.Host()] == 0 || hosts[srv1.Host()]+hosts[srv2.Host()] != 6 {
+		t.Fatalf("unexpected requests by host %v", hosts)
+	}
+
+	// srv2 is ejected once out of rotation, and comes back.
+	srv2.SetInRotation(false)
+	b.CheckHealth(context.Background())
+	if diff := cmp.Diff(map[string]int{srv1.Host(): 4}, send(4)); diff != "" {
+		t.Fatalf("unexpected requests by host (-want +got):\n%s", diff)
+	}
+	srv2.SetInRotation(true)
+	b.CheckHealth(context.Background())
+	if diff := cmp.Diff(map[string]int{srv1.Host(): 2, srv2.Host(): 2}, send(4)); diff != "" {
+		t.Fatalf("unexpected requests by host (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{down.Host() + " down", srv2.Host() + " down", srv2.Host() + " up"}, changes); diff != "" {
+		t.Fatalf("unexpected state changes (-want +got):\n%s", diff)
+	}
+}
+
+func TestBalancerBasePath(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.Host+" "+r.URL.EscapedPath())
+		mu.Unlock()
+	})
+	srv1, srv2 := httptest.NewServer(handler), httptest.NewServer(handler)
+	defer srv1.Close()
+	defer srv2.Close()
+	host1, host2 := strings.TrimPrefix(srv1.URL, "http://"), strings.TrimPrefix(srv2.URL, "http://")
+
+	b, err := NewBalancer(nil, BalancerConfig{
+		Endpoints:           []string{srv1.URL + "/killbill/", srv2.URL + "/eu/kb"},
+		HealthCheckInterval: -1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	client := &http.Client{Transport: b}
+	for _, u := range []string{"http://kb/1.0/kb/accounts", "http://kb/1.0/kb/accounts", "http://kb/1.0/kb/tags/a%2Fb"} {
+		resp, err := client.Get(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	expected := []string{
+		host2 + " /eu/kb/1.0/kb/accounts",
+		host1 + " /killbill/1.0/kb/accounts",
+		host2 + " /eu/kb/1.0/kb/tags/a%2Fb",
+	}
+	if diff := cmp.Diff(expected, paths); diff != "" {
+		t.Fatalf("unexpected paths (-want +got):\n%s", diff)
+	}
+}
+
+func TestBalancerFailoverStatus(t *testing.T) {
+	var status int32 = http.StatusServiceUnavailable
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer unavailable.Close()
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ok.Close()
+	unavailableHost, okHost := strings.TrimPrefix(unavailable.URL, "http://"), strings.TrimPrefix(ok.URL, "http://")
+
+	rec := &hostRecorder{hosts: map[string]int{}}
+	now := time.Now()
+	var changes []string
+	b, err := NewBalancer(rec, BalancerConfig{
+		Endpoints:           []string{unavailable.URL, ok.URL},
+		HealthCheckInterval: -1,
+		EjectionBackoff:     time.Minute,
+		OnStateChange: func(endpoint string, healthy bool, err error) {
+			changes = append(changes, strings.TrimPrefix(endpoint, "http://")+map[bool]string{true: " up", false: " down"}[healthy])
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	b.now = func() time.Time { return now }
+	client := &http.Client{Transport: b}
+	send := func(method string, n int) (map[string]int, []int) {
+		rec.reset()
+		var statuses []int
+		for i := 0; i < n; i++ {
+			req, _ := http.NewRequest(method, "http://kb/1.0/kb/accounts", nil)
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			statuses = append(statuses, resp.StatusCode)
+		}
+		return rec.reset(), statuses
+	}
+
+	// The unavailable node is ejected by the first GET, which fails over.
+	hosts, statuses := send(http.MethodGet, 4)
+	if diff := cmp.Diff(map[string]int{unavailableHost: 1, okHost: 4}, hosts); diff != "" {
+		t.Fatalf("unexpected requests by host (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{200, 200, 200, 200}, statuses); diff != "" {
+		t.Fatalf("unexpected statuses (-want +got):\n%s", diff)
+	}
+
+	// It's tried again after its ejection backoff, and ejected for twice as long.
+	now = now.Add(time.Minute)
+	if hosts, _ = send(http.MethodGet, 4); hosts[unavailableHost] != 1 {
+		t.Fatalf("expecting the unavailable node to be tried once, got %v", hosts)
+	}
+	now = now.Add(time.Minute)
+	if hosts, _ = send(http.MethodGet, 4); hosts[unavailableHost] != 0 {
+		t.Fatalf("expecting the unavailable node to be ejected, got %v", hosts)
+	}
+	now = now.Add(time.Minute)
+	atomic.StoreInt32(&status, http.StatusOK)
+	if hosts, _ = send(http.MethodGet, 4); hosts[unavailableHost] != 2 {
+		t.Fatalf("expecting the node to be back in rotation, got %v", hosts)
+	}
+	if diff := cmp.Diff([]string{unavailableHost + " down", unavailableHost + " up"}, changes); diff != "" {
+		t.Fatalf("unexpected state changes (-want +got):\n%s", diff)
+	}
+
+	// Requests which can't be sent again don't fail over.
+	atomic.StoreInt32(&status, http.StatusServiceUnavailable)
+	b.setHealth(b.nodes[1], errors.New("down"))
+	hosts, statuses = send(http.MethodPost, 1)
+	if diff := cmp.Diff(map[string]int{unavailableHost: 1}, hosts); diff != "" {
+		t.Fatalf("unexpected requests by host (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{503}, statuses); diff != "" {
+		t.Fatalf("unexpected statuses (-want +got):\n%s", diff)
+	}
+}
+
+func TestBalancerLeastLatency(t *testing.T) {
+	b, err := NewBalancer(nil, BalancerConfig{
+		Endpoints:           []string{"kb1:8080", "kb2:8080"},
+		Policy:              LeastLatency,
+		HealthCheckInterval: -1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	b.observe(b.nodes[0], 50*time.Millisecond)
+	b.observe(b.nodes[1], 10*time.Millisecond)
+	if n := b.pick(nil); n.endpoint != "kb2:8080" {
+		t.Fatalf("expecting the fastest node, got %s", n.endpoint)
+	}
+	b.observe(b.nodes[1], 500*time.Millisecond)
+	if n := b.pick(nil); n.endpoint != "kb1:8080" {
+		t.Fatalf("expecting the fastest node, got %s", n.endpoint)
+	}
+}
+
+func TestBalancerRefresh(t *testing.T) {
+	srv1, srv2 := kbtest.NewServer(), kbtest.NewServer()
+	defer srv1.Close()
+	defer srv2.Close()
+	srv1.SetNodes("kb1", "kb2")
+	hosts := map[string]string{"kb1": srv1.Host(), "kb2": srv2.Host()}
+
+	b, err := NewBalancer(nil, BalancerConfig{
+		Endpoints:           []string{srv1.Host()},
+		HealthCheckInterval: -1,
+		NodeEndpoint:        func(node *kbmodel.NodeInfo) string { return hosts[node.NodeName] },
+		AuthInfo:            httptransport.BasicAuth(kbtest.DefaultUsername, kbtest.DefaultPassword),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	if err := b.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	var endpoints []string
+	for _, n := range b.Nodes() {
+		endpoints = append(endpoints, n.Endpoint)
+	}
+	expected := []string{srv1.Host(), srv2.Host()}
+	if expected[0] > expected[1] {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+	if diff := cmp.Diff(expected, endpoints); diff != "" {
+		t.Fatalf("unexpected nodes (-want +got):\n%s", diff)
+	}
+}
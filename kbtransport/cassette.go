@@ -0,0 +1,381 @@
+package kbtransport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// CassetteMode selects whether a Cassette records or replays interactions.
+type CassetteMode int
+
+const (
+	// CassetteReplay serves the recorded interactions, without any network access.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord forwards requests to the server and records the interactions.
+	CassetteRecord
+)
+
+// RedactedValue replaces the value of redacted headers in fixtures. The cookies of the Cookie and
+// Set-Cookie headers keep their name and attributes, and only their value is replaced.
+const RedactedValue = "REDACTED"
+
+// DefaultRedactedHeaders are the headers redacted from fixtures and debug logs by default. Cookies carry the
+// kill bill session.
+var DefaultRedactedHeaders = []string{"Authorization", "X-KillBill-ApiKey", "X-KillBill-ApiSecret", "Cookie", "Set-Cookie"}
+
+// ErrInteractionNotFound is returned in replay mode when no recorded interaction matches a request.
+var ErrInteractionNotFound = errors.New("kbtransport: no recorded interaction")
+
+// Interaction is a recorded request/response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the recorded part of a request.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the recorded part of a response.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// cassetteFile is the fixture file format.
+type cassetteFile struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Cassette is an http.RoundTripper that records interactions with kill bill into a fixture
+// file, or replays them. It is installed on the transport returned by httptransport.New
+// (or wrapper.RawClient.Trp):
+//
+//	mode := kbtransport.CassetteReplay
+//	if os.Getenv("KB_RECORD") != "" {
+//		mode = kbtransport.CassetteRecord
+//	}
+//	cas, err := kbtransport.NewCassette("testdata/subscription.json", mode, nil)
+//	...
+//	defer cas.Save()
+//	cli.Trp.Transport = cas
+//
+// The fields of RedactFields, and the values of the plugin properties, are redacted from the
+// recorded query and bodies, as DebugTransport does for its logs. Requests are matched on
+// method, path, query and body, once redacted. JSON bodies are normalized, so the field order
+// doesn't matter. Recorded interactions are served in order: if a request is
+// made several times, the recorded responses are returned one after the other, and the
+// last one is repeated once they are exhausted.
+type Cassette struct {
+	path string
+	mode CassetteMode
+	next http.RoundTripper
+
+	// RedactHeaders are the headers whose value is replaced by RedactedValue in the fixture.
+	// Defaults to DefaultRedactedHeaders.
+	RedactHeaders []string
+	// RedactFields are the JSON body fields and query parameters whose value is replaced by
+	// RedactedValue in the fixture. Defaults to DefaultRedactedFields.
+	RedactFields []string
+
+	mu           sync.Mutex
+	redactor     *fieldRedactor
+	interactions []*Interaction
+	// used marks the interactions already replayed.
+	used []bool
+}
+
+// NewCassette returns a cassette for the given fixture file. In replay mode, the file is loaded
+// and next is not used. In record mode, requests are sent through next, or http.DefaultTransport
+// if nil, and the file is written by Save.
+func NewCassette(path string, mode CassetteMode, next http.RoundTripper) (*Cassette, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c := &Cassette{
+		path:          path,
+		mode:          mode,
+		next:          next,
+		RedactHeaders: DefaultRedactedHeaders,
+		RedactFields:  DefaultRedactedFields,
+	}
+	if mode == CassetteReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var f cassetteFile
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, fmt.Errorf("kbtransport: invalid cassette %s: %w", path, err)
+		}
+		c.interactions = f.Interactions
+		c.used = make([]bool, len(f.Interactions))
+	}
+	return c, nil
+}
+
+// Mode returns the mode of the cassette.
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// Interactions returns the recorded interactions.
+func (c *Cassette) Interactions() []*Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*Interaction(nil), c.interactions...)
+}
+
+// Save writes the recorded interactions to the fixture file, creating its directory if needed.
+// It is a no-op in replay mode.
+func (c *Cassette) Save() error {
+	if c.mode != CassetteRecord {
+		return nil
+	}
+	c.mu.Lock()
+	b, err := json.MarshalIndent(&cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, append(b, '\n'), 0644)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.mode == CassetteRecord {
+		return c.record(req, body)
+	}
+	return c.replay(req, body)
+}
+
+func (c *Cassette) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := c.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r := c.fieldRedactor()
+	c.interactions = append(c.interactions, &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  redactQuery(r, req.URL),
+			Header: c.redact(req.Header),
+			Body:   redactBody(r, body),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     c.redact(resp.Header),
+			Body:       redactBody(r, respBody),
+		},
+	})
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The recorded requests are redacted, so is the request to match.
+	r := c.fieldRedactor()
+	query, redactedBody := redactQuery(r, req.URL), redactBody(r, body)
+	found := -1
+	for i, in := range c.interactions {
+		if !matchRequest(&in.Request, req.Method, req.URL.Path, query, redactedBody) {
+			continue
+		}
+		found = i
+		if !c.used[i] {
+			break
+		}
+	}
+	if found < 0 {
+		return nil, fmt.Errorf("%w for %s %s", ErrInteractionNotFound, req.Method, req.URL.RequestURI())
+	}
+	c.used[found] = true
+
+	recorded := c.interactions[found].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header.Clone(),
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// fieldRedactor returns the redactor of RedactFields. c.mu must be held.
+func (c *Cassette) fieldRedactor() *fieldRedactor {
+	if c.redactor == nil {
+		c.redactor = newFieldRedactor(c.RedactFields)
+	}
+	return c.redactor
+}
+
+// redactQuery returns the query of u with the redacted parameters masked.
+func redactQuery(r *fieldRedactor, u *url.URL) string {
+	if u.RawQuery == "" {
+		return ""
+	}
+	return r.query(u.Query()).Encode()
+}
+
+// redactBody returns the body with the redacted fields masked. JSON bodies are re-encoded.
+func redactBody(r *fieldRedactor, b []byte) string {
+	v, ok := decodeJSON(b)
+	if !ok {
+		return r.text(string(b))
+	}
+	res, err := encodeJSON(r.json(v, false))
+	if err != nil {
+		return r.text(string(b))
+	}
+	return res
+}
+
+// redact returns a copy of the headers, with the values of RedactHeaders replaced.
+func (c *Cassette) redact(h http.Header) http.Header {
+	res := h.Clone()
+	for _, name := range c.RedactHeaders {
+		key := http.CanonicalHeaderKey(name)
+		values, ok := res[key]
+		if !ok {
+			continue
+		}
+		switch key {
+		case "Cookie":
+			for i, v := range values {
+				cookies := strings.Split(v, ";")
+				for j, cookie := range cookies {
+					cookies[j] = redactCookie(cookie)
+				}
+				values[i] = strings.Join(cookies, ";")
+			}
+		case "Set-Cookie":
+			for i, v := range values {
+				// The first pair is the cookie, the others are its attributes.
+				cookie, attrs := v, ""
+				if j := strings.Index(v, ";"); j >= 0 {
+					cookie, attrs = v[:j], v[j:]
+				}
+				values[i] = redactCookie(cookie) + attrs
+			}
+		default:
+			res.Set(name, RedactedValue)
+		}
+	}
+	return res
+}
+
+// redactCookie replaces the value of a name=value cookie pair.
+func redactCookie(cookie string) string {
+	i := strings.Index(cookie, "=")
+	if i < 0 {
+		return RedactedValue
+	}
+	return cookie[:i+1] + RedactedValue
+}
+
+// readRequestBody reads the body of the request, and resets it so that it can be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), nil
+	}
+	return b, nil
+}
+
+func matchRequest(recorded *RecordedRequest, method, path, query, body string) bool {
+	if recorded.Method != method || recorded.Path != path {
+		return false
+	}
+	recordedQuery, err := url.ParseQuery(recorded.Query)
+	if err != nil {
+		return false
+	}
+	liveQuery, _ := url.ParseQuery(query)
+	if recordedQuery.Encode() != liveQuery.Encode() {
+		return false
+	}
+	return normalizeBody([]byte(recorded.Body)) == normalizeBody([]byte(body))
+}
+
+// normalizeBody returns a canonical form of JSON bodies, and the trimmed body otherwise.
+func normalizeBody(b []byte) string {
+	if v, ok := decodeJSON(b); ok {
+		if res, err := encodeJSON(v); err == nil {
+			return res
+		}
+	}
+	return string(bytes.TrimSpace(b))
+}
+
+// decodeJSON decodes a JSON body, keeping the numbers as they are written.
+func decodeJSON(b []byte) (interface{}, bool) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return nil, false
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return nil, false
+	}
+	return v, true
+}
+
+// encodeJSON encodes a decoded JSON body, without escaping the HTML characters.
+func encodeJSON(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
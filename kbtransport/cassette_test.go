@@ -0,0 +1,265 @@
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbauth"
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/tenant"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func newCassetteClient(host string, cas *Cassette) *kbclient.KillBill {
+	trp := httptransport.New(host, "", []string{"http"})
+	trp.Transport = cas
+	authWriter := runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		if err := httptransport.BasicAuth(kbtest.DefaultUsername, kbtest.DefaultPassword).AuthenticateRequest(r, nil); err != nil {
+			return err
+		}
+		if err := r.SetHeaderParam("X-KillBill-ApiKey", "bob"); err != nil {
+			return err
+		}
+		return r.SetHeaderParam("X-KillBill-ApiSecret", "lazar")
+	})
+	createdBy := "test"
+	return kbclient.New(trp, strfmt.Default, authWriter, kbclient.KillbillDefaults{CreatedBy: &createdBy})
+}
+
+// cassetteScenario creates an account, and returns it along with the result of a lookup by key.
+func cassetteScenario(client *kbclient.KillBill) (created *kbmodel.Account, byKey *kbmodel.Account, err error) {
+	ctx := context.Background()
+	resp, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+		Body:                  &kbmodel.Account{ExternalKey: "cassette", Currency: kbmodel.AccountCurrencyUSD, Name: "John"},
+		ProcessLocationHeader: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	getResp, err := client.Account.GetAccountByKey(ctx, &account.GetAccountByKeyParams{ExternalKey: "cassette"})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Payload, getResp.Payload, nil
+}
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures", "account.json")
+
+	// Record against the fake server.
+	srv := kbtest.NewServer()
+	srv.AddTenant("bob", "lazar")
+	rec, err := NewCassette(path, CassetteRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded, recordedByKey, err := cassetteScenario(newCassetteClient(srv.Host(), rec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newCassetteClient(srv.Host(), rec).Account.GetAccountByKey(context.Background(),
+		&account.GetAccountByKeyParams{ExternalKey: "unknown"}); !errors.Is(err, kbcommon.ErrAccountNotFound) {
+		t.Fatalf("expecting ErrAccountNotFound, got %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	fixture, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"lazar", "\"bob\"", "Basic "} {
+		if strings.Contains(string(fixture), secret) {
+			t.Fatalf("fixture contains %s:\n%s", secret, fixture)
+		}
+	}
+	if len(rec.Interactions()) != 4 {
+		t.Fatalf("expecting 4 interactions, got %d", len(rec.Interactions()))
+	}
+
+	// Replay, without any server.
+	rep, err := NewCassette(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newCassetteClient("127.0.0.1:1", rep)
+	replayed, replayedByKey, err := cassetteScenario(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(recorded, replayed); diff != "" {
+		t.Fatalf("unexpected account (-recorded +replayed):\n%s", diff)
+	}
+	if diff := cmp.Diff(recordedByKey, replayedByKey); diff != "" {
+		t.Fatalf("unexpected account (-recorded +replayed):\n%s", diff)
+	}
+	if _, err := client.Account.GetAccountByKey(context.Background(),
+		&account.GetAccountByKeyParams{ExternalKey: "unknown"}); !errors.Is(err, kbcommon.ErrAccountNotFound) {
+		t.Fatalf("expecting ErrAccountNotFound, got %v", err)
+	}
+	if _, err := client.Account.GetAccountByKey(context.Background(),
+		&account.GetAccountByKeyParams{ExternalKey: "other"}); !errors.Is(err, ErrInteractionNotFound) {
+		t.Fatalf("expecting ErrInteractionNotFound, got %v", err)
+	}
+	// Requests with a different body don't match.
+	if _, err := client.Account.CreateAccount(context.Background(), &account.CreateAccountParams{
+		Body: &kbmodel.Account{ExternalKey: "cassette", Currency: kbmodel.AccountCurrencyEUR},
+	}); !errors.Is(err, ErrInteractionNotFound) {
+		t.Fatalf("expecting ErrInteractionNotFound, got %v", err)
+	}
+}
+
+func TestCassette_Session(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+	newSessionClient := func(host string, cas *Cassette) (*kbclient.KillBill, *kbauth.Session) {
+		trp := httptransport.New(host, "", []string{"http"})
+		trp.Transport = cas
+		session := kbauth.NewSession(kbtest.DefaultUsername, kbtest.DefaultPassword)
+		client := kbclient.New(trp, strfmt.Default, kbauth.WithTenant(session, "bob", "lazar"), kbclient.KillbillDefaults{})
+		client.SetTransport(kbauth.NewTransport(client.Transport))
+		return client, session
+	}
+	getAccount := func(client *kbclient.KillBill) error {
+		_, err := client.Account.GetAccountByKey(context.Background(), &account.GetAccountByKeyParams{ExternalKey: "john"})
+		return err
+	}
+
+	// Record a session login, and a call using the session.
+	srv := kbtest.NewServer()
+	srv.AddTenant("bob", "lazar")
+	rec, err := NewCassette(path, CassetteRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, session := newSessionClient(srv.Host(), rec)
+	if err := getAccount(client); !errors.Is(err, kbcommon.ErrAccountNotFound) {
+		t.Fatalf("expecting ErrAccountNotFound, got %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	fixture, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cookie := kbauth.SessionCookie + "=" + session.Current().ID; strings.Contains(string(fixture), cookie) {
+		t.Fatalf("fixture contains the session cookie:\n%s", fixture)
+	}
+	interactions := rec.Interactions()
+	if len(interactions) != 2 {
+		t.Fatalf("expecting 2 interactions, got %d", len(interactions))
+	}
+	for _, s := range []struct {
+		header http.Header
+		name   string
+	}{
+		{interactions[0].Response.Header, "Set-Cookie"},
+		{interactions[1].Request.Header, "Cookie"},
+	} {
+		if v := s.header.Get(s.name); !strings.HasPrefix(v, kbauth.SessionCookie+"="+RedactedValue) {
+			t.Fatalf("expecting a redacted %s, got %q", s.name, v)
+		}
+	}
+
+	// The login is replayed with the redacted session.
+	rep, err := NewCassette(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, session = newSessionClient("127.0.0.1:1", rep)
+	if err := getAccount(client); !errors.Is(err, kbcommon.ErrAccountNotFound) {
+		t.Fatalf("expecting ErrAccountNotFound, got %v", err)
+	}
+	if session.Current() == nil || session.Current().ID != RedactedValue {
+		t.Fatalf("expecting the redacted session, got %+v", session.Current())
+	}
+}
+
+func TestCassette_RedactBodies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tenant.json")
+	createTenant := func(client *kbclient.KillBill) (*kbmodel.Tenant, error) {
+		apiKey, apiSecret := "alice", "s3cr3t-alice"
+		resp, err := client.Tenant.CreateTenant(context.Background(), &tenant.CreateTenantParams{
+			Body:                  &kbmodel.Tenant{APIKey: &apiKey, APISecret: &apiSecret},
+			ProcessLocationHeader: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Payload, nil
+	}
+
+	// Record a tenant creation.
+	srv := kbtest.NewServer()
+	srv.AddTenant("bob", "lazar")
+	rec, err := NewCassette(path, CassetteRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded, err := createTenant(newCassetteClient(srv.Host(), rec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	fixture, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(fixture), "s3cr3t-alice") {
+		t.Fatalf("fixture contains the tenant secret:\n%s", fixture)
+	}
+	if body := rec.Interactions()[0].Request.Body; !strings.Contains(body, `"apiSecret":"`+RedactedValue+`"`) {
+		t.Fatalf("expecting a redacted apiSecret, got %s", body)
+	}
+
+	// The creation is replayed from the redacted body.
+	rep, err := NewCassette(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := createTenant(newCassetteClient("127.0.0.1:1", rep))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(recorded.TenantID, replayed.TenantID); diff != "" {
+		t.Fatalf("unexpected tenant (-recorded +replayed):\n%s", diff)
+	}
+}
+
+func TestNormalizeBody(t *testing.T) {
+	scenarios := []struct {
+		A, B  string
+		Equal bool
+	}{
+		{`{"a":1,"b":"x"}`, ` { "b": "x", "a": 1 }`, true},
+		{`{"a":1}`, `{"a":2}`, false},
+		{`<catalog/>`, "<catalog/>\n", true},
+		{``, ``, true},
+	}
+	for _, s := range scenarios {
+		if got := normalizeBody([]byte(s.A)) == normalizeBody([]byte(s.B)); got != s.Equal {
+			t.Fatalf("%q vs %q: expecting %v", s.A, s.B, s.Equal)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package kbtransport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DebugFormat selects the format of the debug logs.
+type DebugFormat string
+
+const (
+	// DebugText logs each exchange as readable text, with indented JSON bodies.
+	DebugText DebugFormat = "text"
+	// DebugJSON logs each exchange as a single JSON line.
+	DebugJSON DebugFormat = "json"
+)
+
+// DefaultMaxDebugBodySize - number of bytes of the bodies logged by default.
+const DefaultMaxDebugBodySize = 4096
+
+// DefaultRedactedFields are the JSON body fields and query parameters redacted from the debug logs
+// and the cassette fixtures by default. Names are case insensitive.
+var DefaultRedactedFields = []string{"password", "apiKey", "apiSecret", "ccNumber", "ccVerificationValue", "token"}
+
+// pluginPropertyFields are the JSON body fields holding plugin properties, whose values are redacted.
+var pluginPropertyFields = map[string]bool{
+	"properties":                    true,
+	"pluginProperties":              true,
+	"paymentMethodPluginProperties": true,
+	"transactionPluginProperties":   true,
+	"formFields":                    true,
+}
+
+// pluginPropertyParam - query parameter holding key=value plugin properties.
+const pluginPropertyParam = "pluginProperty"
+
+// DebugLogger receives the debug logs. cmdlib.Logger implements it.
+type DebugLogger interface {
+	Infof(format string, args ...interface{})
+}
+
+// stdLogger logs with the standard log package.
+type stdLogger struct{}
+
+func (stdLogger) Infof(format string, args ...interface{}) {
+	log.Printf(format, args...)
+}
+
+// DebugOptions configures DebugTransport.
+type DebugOptions struct {
+	// Format of the logs. Defaults to DebugText.
+	Format DebugFormat
+
+	// MaxBodySize - number of bytes of the bodies logged, the rest is truncated.
+	// Defaults to DefaultMaxDebugBodySize, negative logs the full bodies.
+	MaxBodySize int
+
+	// RedactHeaders - headers whose values are replaced by RedactedValue. Defaults to DefaultRedactedHeaders.
+	RedactHeaders []string
+
+	// RedactFields - JSON body fields and query parameters whose values are replaced by
+	// RedactedValue. Defaults to DefaultRedactedFields. The values of plugin properties are
+	// always redacted, since payment plugins use them to pass card details and credentials.
+	RedactFields []string
+
+	// Logger receives the logs. Defaults to the standard log package.
+	Logger DebugLogger
+}
+
+// DebugTransport is an http.RoundTripper logging the requests and responses, without their credentials.
+// It replaces the Debug flag of httptransport.Runtime, which dumps the Authorization and
+// api secret headers:
+//
+//	trp.Transport = kbtransport.NewDebugTransport(trp.Transport, kbtransport.DebugOptions{Format: kbtransport.DebugJSON})
+type DebugTransport struct {
+	next http.RoundTripper
+	opts DebugOptions
+
+	redactHeaders map[string]bool
+	redactor      *fieldRedactor
+}
+
+// NewDebugTransport wraps next, or http.DefaultTransport if nil.
+func NewDebugTransport(next http.RoundTripper, opts DebugOptions) *DebugTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if opts.Format == "" {
+		opts.Format = DebugText
+	}
+	if opts.MaxBodySize == 0 {
+		opts.MaxBodySize = DefaultMaxDebugBodySize
+	}
+	if opts.RedactHeaders == nil {
+		opts.RedactHeaders = DefaultRedactedHeaders
+	}
+	if opts.RedactFields == nil {
+		opts.RedactFields = DefaultRedactedFields
+	}
+	if opts.Logger == nil {
+		opts.Logger = stdLogger{}
+	}
+
+	t := &DebugTransport{
+		next:          next,
+		opts:          opts,
+		redactHeaders: map[string]bool{},
+		redactor:      newFieldRedactor(opts.RedactFields),
+	}
+	for _, h := range opts.RedactHeaders {
+		t.redactHeaders[http.CanonicalHeaderKey(h)] = true
+	}
+	return t
+}
+
+// DebugExchange - logged request and response. It's the format of DebugJSON lines.
+type DebugExchange struct {
+	Time            time.Time         `json:"time"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	RequestHeaders  map[string]string `json:"requestHeaders,omitempty"`
+	RequestBody     string            `json:"requestBody,omitempty"`
+	StatusCode      int               `json:"status,omitempty"`
+	ResponseHeaders map[string]string `json:"responseHeaders,omitempty"`
+	ResponseBody    string            `json:"responseBody,omitempty"`
+	DurationMs      float64           `json:"durationMs"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// RoundTrip sends the request, and logs the exchange.
+func (t *DebugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	ex := DebugExchange{
+		Time:           time.Now(),
+		Method:         req.Method,
+		URL:            t.redactURL(req.URL),
+		RequestHeaders: t.headers(req.Header),
+		RequestBody:    t.body(reqBody, req.Header.Get("Content-Type")),
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	ex.DurationMs = float64(time.Since(ex.Time).Microseconds()) / 1000
+	if err != nil {
+		ex.Error = err.Error()
+		t.log(ex)
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	ex.StatusCode = resp.StatusCode
+	ex.ResponseHeaders = t.headers(resp.Header)
+	ex.ResponseBody = t.body(respBody, resp.Header.Get("Content-Type"))
+	if err != nil {
+		ex.Error = err.Error()
+		t.log(ex)
+		return nil, err
+	}
+	t.log(ex)
+	return resp, nil
+}
+
+func (t *DebugTransport) log(ex DebugExchange) {
+	if t.opts.Format == DebugJSON {
+		b, err := json.Marshal(ex)
+		if err != nil {
+			t.opts.Logger.Infof("kbtransport: failed to encode debug log: %v", err)
+			return
+		}
+		t.opts.Logger.Infof("%s", b)
+		return
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "> %s %s\n", ex.Method, ex.URL)
+	writeDebugHeaders(&sb, "> ", ex.RequestHeaders)
+	if ex.RequestBody != "" {
+		fmt.Fprintf(&sb, ">\n%s\n", ex.RequestBody)
+	}
+	if ex.Error != "" {
+		fmt.Fprintf(&sb, "< error after %.1fms: %s", ex.DurationMs, ex.Error)
+		t.opts.Logger.Infof("%s", sb.String())
+		return
+	}
+	fmt.Fprintf(&sb, "< %d %s (%.1fms)\n", ex.StatusCode, http.StatusText(ex.StatusCode), ex.DurationMs)
+	writeDebugHeaders(&sb, "< ", ex.ResponseHeaders)
+	if ex.ResponseBody != "" {
+		fmt.Fprintf(&sb, "<\n%s\n", ex.ResponseBody)
+	}
+	t.opts.Logger.Infof("%s", strings.TrimSuffix(sb.String(), "\n"))
+}
+
+func writeDebugHeaders(sb *strings.Builder, prefix string, headers map[string]string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(sb, "%s%s: %s\n", prefix, name, headers[name])
+	}
+}
+
+// headers returns the redacted headers, with multiple values joined.
+func (t *DebugTransport) headers(h http.Header) map[string]string {
+	if len(h) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(h))
+	for name, values := range h {
+		if t.redactHeaders[http.CanonicalHeaderKey(name)] {
+			res[name] = RedactedValue
+			continue
+		}
+		res[name] = strings.Join(values, ", ")
+	}
+	return res
+}
+
+// redactURL returns the url with the redacted query parameters, and the values of the plugin properties, masked.
+func (t *DebugTransport) redactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	res := *u
+	res.RawQuery = t.redactor.query(u.Query()).Encode()
+	return res.String()
+}
+
+// body returns the redacted and truncated body.
+func (t *DebugTransport) body(b []byte, contentType string) string {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return ""
+	}
+
+	var res string
+	var v interface{}
+	var isJSON bool
+	if strings.Contains(contentType, "json") {
+		// Numbers are kept as they are written, for ex. amounts with their scale.
+		v, isJSON = decodeJSON(b)
+	}
+	if isJSON {
+		v = t.redactor.json(v, false)
+		var out []byte
+		if t.opts.Format == DebugJSON {
+			out, _ = json.Marshal(v)
+		} else {
+			out, _ = json.MarshalIndent(v, "", "  ")
+		}
+		res = string(out)
+	} else {
+		res = t.redactor.text(string(b))
+	}
+
+	if t.opts.MaxBodySize > 0 && len(res) > t.opts.MaxBodySize {
+		// Don't split a multi-byte character.
+		end := t.opts.MaxBodySize
+		for end > 0 && !utf8.RuneStart(res[end]) {
+			end--
+		}
+		res = fmt.Sprintf("%s... (truncated, %d bytes)", res[:end], len(res))
+	}
+	return res
+}
+
+// fieldRedactor masks the redacted fields of the query parameters and of the bodies, and the
+// values of the plugin properties. It is shared by DebugTransport and Cassette.
+type fieldRedactor struct {
+	fields map[string]bool
+	// fieldsRegexp is used for the bodies which can't be parsed, for ex. truncated JSON.
+	fieldsRegexp *regexp.Regexp
+}
+
+func newFieldRedactor(fields []string) *fieldRedactor {
+	r := &fieldRedactor{fields: map[string]bool{}}
+	var quoted []string
+	for _, f := range fields {
+		r.fields[strings.ToLower(f)] = true
+		quoted = append(quoted, regexp.QuoteMeta(f))
+	}
+	if len(quoted) > 0 {
+		r.fieldsRegexp = regexp.MustCompile(`(?i)("(?:` + strings.Join(quoted, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	}
+	return r
+}
+
+// query masks the redacted query parameters, and the values of the plugin properties, in place.
+func (r *fieldRedactor) query(query url.Values) url.Values {
+	for name, values := range query {
+		switch {
+		case name == pluginPropertyParam:
+			for i, v := range values {
+				if idx := strings.Index(v, "="); idx >= 0 {
+					values[i] = v[:idx+1] + RedactedValue
+				} else {
+					values[i] = RedactedValue
+				}
+			}
+		case r.fields[strings.ToLower(name)]:
+			for i := range values {
+				values[i] = RedactedValue
+			}
+		}
+	}
+	return query
+}
+
+// json masks the redacted fields of a decoded JSON value. inProperties is set for the
+// elements of plugin properties lists.
+func (r *fieldRedactor) json(v interface{}, inProperties bool) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range val {
+			switch {
+			case r.fields[strings.ToLower(k)]:
+				val[k] = RedactedValue
+			case inProperties && k == "value":
+				val[k] = RedactedValue
+			default:
+				val[k] = r.json(fv, pluginPropertyFields[k])
+			}
+		}
+	case []interface{}:
+		for i, e := range val {
+			val[i] = r.json(e, inProperties)
+		}
+	}
+	return v
+}
+
+// text masks the string values of the redacted fields of a body which isn't valid JSON.
+func (r *fieldRedactor) text(s string) string {
+	if r.fieldsRegexp == nil {
+		return s
+	}
+	return r.fieldsRegexp.ReplaceAllString(s, `$1"`+RedactedValue+`"`)
+}
@@ -0,0 +1,115 @@
+package kbtransport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// logRecorder records the debug logs.
+type logRecorder struct {
+	logs []string
+}
+
+func (r *logRecorder) Infof(format string, args ...interface{}) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+func TestDebugTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		w.Header().Set("Content-Type", req.Header.Get("Content-Type"))
+		w.Header().Set("Set-Cookie", "JSESSIONID=session")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	const body = `{"pluginName":"stripe","pluginInfo":{"properties":[{"key":"ccNumber","value":"4242424242424242"}]},"password":"hunter2"}`
+	send := func(opts DebugOptions, contentType, body string) *logRecorder {
+		rec := &logRecorder{}
+		opts.Logger = rec
+		client := &http.Client{Transport: NewDebugTransport(nil, opts)}
+		req, _ := http.NewRequest(http.MethodPost, srv.URL+"/1.0/kb/accounts/a/paymentMethods?pluginProperty=token%3Dtok_1&apiSecret=lazar", strings.NewReader(body))
+		req.SetBasicAuth("admin", "password")
+		req.Header.Set("X-KillBill-ApiSecret", "lazar")
+		req.Header.Set("Content-Type", contentType)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		received, _ := ioutil.ReadAll(resp.Body)
+		if string(received) != body {
+			t.Fatalf("response body was altered: %s", received)
+		}
+		if len(rec.logs) != 1 {
+			t.Fatalf("expecting a log per exchange, got %v", rec.logs)
+		}
+		for _, secret := range []string{"4242424242424242", "hunter2", "lazar", "tok_1", "JSESSIONID", "Basic "} {
+			if strings.Contains(rec.logs[0], secret) {
+				t.Fatalf("%q leaked in:\n%s", secret, rec.logs[0])
+			}
+		}
+		return rec
+	}
+
+	// Text.
+	rec := send(DebugOptions{}, "application/json", body)
+	for _, line := range []string{
+		"> POST " + srv.URL + "/1.0/kb/accounts/a/paymentMethods?apiSecret=REDACTED&pluginProperty=token%3DREDACTED",
+		"> Authorization: REDACTED",
+		"> X-Killbill-Apisecret: REDACTED",
+		`        "key": "ccNumber",`,
+		`        "value": "REDACTED"`,
+		`  "password": "REDACTED",`,
+		"< 201 Created (",
+		"< Set-Cookie: REDACTED",
+	} {
+		if !strings.Contains(rec.logs[0], line) {
+			t.Fatalf("missing %q in:\n%s", line, rec.logs[0])
+		}
+	}
+
+	// JSON lines.
+	rec = send(DebugOptions{Format: DebugJSON}, "application/json", body)
+	var ex DebugExchange
+	if err := json.Unmarshal([]byte(rec.logs[0]), &ex); err != nil {
+		t.Fatalf("invalid json line %s: %v", rec.logs[0], err)
+	}
+	expected := `{"password":"REDACTED","pluginInfo":{"properties":[{"key":"ccNumber","value":"REDACTED"}]},"pluginName":"stripe"}`
+	if diff := cmp.Diff([]string{expected, expected}, []string{ex.RequestBody, ex.ResponseBody}); diff != "" {
+		t.Fatalf("unexpected bodies (-want +got):\n%s", diff)
+	}
+	if ex.StatusCode != http.StatusCreated || ex.RequestHeaders["Authorization"] != RedactedValue {
+		t.Fatalf("unexpected exchange %+v", ex)
+	}
+
+	// Numbers are logged as received.
+	rec = send(DebugOptions{Format: DebugJSON}, "application/json", `{"amount":10.50,"recordId":12345678901234567890}`)
+	if err := json.Unmarshal([]byte(rec.logs[0]), &ex); err != nil {
+		t.Fatalf("invalid json line %s: %v", rec.logs[0], err)
+	}
+	if expected := `{"amount":10.50,"recordId":12345678901234567890}`; ex.RequestBody != expected {
+		t.Fatalf("unexpected body %s, expecting %s", ex.RequestBody, expected)
+	}
+
+	// Truncated bodies.
+	xml := "<catalogs>" + strings.Repeat("<catalog/>", 100) + "</catalogs>"
+	rec = send(DebugOptions{MaxBodySize: 20}, "text/xml", xml)
+	if !strings.Contains(rec.logs[0], "<catalogs><catalog/>... (truncated, 1021 bytes)") {
+		t.Fatalf("body not truncated:\n%s", rec.logs[0])
+	}
+	// Multi-byte characters are not split.
+	rec = send(DebugOptions{MaxBodySize: 5}, "text/plain", strings.Repeat("é", 10))
+	if !strings.Contains(rec.logs[0], "éé... (truncated, 20 bytes)") || !utf8.ValidString(rec.logs[0]) {
+		t.Fatalf("body not truncated on a character:\n%s", rec.logs[0])
+	}
+}
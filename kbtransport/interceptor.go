@@ -0,0 +1,121 @@
+package kbtransport
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// Invoker submits an operation to the rest of the chain.
+type Invoker func(op *runtime.ClientOperation) (interface{}, error)
+
+// Interceptor intercepts the operations submitted through an InterceptorTransport. It can
+// modify the operation, and must call next to submit it:
+//
+//	func logCalls(op *runtime.ClientOperation, next kbtransport.Invoker) (interface{}, error) {
+//		start := time.Now()
+//		result, err := next(op)
+//		log.Println(kbtransport.NewCall(op, result, err, start))
+//		return result, err
+//	}
+type Interceptor func(op *runtime.ClientOperation, next Invoker) (interface{}, error)
+
+// InterceptorTransport submits the operations through a chain of interceptors.
+type InterceptorTransport struct {
+	next         runtime.ClientTransport
+	interceptors []Interceptor
+}
+
+// NewInterceptorTransport wraps next. Interceptors are called in order: the first one is the outermost.
+func NewInterceptorTransport(next runtime.ClientTransport, interceptors ...Interceptor) *InterceptorTransport {
+	return &InterceptorTransport{next: next, interceptors: interceptors}
+}
+
+// Submit submits the operation through the interceptors.
+func (t *InterceptorTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	return t.invoke(0, op)
+}
+
+func (t *InterceptorTransport) invoke(i int, op *runtime.ClientOperation) (interface{}, error) {
+	if i == len(t.interceptors) {
+		return t.next.Submit(op)
+	}
+	return t.interceptors[i](op, func(op *runtime.ClientOperation) (interface{}, error) {
+		return t.invoke(i+1, op)
+	})
+}
+
+// Call describes a completed operation.
+type Call struct {
+	// OperationID - id of the operation, for ex. getAccount.
+	OperationID string
+
+	// Method and PathPattern of the operation, for ex. GET /1.0/kb/accounts/{accountId}.
+	Method      string
+	PathPattern string
+
+	// StatusCode - http status code of the response, 0 if no response was received.
+	StatusCode int
+
+	// Duration of the call.
+	Duration time.Duration
+
+	// ErrorCode - kill bill error code, 0 if the call succeeded or the error has no code.
+	ErrorCode kbcommon.ErrorCode
+
+	// Err - error returned by the call.
+	Err error
+}
+
+// NewCall returns the description of a call started at start.
+func NewCall(op *runtime.ClientOperation, result interface{}, err error, start time.Time) Call {
+	c := Call{
+		OperationID: op.ID,
+		Method:      op.Method,
+		PathPattern: op.PathPattern,
+		Duration:    time.Since(start),
+		Err:         err,
+	}
+	var kbErr *kbcommon.KillbillError
+	var apiErr *runtime.APIError
+	switch {
+	case err == nil:
+		c.StatusCode = statusCode(result)
+	case errors.As(err, &kbErr):
+		c.StatusCode = kbErr.HTTPCode
+		c.ErrorCode = kbErr.ErrorCode()
+	case errors.As(err, &apiErr):
+		c.StatusCode = apiErr.Code
+	}
+	return c
+}
+
+// statusCode returns the status code of a generated response, for ex. *account.GetAccountOK.
+func statusCode(result interface{}) int {
+	val := reflect.ValueOf(result)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return 0
+	}
+	f := val.Elem().FieldByName("HttpResponse")
+	if !f.IsValid() || f.Kind() != reflect.Interface || f.IsNil() {
+		return 0
+	}
+	if resp, ok := f.Interface().(runtime.ClientResponse); ok {
+		return resp.Code()
+	}
+	return 0
+}
+
+// Observe returns an interceptor calling fn after each operation.
+func Observe(fn func(op *runtime.ClientOperation, call Call)) Interceptor {
+	return func(op *runtime.ClientOperation, next Invoker) (interface{}, error) {
+		start := time.Now()
+		result, err := next(op)
+		fn(op, NewCall(op, result, err, start))
+		return result, err
+	}
+}
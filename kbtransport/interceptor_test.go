@@ -0,0 +1,152 @@
+package kbtransport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestInterceptorTransport(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(op *runtime.ClientOperation, next Invoker) (interface{}, error) {
+			calls = append(calls, name+" before "+op.ID)
+			result, err := next(op)
+			calls = append(calls, name+" after "+op.ID)
+			return result, err
+		}
+	}
+	client.SetTransport(NewInterceptorTransport(client.Transport, record("first"), record("second")))
+
+	if _, err := client.Account.GetAccounts(context.Background(), &account.GetAccountsParams{}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"first before getAccounts", "second before getAccounts", "second after getAccounts", "first after getAccounts"}
+	if diff := cmp.Diff(expected, calls); diff != "" {
+		t.Fatalf("unexpected calls (-want +got):\n%s", diff)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	metrics := NewMetrics()
+	client.SetTransport(NewInterceptorTransport(client.Transport, metrics.Interceptor()))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Account.GetAccounts(ctx, &account.GetAccountsParams{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.Account.GetAccountByKey(ctx, &account.GetAccountByKeyParams{ExternalKey: "john"}); err == nil {
+		t.Fatalf("expecting account not found error")
+	}
+
+	type opMetrics struct {
+		OperationID, Method string
+		Count, Errors       int64
+		StatusCodes         map[int]int64
+		ErrorCodes          map[kbcommon.ErrorCode]int64
+	}
+	var got []opMetrics
+	for _, om := range metrics.Snapshot() {
+		got = append(got, opMetrics{om.OperationID, om.Method, om.Count, om.Errors, om.StatusCodes, om.ErrorCodes})
+	}
+	expected := []opMetrics{
+		{"getAccountByKey", "GET", 1, 1, map[int]int64{404: 1}, map[kbcommon.ErrorCode]int64{kbcommon.ErrorCodeAccountDoesNotExistForKey: 1}},
+		{"getAccounts", "GET", 2, 0, map[int]int64{200: 2}, map[kbcommon.ErrorCode]int64{}},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("unexpected metrics (-want +got):\n%s", diff)
+	}
+
+	var buf bytes.Buffer
+	if err := metrics.WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`kbcli_requests_total{operation="getAccounts",method="GET",status="200"} 2`,
+		`kbcli_requests_total{operation="getAccountByKey",method="GET",status="404"} 1`,
+		`kbcli_request_errors_total{operation="getAccountByKey",method="GET",error_code="ACCOUNT_DOES_NOT_EXIST_FOR_KEY"} 1`,
+		`kbcli_request_duration_seconds_bucket{operation="getAccounts",method="GET",le="+Inf"} 2`,
+		`kbcli_request_duration_seconds_count{operation="getAccounts",method="GET"} 2`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Fatalf("missing %s in:\n%s", line, buf.String())
+		}
+	}
+}
+
+// headerRecorder records a request header.
+type headerRecorder struct {
+	header string
+
+	mu     sync.Mutex
+	values []string
+}
+
+func (r *headerRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.values = append(r.values, req.Header.Get(r.header))
+	r.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestTracer(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	rec := &headerRecorder{header: RequestIDHeader}
+	client.Transport.(*httptransport.Runtime).Transport = rec
+
+	var spans []Span
+	tracer := &Tracer{
+		NewID:  func() string { return "generated" },
+		OnSpan: func(s Span) { spans = append(spans, s) },
+	}
+	client.SetTransport(NewInterceptorTransport(client.Transport, tracer.Interceptor()))
+
+	ctx := context.Background()
+	if _, err := client.Account.GetAccounts(ctx, &account.GetAccountsParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Account.GetAccounts(WithRequestID(ctx, "incoming"), &account.GetAccountsParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"generated", "incoming"}, rec.values); diff != "" {
+		t.Fatalf("unexpected request ids (-want +got):\n%s", diff)
+	}
+	var got []string
+	for _, s := range spans {
+		got = append(got, fmt.Sprintf("%s %s %s %s %d", s.RequestID, s.OperationID, s.Method, s.PathPattern, s.StatusCode))
+	}
+	expected := []string{
+		"generated getAccounts GET /1.0/kb/accounts/pagination 200",
+		"incoming getAccounts GET /1.0/kb/accounts/pagination 200",
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("unexpected spans (-want +got):\n%s", diff)
+	}
+}
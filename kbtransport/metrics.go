@@ -0,0 +1,228 @@
+package kbtransport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// DefaultLatencyBuckets - upper bounds, in seconds, of the latency histogram buckets.
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics collects the count, errors and latency of kill bill calls, by operation.
+// Install its interceptor with NewInterceptorTransport, and export the metrics in
+// prometheus text format with WritePrometheus, or by serving Metrics as http handler:
+//
+//	metrics := kbtransport.NewMetrics()
+//	client.SetTransport(kbtransport.NewInterceptorTransport(client.Transport, metrics.Interceptor()))
+//	http.Handle("/metrics", metrics)
+type Metrics struct {
+	buckets []float64
+
+	mu  sync.Mutex
+	ops map[operationKey]*OperationMetrics
+}
+
+type operationKey struct {
+	operationID, method string
+}
+
+// OperationMetrics - metrics of an operation.
+type OperationMetrics struct {
+	OperationID string
+	Method      string
+
+	// Count - number of calls.
+	Count int64
+
+	// Errors - number of failed calls.
+	Errors int64
+
+	// StatusCodes - number of calls by http status code. 0 counts the calls without response.
+	StatusCodes map[int]int64
+
+	// ErrorCodes - number of failed calls by kill bill error code. 0 counts the errors without code.
+	ErrorCodes map[kbcommon.ErrorCode]int64
+
+	// TotalDuration and MaxDuration of the calls.
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+
+	// BucketCounts - number of calls in each latency bucket (not cumulative). The last
+	// count is for the calls slower than the last bucket.
+	BucketCounts []int64
+}
+
+// NewMetrics returns a collector with the given latency buckets, in seconds, or DefaultLatencyBuckets.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Metrics{buckets: buckets, ops: map[operationKey]*OperationMetrics{}}
+}
+
+// Interceptor returns the interceptor recording the calls.
+func (m *Metrics) Interceptor() Interceptor {
+	return Observe(func(_ *runtime.ClientOperation, c Call) {
+		m.Record(c)
+	})
+}
+
+// Record records a call.
+func (m *Metrics) Record(c Call) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := operationKey{c.OperationID, c.Method}
+	om := m.ops[key]
+	if om == nil {
+		om = &OperationMetrics{
+			OperationID:  c.OperationID,
+			Method:       c.Method,
+			StatusCodes:  map[int]int64{},
+			ErrorCodes:   map[kbcommon.ErrorCode]int64{},
+			BucketCounts: make([]int64, len(m.buckets)+1),
+		}
+		m.ops[key] = om
+	}
+	om.Count++
+	om.StatusCodes[c.StatusCode]++
+	if c.Err != nil {
+		om.Errors++
+		om.ErrorCodes[c.ErrorCode]++
+	}
+	om.TotalDuration += c.Duration
+	if c.Duration > om.MaxDuration {
+		om.MaxDuration = c.Duration
+	}
+	om.BucketCounts[sort.SearchFloat64s(m.buckets, c.Duration.Seconds())]++
+}
+
+// Snapshot returns a copy of the metrics, sorted by operation.
+func (m *Metrics) Snapshot() []OperationMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res := make([]OperationMetrics, 0, len(m.ops))
+	for _, om := range m.ops {
+		c := *om
+		c.StatusCodes = map[int]int64{}
+		for k, v := range om.StatusCodes {
+			c.StatusCodes[k] = v
+		}
+		c.ErrorCodes = map[kbcommon.ErrorCode]int64{}
+		for k, v := range om.ErrorCodes {
+			c.ErrorCodes[k] = v
+		}
+		c.BucketCounts = append([]int64(nil), om.BucketCounts...)
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].OperationID != res[j].OperationID {
+			return res[i].OperationID < res[j].OperationID
+		}
+		return res[i].Method < res[j].Method
+	})
+	return res
+}
+
+// Reset discards the recorded calls.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ops = map[operationKey]*OperationMetrics{}
+}
+
+// WritePrometheus writes the metrics in prometheus text format:
+//   - kbcli_requests_total{operation,method,status}
+//   - kbcli_request_errors_total{operation,method,error_code}
+//   - kbcli_request_duration_seconds{operation,method} histogram
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	ops := m.Snapshot()
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "# HELP kbcli_requests_total Kill Bill requests, by operation and http status code.")
+	fmt.Fprintln(bw, "# TYPE kbcli_requests_total counter")
+	for _, om := range ops {
+		for _, status := range sortedInts(om.StatusCodes) {
+			statusLabel := "none"
+			if status != 0 {
+				statusLabel = strconv.Itoa(status)
+			}
+			fmt.Fprintf(bw, "kbcli_requests_total{%s,status=%q} %d\n", opLabels(om), statusLabel, om.StatusCodes[status])
+		}
+	}
+
+	fmt.Fprintln(bw, "# HELP kbcli_request_errors_total Failed Kill Bill requests, by operation and kill bill error code.")
+	fmt.Fprintln(bw, "# TYPE kbcli_request_errors_total counter")
+	for _, om := range ops {
+		codes := make([]int, 0, len(om.ErrorCodes))
+		for code := range om.ErrorCodes {
+			codes = append(codes, int(code))
+		}
+		sort.Ints(codes)
+		for _, code := range codes {
+			fmt.Fprintf(bw, "kbcli_request_errors_total{%s,error_code=%q} %d\n", opLabels(om),
+				errorCodeLabel(kbcommon.ErrorCode(code)), om.ErrorCodes[kbcommon.ErrorCode(code)])
+		}
+	}
+
+	fmt.Fprintln(bw, "# HELP kbcli_request_duration_seconds Latency of Kill Bill requests.")
+	fmt.Fprintln(bw, "# TYPE kbcli_request_duration_seconds histogram")
+	for _, om := range ops {
+		var cumulative int64
+		for i, upper := range m.buckets {
+			cumulative += om.BucketCounts[i]
+			fmt.Fprintf(bw, "kbcli_request_duration_seconds_bucket{%s,le=%q} %d\n", opLabels(om),
+				strconv.FormatFloat(upper, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(bw, "kbcli_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", opLabels(om), om.Count)
+		fmt.Fprintf(bw, "kbcli_request_duration_seconds_sum{%s} %s\n", opLabels(om),
+			strconv.FormatFloat(om.TotalDuration.Seconds(), 'g', -1, 64))
+		fmt.Fprintf(bw, "kbcli_request_duration_seconds_count{%s} %d\n", opLabels(om), om.Count)
+	}
+	return bw.Flush()
+}
+
+// ServeHTTP serves the metrics in prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WritePrometheus(w)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func opLabels(om OperationMetrics) string {
+	return fmt.Sprintf(`operation="%s",method="%s"`, labelEscaper.Replace(om.OperationID), labelEscaper.Replace(om.Method))
+}
+
+func errorCodeLabel(code kbcommon.ErrorCode) string {
+	if code == 0 {
+		return "none"
+	}
+	if name := code.Name(); name != "" {
+		return name
+	}
+	return strconv.Itoa(int(code))
+}
+
+func sortedInts(m map[int]int64) []int {
+	res := make([]int, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Ints(res)
+	return res
+}
@@ -0,0 +1,89 @@
+package kbtransport
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/killbill/kbcli/v3/kbauth"
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// Header names of kill bill profiling.
+const (
+	ProfilingRequestHeader  = kbcommon.ProfilingRequestHeader
+	ProfilingResponseHeader = kbcommon.ProfilingResponseHeader
+)
+
+// The options below are per-call options of the generated clients. Their type is assignable to
+// the ClientOption type of every kbclient package:
+//
+//	client.Account.GetAccount(ctx, params,
+//		kbtransport.WithTenant(apiKey, apiSecret),
+//		kbtransport.WithHeader(kbtransport.RequestIDHeader, requestID),
+//		kbtransport.WithTimeout(5*time.Second))
+//
+// When the call follows the Location header of a created resource, the options apply to both requests.
+
+// WithHeader sends an additional header with the request.
+func WithHeader(name, value string) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.Params = &headerParams{params: op.Params, headers: map[string]string{name: value}}
+	}
+}
+
+// WithAuth authenticates the request with authInfo instead of the client authentication.
+func WithAuth(authInfo runtime.ClientAuthInfoWriter) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.AuthInfo = authInfo
+	}
+}
+
+// WithTenant sends the request to the tenant with the given api key and secret, using the client
+// authentication for the user. Sessions of the client authentication are still logged in and
+// refreshed by kbauth.Transport.
+func WithTenant(apiKey, apiSecret string) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.AuthInfo = kbauth.WithTenant(op.AuthInfo, apiKey, apiSecret)
+	}
+}
+
+// WithTimeout bounds the duration of the call, retries included, with a deadline on the context of
+// the call. The runtime ignores the request timeout of operations with a context, which the
+// generated clients always set. The deadline is released once the call returns when
+// TimeoutTransport is installed, or else when it expires.
+func WithTimeout(timeout time.Duration) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		parent := op.Context
+		if parent == nil {
+			parent = context.Background()
+		}
+		ctx, cancel := context.WithTimeout(parent, timeout)
+		op.Context = context.WithValue(ctx, timeoutCtxKey{}, cancel)
+	}
+}
+
+// WithProfiling asks kill bill to profile the call, for ex. with info "JAXRS,DAO", and stores
+// the profiling data returned by kill bill in result. See ProfilingTransport to profile every call
+// made with a context, and kbcommon.ParseProfile to parse the result.
+func WithProfiling(info string, result *string) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.Params = &headerParams{params: op.Params, headers: map[string]string{ProfilingRequestHeader: info}}
+		op.Reader = &profilingReader{reader: op.Reader, result: result}
+	}
+}
+
+// profilingReader stores the profiling header of the response, and reads the response.
+type profilingReader struct {
+	reader runtime.ClientResponseReader
+	result *string
+}
+
+// ReadResponse implements runtime.ClientResponseReader.
+func (r *profilingReader) ReadResponse(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if r.result != nil {
+		*r.result = resp.GetHeader(ProfilingResponseHeader)
+	}
+	return r.reader.ReadResponse(resp, consumer)
+}
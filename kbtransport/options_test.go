@@ -0,0 +1,145 @@
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbauth"
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientOptions(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	srv.AddTenant("alice", "wonder")
+	ctx := context.Background()
+	newClient := func(rt http.RoundTripper) *kbclient.KillBill {
+		client := srv.NewClient("bob", "lazar")
+		client.Transport.(*httptransport.Runtime).Transport = rt
+		return client
+	}
+
+	// The account is created in alice's tenant, and read back with the same options.
+	rec := &headerRecorder{header: RequestIDHeader}
+	client := newClient(rec)
+	_, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+		Body:                  &kbmodel.Account{ExternalKey: "john", Currency: kbmodel.AccountCurrencyUSD},
+		XKillbillCreatedBy:    "test",
+		ProcessLocationHeader: true,
+	}, WithTenant("alice", "wonder"), WithHeader(RequestIDHeader, "req-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"req-1", "req-1"}, rec.values); diff != "" {
+		t.Fatalf("unexpected request ids (-want +got):\n%s", diff)
+	}
+	if _, err := client.Account.GetAccountByKey(ctx, &account.GetAccountByKeyParams{ExternalKey: "john"}); err == nil {
+		t.Fatalf("expecting account not found in bob's tenant")
+	}
+	if _, err := client.Account.GetAccountByKey(ctx, &account.GetAccountByKeyParams{ExternalKey: "john"},
+		WithTenant("alice", "wonder")); err != nil {
+		t.Fatal(err)
+	}
+
+	// WithAuth replaces the client authentication.
+	_, err = client.Account.GetAccounts(ctx, &account.GetAccountsParams{},
+		WithAuth(httptransport.BasicAuth("admin", "wrong")))
+	if err == nil {
+		t.Fatalf("expecting authentication error")
+	}
+
+	// WithTimeout.
+	client = newClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}))
+	_, err = client.Account.GetAccounts(ctx, &account.GetAccountsParams{}, WithTimeout(10*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expecting deadline exceeded, got %v", err)
+	}
+	client.SetTransport(NewTimeoutTransport(client.Transport))
+	_, err = client.Account.GetAccounts(ctx, &account.GetAccountsParams{}, WithTimeout(10*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expecting deadline exceeded with TimeoutTransport, got %v", err)
+	}
+
+	// WithProfiling.
+	var profilingReq string
+	client = newClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		profilingReq = req.Header.Get(ProfilingRequestHeader)
+		resp, err := http.DefaultTransport.RoundTrip(req)
+		if err == nil {
+			resp.Header.Set(ProfilingResponseHeader, `{"rawData":[]}`)
+		}
+		return resp, err
+	}))
+	var profilingResp string
+	if _, err := client.Account.GetAccounts(ctx, &account.GetAccountsParams{}, WithProfiling("JAXRS", &profilingResp)); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"JAXRS", `{"rawData":[]}`}, []string{profilingReq, profilingResp}); diff != "" {
+		t.Fatalf("unexpected profiling headers (-want +got):\n%s", diff)
+	}
+}
+
+func TestWithTenantSession(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	srv.AddTenant("alice", "wonder")
+	ctx := context.Background()
+
+	var cookies []string
+	trp := srv.NewTransport()
+	trp.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		cookies = append(cookies, req.Header.Get("Cookie"))
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	session := kbauth.NewSession(kbtest.DefaultUsername, kbtest.DefaultPassword)
+	client := kbclient.New(trp, strfmt.Default, kbauth.WithTenant(session, "bob", "lazar"), kbclient.KillbillDefaults{})
+	client.SetTransport(kbauth.NewTransport(client.Transport))
+
+	// The session is logged in for calls to another tenant.
+	_, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+		Body:               &kbmodel.Account{ExternalKey: "john", Currency: kbmodel.AccountCurrencyUSD},
+		XKillbillCreatedBy: "test",
+	}, WithTenant("alice", "wonder"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Current() == nil {
+		t.Fatal("expecting a session")
+	}
+	cookie := kbauth.SessionCookie + "=" + session.Current().ID
+	if diff := cmp.Diff([]string{"", cookie}, cookies); diff != "" {
+		t.Fatalf("unexpected cookies (-want +got):\n%s", diff)
+	}
+
+	// And refreshed when it expires.
+	srv.ExpireSessions()
+	if _, err := client.Account.GetAccountByKey(ctx, &account.GetAccountByKeyParams{ExternalKey: "john"},
+		WithTenant("alice", "wonder")); err != nil {
+		t.Fatal(err)
+	}
+	if kbauth.SessionCookie+"="+session.Current().ID == cookie {
+		t.Fatal("expecting a new session")
+	}
+}
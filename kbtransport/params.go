@@ -0,0 +1,45 @@
+package kbtransport
+
+import (
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// headerParams writes the params of an operation, and then additional headers.
+// Decorators and client options use it to add headers to requests, and ValidateParams unwraps it to
+// validate the original params.
+type headerParams struct {
+	params  runtime.ClientRequestWriter
+	headers map[string]string
+}
+
+// withHeaders returns a copy of op whose requests have the given headers.
+func withHeaders(op *runtime.ClientOperation, headers map[string]string) *runtime.ClientOperation {
+	res := *op
+	res.Params = &headerParams{params: op.Params, headers: headers}
+	return &res
+}
+
+// WriteToRequest implements runtime.ClientRequestWriter.
+func (p *headerParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	if err := p.params.WriteToRequest(r, reg); err != nil {
+		return err
+	}
+	for name, value := range p.headers {
+		if err := r.SetHeaderParam(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// originalParams returns the params of the generated client, before decorators added headers.
+func originalParams(params runtime.ClientRequestWriter) runtime.ClientRequestWriter {
+	for {
+		hp, ok := params.(*headerParams)
+		if !ok {
+			return params
+		}
+		params = hp.params
+	}
+}
@@ -0,0 +1,71 @@
+package kbtransport
+
+import (
+	"github.com/go-openapi/runtime"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// ProfilingTransport profiles the calls made with a context returned by kbcommon.WithProfiling, and
+// parses the profiling data returned by kill bill, including for the calls profiled with the
+// WithProfilingInfo param or client default:
+//
+//	client.SetTransport(kbtransport.NewProfilingTransport(client.Transport, nil))
+//	ctx = kbcommon.WithProfiling(ctx, kbcommon.DefaultProfilingInfo)
+//	client.Account.GetAccount(ctx, params)
+//	for _, p := range kbcommon.ProfilesFromContext(ctx) {
+//		p.WriteTree(os.Stdout)
+//	}
+//
+// Each response carrying profiling data gives a profile, so retried calls can have several profiles.
+type ProfilingTransport struct {
+	next      runtime.ClientTransport
+	onProfile func(*kbcommon.Profile)
+}
+
+// NewProfilingTransport wraps next. onProfile, if not nil, receives the profiles of all the calls,
+// whatever their context.
+func NewProfilingTransport(next runtime.ClientTransport, onProfile func(*kbcommon.Profile)) *ProfilingTransport {
+	return &ProfilingTransport{next: next, onProfile: onProfile}
+}
+
+// Submit submits the operation, with the profiling header of its context, and records the profile
+// of the response.
+func (t *ProfilingTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	if info, ok := kbcommon.ProfilingInfoFromContext(op.Context); ok && op.Params != nil {
+		op = withHeaders(op, map[string]string{ProfilingRequestHeader: info})
+	}
+	res := *op
+	res.Reader = &profileReader{reader: op.Reader, op: op, t: t}
+	return t.next.Submit(&res)
+}
+
+// profileReader parses the profiling header of the response, and reads the response.
+type profileReader struct {
+	reader runtime.ClientResponseReader
+	op     *runtime.ClientOperation
+	t      *ProfilingTransport
+}
+
+// ReadResponse implements runtime.ClientResponseReader.
+func (r *profileReader) ReadResponse(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if header := resp.GetHeader(ProfilingResponseHeader); header != "" {
+		r.t.record(r.op, header)
+	}
+	return r.reader.ReadResponse(resp, consumer)
+}
+
+func (t *ProfilingTransport) record(op *runtime.ClientOperation, header string) {
+	p, err := kbcommon.ParseProfile(header)
+	if err != nil {
+		// Keep the raw data, the call itself succeeded.
+		p = &kbcommon.Profile{Raw: header}
+	}
+	p.OperationID = op.ID
+	p.Method = op.Method
+	p.PathPattern = op.PathPattern
+	kbcommon.AddProfile(op.Context, p)
+	if t.onProfile != nil {
+		t.onProfile(p)
+	}
+}
@@ -0,0 +1,70 @@
+package kbtransport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestProfilingTransport(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	var all []string
+	client.SetTransport(NewProfilingTransport(client.Transport, func(p *kbcommon.Profile) {
+		all = append(all, p.OperationID)
+	}))
+
+	// Not profiled.
+	created, err := client.Account.CreateAccount(context.Background(), &account.CreateAccountParams{
+		Body:                  &kbmodel.Account{Currency: kbmodel.AccountCurrencyUSD},
+		ProcessLocationHeader: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 0 {
+		t.Fatalf("unexpected profiles %v", all)
+	}
+
+	// Profiled with the context.
+	ctx := kbcommon.WithProfiling(context.Background(), "API,DAO")
+	_, err = client.Account.GetAccount(ctx, &account.GetAccountParams{AccountID: created.Payload.AccountID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles := kbcommon.ProfilesFromContext(ctx)
+	if len(profiles) != 1 {
+		t.Fatalf("expecting 1 profile, got %d", len(profiles))
+	}
+	p := profiles[0]
+	if p.OperationID != "getAccount" || p.Method != "GET" || p.PathPattern != "/1.0/kb/accounts/{accountId}" {
+		t.Fatalf("unexpected profiled operation %s %s %s", p.OperationID, p.Method, p.PathPattern)
+	}
+	var kinds []string
+	for _, timing := range p.Timings() {
+		kinds = append(kinds, timing.Kind)
+	}
+	if diff := cmp.Diff([]string{kbcommon.ProfilingAPI, kbcommon.ProfilingDAO}, kinds); diff != "" {
+		t.Fatalf("unexpected timings (-want +got):\n%s", diff)
+	}
+
+	// Profiled with the client defaults.
+	info := "JAXRS"
+	client.SetDefaults(kbclient.KillbillDefaults{WithProfilingInfo: &info})
+	_, err = client.Account.GetAccount(context.Background(), &account.GetAccountParams{AccountID: created.Payload.AccountID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"getAccount", "getAccount"}, all); diff != "" {
+		t.Fatalf("unexpected profiled operations (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,58 @@
+package kbtransport
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+)
+
+// ErrReadOnly is matched by the errors of the operations blocked by a ReadOnlyTransport.
+var ErrReadOnly = errors.New("kbtransport: read-only client")
+
+// ReadOnlyError is returned for the operations blocked by a ReadOnlyTransport. It matches ErrReadOnly.
+type ReadOnlyError struct {
+	// OperationID - id of the blocked operation, for ex. createAccount.
+	OperationID string
+
+	// Method and PathPattern of the blocked operation.
+	Method      string
+	PathPattern string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("kbcli: %s (%s %s) blocked: the client is read-only", e.OperationID, e.Method, e.PathPattern)
+}
+
+// Is returns true for ErrReadOnly.
+func (e *ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
+
+// ReadOnlyTransport rejects the mutating operations (POST, PUT, DELETE...) before they are sent,
+// except the allowed ones. Safe operations are sent as is:
+//
+//	client.SetTransport(kbtransport.NewReadOnlyTransport(client.Transport, "generateDryRunInvoice"))
+//	_, err := client.Account.CreateAccount(ctx, params)
+//	// kbcli: createAccount (POST /1.0/kb/accounts) blocked: the client is read-only
+type ReadOnlyTransport struct {
+	next  runtime.ClientTransport
+	allow map[string]bool
+}
+
+// NewReadOnlyTransport wraps next. allow lists the ids of the mutating operations still permitted.
+func NewReadOnlyTransport(next runtime.ClientTransport, allow ...string) *ReadOnlyTransport {
+	t := &ReadOnlyTransport{next: next, allow: map[string]bool{}}
+	for _, id := range allow {
+		t.allow[id] = true
+	}
+	return t
+}
+
+// Submit submits the safe and allowed operations, and fails with a *ReadOnlyError otherwise.
+func (t *ReadOnlyTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	if !IsSafeMethod(op.Method) && !t.allow[op.ID] {
+		return nil, &ReadOnlyError{OperationID: op.ID, Method: op.Method, PathPattern: op.PathPattern}
+	}
+	return t.next.Submit(op)
+}
@@ -0,0 +1,51 @@
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	trp := client.Transport
+	ctx := context.Background()
+	createAccount := func() error {
+		_, err := client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+			Body:                  &kbmodel.Account{ExternalKey: "john", Currency: kbmodel.AccountCurrencyUSD},
+			XKillbillCreatedBy:    "test",
+			ProcessLocationHeader: true,
+		})
+		return err
+	}
+
+	client.SetTransport(NewReadOnlyTransport(trp))
+	err := createAccount()
+	var roErr *ReadOnlyError
+	if !errors.Is(err, ErrReadOnly) || !errors.As(err, &roErr) || roErr.OperationID != "createAccount" {
+		t.Fatalf("expecting createAccount to be blocked, got %v", err)
+	}
+	if expected := "kbcli: createAccount (POST /1.0/kb/accounts) blocked: the client is read-only"; err.Error() != expected {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	resp, err := client.Account.GetAccounts(ctx, &account.GetAccountsParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Payload) != 0 {
+		t.Fatalf("blocked operation reached the server")
+	}
+
+	// Allowed operations, and the follow-up GET, are sent.
+	client.SetTransport(NewReadOnlyTransport(trp, "createAccount"))
+	if err := createAccount(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,295 @@
+// Package kbtransport implements runtime.ClientTransport decorators for the kill bill client.
+//
+// Decorators wrap the transport returned by httptransport.New (or any other transport)
+// and can be installed on all the sub clients at once through KillBill.SetTransport:
+//
+//	client := kbclient.New(trp, strfmt.Default, authWriter, kbclient.KillbillDefaults{})
+//	client.SetTransport(kbtransport.NewRetryTransport(client.Transport, kbtransport.DefaultRetryPolicy()))
+//
+// The package also provides http.RoundTripper implementations, installed on the
+// httptransport.Runtime Transport field, such as the record/replay Cassette, the redacting
+// DebugTransport and the multi-node Balancer, and NewRuntime, which builds the transport itself
+// from a kill bill url and its TLS, proxy and connection settings.
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// RetryPolicy configures RetryTransport.
+type RetryPolicy struct {
+	// MaxAttempts - total number of attempts, including the first one.
+	MaxAttempts int
+
+	// InitialBackoff - delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff - upper bound for the delay between attempts.
+	MaxBackoff time.Duration
+
+	// Multiplier applied to the delay after each attempt.
+	Multiplier float64
+
+	// Jitter - fraction of the delay that is randomized. For ex., 0.2 means +/- 20%.
+	Jitter float64
+
+	// RetryableStatusCodes - http status codes that are retried.
+	RetryableStatusCodes []int
+
+	// IsRetryableError classifies errors that are not kill bill http errors
+	// (connection resets, timeouts, ...). Defaults to IsRetryableError.
+	IsRetryableError func(err error) bool
+
+	// RetryMutating decides if a mutating (non GET/HEAD/OPTIONS) operation can be retried.
+	// Mutating operations are never retried if nil, unless the call opted in through
+	// WithIdempotentRequest.
+	RetryMutating func(op *runtime.ClientOperation) bool
+
+	// OnRetry, if set, is called before sleeping for the next attempt.
+	OnRetry func(op *runtime.ClientOperation, attempt int, err error, delay time.Duration)
+}
+
+// DefaultRetryPolicy returns a policy that retries safe operations up to 3 times
+// on connection errors and 502, 503, 504 responses.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       100 * time.Millisecond,
+		MaxBackoff:           5 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		IsRetryableError:     IsRetryableError,
+	}
+}
+
+// Backoff returns the delay before the given retry (1 for the first retry), without jitter.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	if retry < 1 || p.InitialBackoff <= 0 {
+		return 0
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	return time.Duration(d)
+}
+
+type idempotentCtxKey struct{}
+
+// WithIdempotentRequest marks the calls made with the returned context as safe to retry,
+// even if they are mutating. Use it for calls that can't create duplicates, for ex.
+// because the request carries an external key.
+func WithIdempotentRequest(parent context.Context) context.Context {
+	return context.WithValue(parent, idempotentCtxKey{}, true)
+}
+
+// isIdempotentRequest returns true if the context was created by WithIdempotentRequest.
+func isIdempotentRequest(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	v, _ := ctx.Value(idempotentCtxKey{}).(bool)
+	return v
+}
+
+// IsSafeMethod returns true for http methods that don't modify server state.
+func IsSafeMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// IsRetryableError returns true for transient network errors:
+// connection resets/refusals, unexpected EOFs and timeouts.
+// Context cancellation is never retryable.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// HTTPStatusError is the error of a response whose body couldn't be decoded, for ex. the html
+// page of a 503 from a proxy or a load balancer in front of kill bill.
+type HTTPStatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("http status %d: %v", e.StatusCode, e.Err)
+}
+
+// Unwrap returns the decoding error.
+func (e *HTTPStatusError) Unwrap() error {
+	return e.Err
+}
+
+// statusReader reads the response, and returns a HTTPStatusError if the body of an error
+// response can't be decoded.
+type statusReader struct {
+	reader runtime.ClientResponseReader
+}
+
+// ReadResponse implements runtime.ClientResponseReader.
+func (r *statusReader) ReadResponse(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	res, err := r.reader.ReadResponse(resp, consumer)
+	var kbErr *kbcommon.KillbillError
+	if err != nil && resp.Code() >= http.StatusBadRequest && !errors.As(err, &kbErr) {
+		return res, &HTTPStatusError{StatusCode: resp.Code(), Err: err}
+	}
+	return res, err
+}
+
+// RetryTransport retries failed operations according to a RetryPolicy.
+type RetryTransport struct {
+	next   runtime.ClientTransport
+	policy RetryPolicy
+
+	// sleep waits for the given duration or until ctx is done. Overridden in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+// NewRetryTransport wraps next with the given retry policy.
+func NewRetryTransport(next runtime.ClientTransport, policy RetryPolicy) *RetryTransport {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.IsRetryableError == nil {
+		policy.IsRetryableError = IsRetryableError
+	}
+	return &RetryTransport{
+		next:   next,
+		policy: policy,
+		sleep:  sleepContext,
+		rnd:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Submit submits the operation, retrying on retryable failures.
+func (t *RetryTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	ctx := op.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	canRetry := t.canRetry(op)
+	if op.Reader != nil {
+		// The status of error responses is classified even if their body isn't kill bill json.
+		res := *op
+		res.Reader = &statusReader{reader: op.Reader}
+		op = &res
+	}
+
+	for attempt := 1; ; attempt++ {
+		result, err := t.next.Submit(op)
+		if err == nil || !canRetry || attempt >= t.policy.MaxAttempts || !t.shouldRetry(err) {
+			return result, err
+		}
+
+		delay := t.delay(attempt)
+		if t.policy.OnRetry != nil {
+			t.policy.OnRetry(op, attempt, err, delay)
+		}
+		if sleepErr := t.sleep(ctx, delay); sleepErr != nil {
+			// Return the last error from the server rather than the context error.
+			return result, err
+		}
+	}
+}
+
+// canRetry returns true if the operation is safe to retry.
+func (t *RetryTransport) canRetry(op *runtime.ClientOperation) bool {
+	if IsSafeMethod(op.Method) {
+		return true
+	}
+	if isIdempotentRequest(op.Context) {
+		return true
+	}
+	return t.policy.RetryMutating != nil && t.policy.RetryMutating(op)
+}
+
+// shouldRetry classifies the given error.
+func (t *RetryTransport) shouldRetry(err error) bool {
+	var kbErr *kbcommon.KillbillError
+	if errors.As(err, &kbErr) {
+		return t.isRetryableStatus(kbErr.HTTPCode)
+	}
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return t.isRetryableStatus(statusErr.StatusCode)
+	}
+	return t.policy.IsRetryableError(err)
+}
+
+func (t *RetryTransport) isRetryableStatus(status int) bool {
+	for _, code := range t.policy.RetryableStatusCodes {
+		if status == code {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns the jittered delay before the given retry.
+func (t *RetryTransport) delay(retry int) time.Duration {
+	d := t.policy.Backoff(retry)
+	if d <= 0 || t.policy.Jitter <= 0 {
+		return d
+	}
+	t.mu.Lock()
+	r := t.rnd.Float64()
+	t.mu.Unlock()
+	factor := 1 - t.policy.Jitter + 2*t.policy.Jitter*r
+	return time.Duration(float64(d) * factor)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
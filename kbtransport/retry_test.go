@@ -0,0 +1,207 @@
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// transportFunc implements runtime.ClientTransport
+type transportFunc func(op *runtime.ClientOperation) (interface{}, error)
+
+func (f transportFunc) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	return f(op)
+}
+
+// failingTransport fails with the given errors, then succeeds.
+func failingTransport(calls *int, errs ...error) runtime.ClientTransport {
+	return transportFunc(func(op *runtime.ClientOperation) (interface{}, error) {
+		*calls++
+		if *calls <= len(errs) {
+			return nil, errs[*calls-1]
+		}
+		return "ok", nil
+	})
+}
+
+func newTestRetryTransport(next runtime.ClientTransport, policy RetryPolicy, delays *[]time.Duration) *RetryTransport {
+	t := NewRetryTransport(next, policy)
+	t.sleep = func(ctx context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return ctx.Err()
+	}
+	return t
+}
+
+func TestRetryTransport(t *testing.T) {
+	connReset := fmt.Errorf("read: %w", syscall.ECONNRESET)
+	unavailable := kbcommon.NewKillbillError(503)
+	notFound := kbcommon.NewKillbillError(404)
+
+	scenarios := []struct {
+		Name          string
+		Method        string
+		Idempotent    bool
+		Errors        []error
+		ExpectedCalls int
+		ExpectedErr   error
+	}{
+		{"success", "GET", false, nil, 1, nil},
+		{"connection reset", "GET", false, []error{connReset}, 2, nil},
+		{"unavailable", "GET", false, []error{unavailable, unavailable}, 3, nil},
+		{"max attempts", "GET", false, []error{unavailable, unavailable, unavailable}, 3, unavailable},
+		{"not retryable status", "GET", false, []error{notFound}, 1, notFound},
+		{"unexpected eof", "GET", false, []error{io.ErrUnexpectedEOF}, 2, nil},
+		{"canceled", "GET", false, []error{context.Canceled}, 1, context.Canceled},
+		{"post not retried", "POST", false, []error{unavailable}, 1, unavailable},
+		{"delete not retried", "DELETE", false, []error{connReset}, 1, connReset},
+		{"idempotent post", "POST", true, []error{unavailable}, 2, nil},
+	}
+
+	for _, s := range scenarios {
+		var calls int
+		var delays []time.Duration
+		trp := newTestRetryTransport(failingTransport(&calls, s.Errors...), DefaultRetryPolicy(), &delays)
+		ctx := context.Background()
+		if s.Idempotent {
+			ctx = WithIdempotentRequest(ctx)
+		}
+		_, err := trp.Submit(&runtime.ClientOperation{ID: "op", Method: s.Method, Context: ctx})
+		if err != s.ExpectedErr {
+			t.Fatalf("%s: expecting error %v, got %v", s.Name, s.ExpectedErr, err)
+		}
+		if calls != s.ExpectedCalls {
+			t.Fatalf("%s: expecting %d calls, got %d", s.Name, s.ExpectedCalls, calls)
+		}
+		if len(delays) != calls-1 {
+			t.Fatalf("%s: expecting %d delays, got %d", s.Name, calls-1, len(delays))
+		}
+	}
+}
+
+func TestRetryTransport_RetryMutating(t *testing.T) {
+	var calls int
+	var delays []time.Duration
+	policy := DefaultRetryPolicy()
+	policy.RetryMutating = func(op *runtime.ClientOperation) bool {
+		return op.ID == "createAccount"
+	}
+	trp := newTestRetryTransport(failingTransport(&calls, kbcommon.NewKillbillError(502)), policy, &delays)
+	if _, err := trp.Submit(&runtime.ClientOperation{ID: "createAccount", Method: "POST"}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expecting 2 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransport_ContextDone(t *testing.T) {
+	var calls int
+	unavailable := kbcommon.NewKillbillError(503)
+	trp := NewRetryTransport(failingTransport(&calls, unavailable, unavailable), DefaultRetryPolicy())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := trp.Submit(&runtime.ClientOperation{ID: "op", Method: "GET", Context: ctx})
+	if err != unavailable {
+		t.Fatalf("expecting last server error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expecting 1 call, got %d", calls)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+	var result []time.Duration
+	for i := 0; i <= 6; i++ {
+		result = append(result, policy.Backoff(i))
+	}
+	exp := []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second}
+	if diff := cmp.Diff(exp, result); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestRetryTransport_Jitter(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	trp := NewRetryTransport(nil, policy)
+	for i := 0; i < 100; i++ {
+		d := trp.delay(1)
+		if d < 80*time.Millisecond || d > 120*time.Millisecond {
+			t.Fatalf("delay %v out of jitter bounds", d)
+		}
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	if IsRetryableError(errors.New("some error")) {
+		t.Fatalf("generic errors must not be retryable")
+	}
+	if !IsRetryableError(fmt.Errorf("dial: %w", syscall.ECONNREFUSED)) {
+		t.Fatalf("connection refused must be retryable")
+	}
+	if IsRetryableError(fmt.Errorf("wrapped: %w", context.DeadlineExceeded)) {
+		t.Fatalf("deadline exceeded must not be retryable")
+	}
+}
+
+func TestRetryTransport_ProxyError(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls < 3 {
+			// Error page of a proxy or a load balancer, not kill bill json.
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<html><body><h1>503 Service Unavailable</h1></body></html>"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"accountId":"5f1f3c2a-7b8e-4d4a-9a43-2f4a8c1e0b6d","name":"john"}`))
+	}))
+	defer srv.Close()
+
+	trp := httptransport.New(strings.TrimPrefix(srv.URL, "http://"), "", []string{"http"})
+	client := kbclient.New(trp, strfmt.Default, nil, kbclient.KillbillDefaults{})
+	var delays []time.Duration
+	client.SetTransport(newTestRetryTransport(client.Transport, DefaultRetryPolicy(), &delays))
+
+	resp, err := client.Account.GetAccountByKey(context.Background(), &account.GetAccountByKeyParams{ExternalKey: "john"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Payload.Name != "john" || calls != 3 {
+		t.Fatalf("expecting john after 3 calls, got %+v after %d calls", resp.Payload, calls)
+	}
+
+	// The last error keeps the status.
+	calls = 0
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = 2
+	client.SetTransport(newTestRetryTransport(trp, policy, &delays))
+	_, err = client.Account.GetAccountByKey(context.Background(), &account.GetAccountByKeyParams{ExternalKey: "john"})
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable || calls != 2 {
+		t.Fatalf("expecting a 503 HTTPStatusError after 2 calls, got %v after %d calls", err, calls)
+	}
+}
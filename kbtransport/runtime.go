@@ -0,0 +1,200 @@
+package kbtransport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+)
+
+// RuntimeConfig configures the transport returned by NewRuntime.
+type RuntimeConfig struct {
+	// URL of kill bill. Either a full URL, for ex. https://kb.example.com/killbill, whose path is
+	// used as prefix of the kill bill api paths, or host:port, in which case https is used
+	// for port 443 and http otherwise.
+	URL string
+
+	// Schemes, if set, overrides the scheme of URL. When both http and https are given, https is used.
+	Schemes []string
+
+	// CAFile - PEM encoded CA bundle used to verify the server certificate, in addition to the system roots.
+	CAFile string
+
+	// CertFile and KeyFile - PEM encoded client certificate and key, for mutual TLS.
+	CertFile string
+	KeyFile  string
+
+	// InsecureSkipVerify disables the verification of the server certificate. For development only.
+	InsecureSkipVerify bool
+
+	// ProxyURL - url of the http proxy. The proxy is taken from the HTTP_PROXY, HTTPS_PROXY
+	// and NO_PROXY environment variables if empty.
+	ProxyURL string
+
+	// DialTimeout - timeout of the tcp connection. Defaults to 30s.
+	DialTimeout time.Duration
+
+	// KeepAlive - interval of the tcp keep-alive probes. Defaults to 30s, negative disables them.
+	KeepAlive time.Duration
+
+	// DisableKeepAlives disables http keep-alive: each request uses a new connection.
+	DisableKeepAlives bool
+
+	// MaxIdleConns - maximum number of idle connections. Defaults to 100.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost - maximum number of idle connections to kill bill. Defaults to 2.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout - time after which idle connections are closed. Defaults to 90s.
+	IdleConnTimeout time.Duration
+}
+
+// NewRuntime returns a transport for the given configuration, to be passed to kbclient.New.
+func NewRuntime(conf RuntimeConfig) (*httptransport.Runtime, error) {
+	scheme, host, basePath, err := ParseURL(conf.URL)
+	if err != nil {
+		return nil, err
+	}
+	httpTrp, err := NewHTTPTransport(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	schemes := conf.Schemes
+	if len(schemes) == 0 {
+		schemes = []string{scheme}
+	}
+	trp := httptransport.New(host, basePath, schemes)
+	trp.Transport = httpTrp
+	// Add text/xml producer which is not handled by openapi runtime.
+	trp.Producers["text/xml"] = runtime.TextProducer()
+	return trp, nil
+}
+
+// ParseURL returns the scheme, host and base path of the given kill bill url.
+// See RuntimeConfig.URL for the supported formats.
+func ParseURL(rawURL string) (scheme, host, basePath string, err error) {
+	if rawURL == "" {
+		return "", "", "", errors.New("kbtransport: empty kill bill url")
+	}
+	if !strings.Contains(rawURL, "://") {
+		// host:port
+		h, port, err := net.SplitHostPort(rawURL)
+		if err != nil {
+			return "", "", "", fmt.Errorf("kbtransport: invalid kill bill url %q, expecting host:port or a full url: %w", rawURL, err)
+		}
+		p, err := strconv.Atoi(port)
+		if err != nil || h == "" {
+			return "", "", "", fmt.Errorf("kbtransport: invalid kill bill url %q, expecting host:port or a full url", rawURL)
+		}
+		scheme = "http"
+		if p == 443 {
+			scheme = "https"
+		}
+		return scheme, rawURL, "", nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", "", fmt.Errorf("kbtransport: invalid kill bill url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", "", "", fmt.Errorf("kbtransport: unsupported scheme %q in kill bill url, expecting http or https", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", "", "", fmt.Errorf("kbtransport: missing host in kill bill url %q", rawURL)
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", "", "", fmt.Errorf("kbtransport: kill bill url %q can't have user info, query or fragment", rawURL)
+	}
+	return u.Scheme, u.Host, strings.TrimSuffix(u.Path, "/"), nil
+}
+
+// NewHTTPTransport returns the http transport for the TLS, proxy and connection settings of conf.
+// The URL is ignored.
+func NewHTTPTransport(conf RuntimeConfig) (*http.Transport, error) {
+	tlsConfig, err := newTLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if conf.ProxyURL != "" {
+		u, err := url.Parse(conf.ProxyURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("kbtransport: invalid proxy url %q", conf.ProxyURL)
+		}
+		proxy = http.ProxyURL(u)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   durationOrDefault(conf.DialTimeout, 30*time.Second),
+		KeepAlive: durationOrDefault(conf.KeepAlive, 30*time.Second),
+	}
+	maxIdleConns := conf.MaxIdleConns
+	if maxIdleConns == 0 {
+		maxIdleConns = 100
+	}
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		ForceAttemptHTTP2:     true,
+		DisableKeepAlives:     conf.DisableKeepAlives,
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   conf.MaxIdleConnsPerHost,
+		IdleConnTimeout:       durationOrDefault(conf.IdleConnTimeout, 90*time.Second),
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}, nil
+}
+
+func newTLSConfig(conf RuntimeConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: conf.InsecureSkipVerify,
+	}
+	if conf.CAFile != "" {
+		pem, err := ioutil.ReadFile(conf.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("kbtransport: failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("kbtransport: no certificate found in CA bundle %s", conf.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		if conf.CertFile == "" || conf.KeyFile == "" {
+			return nil, errors.New("kbtransport: both client certificate and key are required for mutual TLS")
+		}
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("kbtransport: failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func durationOrDefault(d, def time.Duration) time.Duration {
+	if d == 0 {
+		return def
+	}
+	return d
+}
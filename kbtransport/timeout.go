@@ -0,0 +1,35 @@
+package kbtransport
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+)
+
+// timeoutCtxKey holds the context.CancelFunc of the deadline set by WithTimeout.
+type timeoutCtxKey struct{}
+
+// TimeoutTransport releases the deadline of the calls made with WithTimeout as soon as they
+// return, rather than when the deadline expires. Install it outside of the other decorators, so
+// that retries are made before the release:
+//
+//	client.SetTransport(kbtransport.NewTimeoutTransport(client.Transport))
+//	client.Account.GetAccount(ctx, params, kbtransport.WithTimeout(5*time.Second))
+type TimeoutTransport struct {
+	next runtime.ClientTransport
+}
+
+// NewTimeoutTransport wraps next.
+func NewTimeoutTransport(next runtime.ClientTransport) *TimeoutTransport {
+	return &TimeoutTransport{next: next}
+}
+
+// Submit submits the operation, and releases the deadline of its timeout once the response is read.
+func (t *TimeoutTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	if op.Context != nil {
+		if cancel, ok := op.Context.Value(timeoutCtxKey{}).(context.CancelFunc); ok {
+			defer cancel()
+		}
+	}
+	return t.next.Submit(op)
+}
@@ -0,0 +1,89 @@
+package kbtransport
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader - header carrying the request id. Kill Bill adds it to its logs.
+const RequestIDHeader = "X-Request-Id"
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context whose kill bill calls are sent with the given request id,
+// for ex. the id of the incoming request being served, when traced with Tracer.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request id set with WithRequestID.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// Span - traced kill bill call.
+type Span struct {
+	// RequestID - id sent in the request id header.
+	RequestID string
+
+	// Start time of the call.
+	Start time.Time
+
+	Call
+}
+
+// Tracer sends a request id header with each kill bill call, and reports the calls as spans.
+// The request id is taken from the call context (see WithRequestID), or generated:
+//
+//	tracer := &kbtransport.Tracer{OnSpan: func(s kbtransport.Span) {
+//		log.Printf("%s %s %v %s", s.RequestID, s.OperationID, s.Duration, s.Err)
+//	}}
+//	client.SetTransport(kbtransport.NewInterceptorTransport(client.Transport, tracer.Interceptor()))
+type Tracer struct {
+	// Header carrying the request id. Defaults to RequestIDHeader.
+	Header string
+
+	// NewID generates request ids. Defaults to random uuids.
+	NewID func() string
+
+	// OnSpan, if set, is called after each call.
+	OnSpan func(s Span)
+}
+
+// Interceptor returns the interceptor tracing the calls.
+func (t *Tracer) Interceptor() Interceptor {
+	return func(op *runtime.ClientOperation, next Invoker) (interface{}, error) {
+		id, ok := RequestIDFromContext(op.Context)
+		if !ok {
+			id = t.newID()
+		}
+		header := t.Header
+		if header == "" {
+			header = RequestIDHeader
+		}
+		if op.Params != nil {
+			op = withHeaders(op, map[string]string{header: id})
+		}
+
+		start := time.Now()
+		result, err := next(op)
+		if t.OnSpan != nil {
+			t.OnSpan(Span{RequestID: id, Start: start, Call: NewCall(op, result, err, start)})
+		}
+		return result, err
+	}
+}
+
+func (t *Tracer) newID() string {
+	if t.NewID != nil {
+		return t.NewID()
+	}
+	return uuid.New().String()
+}
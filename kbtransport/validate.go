@@ -0,0 +1,206 @@
+package kbtransport
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
+)
+
+// ValidationTransport validates the parameters of the operations before submitting them, and
+// returns a *kbcommon.ValidationError instead of sending invalid requests. See ValidateParams.
+//
+//	client.SetTransport(kbtransport.NewValidationTransport(client.Transport))
+type ValidationTransport struct {
+	next    runtime.ClientTransport
+	formats strfmt.Registry
+}
+
+// NewValidationTransport wraps next.
+func NewValidationTransport(next runtime.ClientTransport) *ValidationTransport {
+	return &ValidationTransport{next: next, formats: strfmt.Default}
+}
+
+// Submit validates and submits the operation.
+func (t *ValidationTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	if isLocationRequest(op) {
+		// The params of the created resource request are not complete.
+		return t.next.Submit(op)
+	}
+	if err := ValidateParams(originalParams(op.Params), t.formats); err != nil {
+		err.Operation = op.ID
+		return nil, err
+	}
+	return t.next.Submit(op)
+}
+
+// isLocationRequest returns true for the request sent by the generated client to retrieve the
+// resource created by an operation, when ProcessLocationHeader is set. It is the only safe
+// operation sent with the params of an operation having a body.
+func isLocationRequest(op *runtime.ClientOperation) bool {
+	if !IsSafeMethod(op.Method) {
+		return false
+	}
+	val := reflect.ValueOf(originalParams(op.Params))
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := val.Elem().Type().FieldByName("Body")
+	return ok
+}
+
+// Fields of the generated params that are not operation parameters.
+var ignoredParams = map[string]bool{
+	"WithProfilingInfo":     true,
+	"WithStackTrace":        true,
+	"Context":               true,
+	"HTTPClient":            true,
+	"ProcessLocationHeader": true,
+}
+
+var uuidType = reflect.TypeOf(strfmt.UUID(""))
+
+// ValidateParams validates the params of a generated operation (for ex. *account.GetAccountParams):
+//   - the body is required, and validated with its Validate method, except for required fields,
+//   - required (non pointer) string params, such as path params, must not be empty,
+//   - uuid params must be valid uuids.
+//
+// It returns nil if params are valid, or not generated params.
+func ValidateParams(params interface{}, formats strfmt.Registry) *kbcommon.ValidationError {
+	val := reflect.ValueOf(params)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	val = val.Elem()
+
+	var fields []kbcommon.FieldError
+	for i := 0; i < val.NumField(); i++ {
+		ft := val.Type().Field(i)
+		if ft.PkgPath != "" || ignoredParams[ft.Name] {
+			continue
+		}
+		if ft.Name == "Body" {
+			fields = append(fields, validateBody(val.Field(i), formats)...)
+		} else {
+			fields = append(fields, validateParam(paramName(ft.Name), val.Field(i))...)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &kbcommon.ValidationError{Fields: fields}
+}
+
+func validateBody(f reflect.Value, formats strfmt.Registry) []kbcommon.FieldError {
+	switch f.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		if f.IsNil() {
+			return []kbcommon.FieldError{{Field: "body", Message: "is required"}}
+		}
+	case reflect.String:
+		if f.Len() == 0 {
+			return []kbcommon.FieldError{{Field: "body", Message: "is required"}}
+		}
+		return nil
+	}
+
+	if f.Kind() != reflect.Slice {
+		return validateModel(f, "body", formats)
+	}
+	var res []kbcommon.FieldError
+	for i := 0; i < f.Len(); i++ {
+		field := fmt.Sprintf("body.%d", i)
+		if f.Index(i).Type() == uuidType {
+			res = append(res, validateUUID(field, f.Index(i))...)
+		} else {
+			res = append(res, validateModel(f.Index(i), field, formats)...)
+		}
+	}
+	return res
+}
+
+func validateModel(f reflect.Value, field string, formats strfmt.Registry) []kbcommon.FieldError {
+	m, ok := f.Interface().(interface{ Validate(strfmt.Registry) error })
+	if !ok || (f.Kind() == reflect.Ptr && f.IsNil()) {
+		return nil
+	}
+	return kbcommon.FieldErrors(withoutRequired(m.Validate(formats)), field)
+}
+
+// withoutRequired removes the required field errors from the error returned by a model Validate method.
+// kbmodel types are shared by requests and responses, so their required fields aren't always required
+// in requests. For ex., a subscription is created with either a plan name, or a product, billing period
+// and price list.
+func withoutRequired(err error) error {
+	switch e := err.(type) {
+	case *oaerrors.CompositeError:
+		var res []error
+		for _, inner := range e.Errors {
+			if inner = withoutRequired(inner); inner != nil {
+				res = append(res, inner)
+			}
+		}
+		if len(res) == 0 {
+			return nil
+		}
+		return oaerrors.CompositeValidationError(res...)
+	case *oaerrors.Validation:
+		if e.Code() == oaerrors.RequiredFailCode {
+			return nil
+		}
+	}
+	return err
+}
+
+func validateParam(name string, f reflect.Value) []kbcommon.FieldError {
+	switch {
+	case f.Kind() == reflect.String:
+		// Required param
+		if f.Len() == 0 {
+			return []kbcommon.FieldError{{Field: name, Message: "is required"}}
+		}
+		if f.Type() == uuidType {
+			return validateUUID(name, f)
+		}
+	case f.Kind() == reflect.Ptr && f.Type().Elem() == uuidType:
+		if !f.IsNil() {
+			return validateUUID(name, f.Elem())
+		}
+	case f.Kind() == reflect.Slice && f.Type().Elem() == uuidType:
+		var res []kbcommon.FieldError
+		for i := 0; i < f.Len(); i++ {
+			res = append(res, validateUUID(name, f.Index(i))...)
+		}
+		return res
+	}
+	return nil
+}
+
+func validateUUID(name string, f reflect.Value) []kbcommon.FieldError {
+	if !strfmt.IsUUID(f.String()) {
+		return []kbcommon.FieldError{{Field: name, Message: fmt.Sprintf("%q is not a valid uuid", f.String())}}
+	}
+	return nil
+}
+
+// paramName returns the kill bill name of the param of the given field.
+// For ex., AccountID -> accountId, XKillbillCreatedBy -> X-Killbill-CreatedBy.
+func paramName(field string) string {
+	if strings.HasPrefix(field, "XKillbill") {
+		return "X-Killbill-" + strings.Replace(strings.TrimPrefix(field, "XKillbill"), "API", "Api", 1)
+	}
+	if strings.HasSuffix(field, "IDs") {
+		field = strings.TrimSuffix(field, "IDs") + "Ids"
+	} else if strings.HasSuffix(field, "ID") {
+		field = strings.TrimSuffix(field, "ID") + "Id"
+	}
+	r := []rune(field)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
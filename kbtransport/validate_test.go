@@ -0,0 +1,97 @@
+package kbtransport
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/subscription"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestValidateParams(t *testing.T) {
+	const accountID = strfmt.UUID("8e3b4a1e-4c0a-4d1f-9c2e-000000000001")
+	scenarios := []struct {
+		Name     string
+		Params   interface{}
+		Expected []kbcommon.FieldError
+	}{
+		{"valid", &account.AddAccountBlockingStateParams{
+			XKillbillCreatedBy: "jane",
+			AccountID:          accountID,
+			Body:               &kbmodel.BlockingState{BlockedID: accountID, Type: kbmodel.BlockingStateTypeACCOUNT},
+		}, nil},
+		{"missing params", &account.AddAccountBlockingStateParams{}, []kbcommon.FieldError{
+			{Field: "X-Killbill-CreatedBy", Message: "is required"},
+			{Field: "accountId", Message: "is required"},
+			{Field: "body", Message: "is required"},
+		}},
+		{"invalid uuid", &account.GetAccountParams{AccountID: "john"}, []kbcommon.FieldError{
+			{Field: "accountId", Message: `"john" is not a valid uuid`},
+		}},
+		{"invalid body", &account.CreateAccountParams{
+			XKillbillCreatedBy: "jane",
+			Body:               &kbmodel.Account{Currency: "EURO", ParentAccountID: "john"},
+		}, []kbcommon.FieldError{
+			{Field: "body.currency", Message: `currency in body should be one of [` + strings.Join(kbmodel.AccountCurrencyEnumValues, " ") + `]`},
+			{Field: "body.parentAccountId", Message: `parentAccountId in body must be of type uuid: "john"`},
+		}},
+		{"invalid body items", &account.CreateAccountTagsParams{
+			XKillbillCreatedBy: "jane",
+			AccountID:          accountID,
+			Body:               []strfmt.UUID{accountID, "AUTO_PAY_OFF"},
+		}, []kbcommon.FieldError{
+			{Field: "body.1", Message: `"AUTO_PAY_OFF" is not a valid uuid`},
+		}},
+		{"required body fields", &subscription.CreateSubscriptionParams{
+			XKillbillCreatedBy: "jane",
+			Body:               &kbmodel.Subscription{AccountID: accountID, PlanName: swag.String("basic-monthly")},
+		}, nil},
+		{"not params", "params", nil},
+	}
+
+	for _, s := range scenarios {
+		var fields []kbcommon.FieldError
+		if err := ValidateParams(s.Params, strfmt.Default); err != nil {
+			fields = err.Fields
+		}
+		if diff := cmp.Diff(s.Expected, fields); diff != "" {
+			t.Fatalf("%s: unexpected errors (-want +got):\n%s", s.Name, diff)
+		}
+	}
+}
+
+func TestValidationTransport(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	client := srv.NewClient("bob", "lazar")
+	client.SetTransport(NewValidationTransport(NewAuditTransport(client.Transport)))
+	ctx := context.Background()
+
+	_, err := client.Account.GetAccount(ctx, &account.GetAccountParams{AccountID: "john"})
+	var validationErr *kbcommon.ValidationError
+	if !errors.As(err, &validationErr) || !errors.Is(err, kbcommon.ErrValidation) {
+		t.Fatalf("expecting validation error, got %v", err)
+	}
+	expected := `kbcli: invalid getAccount request: accountId: "john" is not a valid uuid`
+	if err.Error() != expected {
+		t.Fatalf("expecting %q, got %q", expected, err.Error())
+	}
+
+	// Valid requests are sent, with the client defaults.
+	_, err = client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+		Body: &kbmodel.Account{Currency: kbmodel.AccountCurrencyUSD},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,17 +1,16 @@
 package killbill
 
 import (
-	"encoding/base64"
-	"fmt"
+	"errors"
 	"github.com/go-openapi/runtime"
 	transport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
-	"strconv"
-	"strings"
 	"time"
 
+	"github.com/killbill/kbcli/v3/kbauth"
 	"github.com/killbill/kbcli/v3/kbclient"
 	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbtransport"
 )
 
 const CreatedBy = "go-client"
@@ -48,22 +47,84 @@ const (
 )
 
 // Kill Bill Error Codes
+// See kbcommon.ErrorCode for the complete list.
 const (
-	SUB_CREATE_BP_EXISTS           = 1015
-	ACCOUNT_ALREADY_EXISTS         = 3000
-	ACCOUNT_DOES_NOT_EXIST_FOR_KEY = 3003
+	SUB_CREATE_BP_EXISTS           = int(kbcommon.ErrorCodeSubCreateBpExists)
+	ACCOUNT_ALREADY_EXISTS         = int(kbcommon.ErrorCodeAccountAlreadyExists)
+	ACCOUNT_DOES_NOT_EXIST_FOR_KEY = int(kbcommon.ErrorCodeAccountDoesNotExistForKey)
 )
 
+// NewKBClient returns a client for the given configuration. It panics if the configuration is invalid,
+// use NewRawClient to get an error instead.
 func NewKBClient(conf KillbillConfig) *RawClient {
-	trp := NewClientTransport(conf.GetUrl())
-	return &RawClient{
+	cli, err := NewRawClient(conf)
+	if err != nil {
+		panic(err)
+	}
+	return cli
+}
+
+// NewRawClient returns a client for the given configuration.
+func NewRawClient(conf KillbillConfig) (*RawClient, error) {
+	trpConf := kbtransport.RuntimeConfig{URL: conf.GetUrl()}
+	if tc, ok := conf.(TransportConfig); ok {
+		trpConf = tc.GetTransportConfig()
+	}
+	trp, err := newRuntime(trpConf)
+	if err != nil {
+		return nil, err
+	}
+	auth := kbauth.Basic(conf.GetUsername(), conf.GetPassword())
+	if ac, ok := conf.(AuthConfig); ok && ac.GetAuthProvider() != nil {
+		auth = ac.GetAuthProvider()
+	}
+	var profilingInfo string
+	if pc, ok := conf.(ProfilingConfig); ok {
+		profilingInfo = pc.GetProfilingInfo()
+	}
+	cli := &RawClient{
 		Trp:               trp,
 		ApiKey:            conf.GetApiKey(),
 		ApiSecret:         conf.GetApiSecret(),
-		CrossTenantClient: NewKillbillClient(trp, "", "", conf.GetUsername(), conf.GetPassword()),
-		TenantClient:      NewKillbillClient(trp, conf.GetApiKey(), conf.GetApiSecret(), conf.GetUsername(), conf.GetPassword()),
+		CrossTenantClient: newKillbillClient(trp, kbauth.WithTenant(auth, "", ""), profilingInfo),
+		TenantClient:      newKillbillClient(trp, kbauth.WithTenant(auth, conf.GetApiKey(), conf.GetApiSecret()), profilingInfo),
 		Timeout:           conf.GetTimeout(),
+		auth:              auth,
+		profilingInfo:     profilingInfo,
+		clientTrp:         newClientTransport(conf, trp),
 	}
+	cli.CrossTenantClient.SetTransport(cli.clientTrp)
+	cli.TenantClient.SetTransport(cli.clientTrp)
+	return cli, nil
+}
+
+// newClientTransport returns the transport of the kill bill clients: trp, decorated as configured.
+func newClientTransport(conf KillbillConfig, trp *transport.Runtime) runtime.ClientTransport {
+	var clientTrp runtime.ClientTransport = trp
+	if rc, ok := conf.(ReadOnlyConfig); ok && rc.GetReadOnly() {
+		clientTrp = kbtransport.NewReadOnlyTransport(clientTrp, rc.GetReadOnlyAllowList()...)
+	}
+	// Audit information set with kbcommon.WithAuditInfo overrides CreatedBy/Comment.
+	clientTrp = kbtransport.NewAuditTransport(clientTrp)
+	// Calls are profiled with WithProfilingInfo contexts, or with ProfilingConfig.
+	var onProfile func(*kbcommon.Profile)
+	if pc, ok := conf.(ProfilingConfig); ok {
+		onProfile = pc.GetProfileHandler()
+	}
+	clientTrp = kbtransport.NewProfilingTransport(clientTrp, onProfile)
+	if vc, ok := conf.(ValidationConfig); ok && vc.GetValidateRequests() {
+		clientTrp = kbtransport.NewValidationTransport(clientTrp)
+	}
+	if rc, ok := conf.(RetryConfig); ok && rc.GetRetryPolicy() != nil {
+		clientTrp = kbtransport.NewRetryTransport(clientTrp, *rc.GetRetryPolicy())
+	}
+	if ic, ok := conf.(InterceptorConfig); ok && len(ic.GetInterceptors()) > 0 {
+		clientTrp = kbtransport.NewInterceptorTransport(clientTrp, ic.GetInterceptors()...)
+	}
+	// Logs in session providers, and refreshes them on 401.
+	clientTrp = kbauth.NewTransport(clientTrp)
+	// Releases the deadline of the calls made with kbtransport.WithTimeout once they return.
+	return kbtransport.NewTimeoutTransport(clientTrp)
 }
 
 type RawClient struct {
@@ -77,60 +138,48 @@ type RawClient struct {
 	TenantClient *kbclient.KillBill
 	// Default timeout
 	Timeout time.Duration
+
+	// Authentication, without tenant, profiling features and decorated transport of the clients.
+	// Used by tenant pools.
+	auth          kbauth.Provider
+	profilingInfo string
+	clientTrp     runtime.ClientTransport
 }
 
-// Technically this could be shared across clients if needs to be
+// NewClientTransport returns the transport for the given kill bill url (host:port or a full url, see
+// kbtransport.RuntimeConfig.URL), which can be shared across clients. It panics if the url is invalid,
+// use kbtransport.NewRuntime to get an error instead.
 func NewClientTransport(url string) *transport.Runtime {
-
-	// Expect hostname:port
-	parts := strings.Split(url, ":")
-	if len(parts) != 2 {
-		panic(fmt.Sprintf("Unexpected KB URL %s ", url))
-	}
-	kbPort, err := strconv.Atoi(parts[1])
+	trp, err := newRuntime(kbtransport.RuntimeConfig{URL: url})
 	if err != nil {
-		panic(fmt.Sprintf("Unexpected KB port %s ", parts[1]))
+		panic(err)
 	}
+	return trp
+}
 
-	scheme := "http"
-	if kbPort == 443 {
-		scheme = "https"
+func newRuntime(conf kbtransport.RuntimeConfig) (*transport.Runtime, error) {
+	trp, err := kbtransport.NewRuntime(conf)
+	if err != nil {
+		return nil, err
 	}
-
-	trp := transport.New(url, "", []string{scheme})
-	// Add text/xml producer which is not handled by openapi runtime.
-	trp.Producers["text/xml"] = runtime.TextProducer()
-	// Set this to true to dump http messages
-	trp.Debug = DebugHttpRequests
-	return trp
+	// Set DebugHttpRequests to true to log http messages, without their credentials
+	if DebugHttpRequests {
+		trp.Transport = kbtransport.NewDebugTransport(trp.Transport, kbtransport.DebugOptions{})
+	}
+	return trp, nil
 }
 
 // Because of Kill Bill not always correctly returning the right error code on duplicate entry
 // we need to add some dirty band-aid and specify 'ignrDup', 'logger' args to this function
-// See https://github.com/killbill/killbill/issues/1344
+// See https://github.com/killbill/killbill/issues/1344 and kbcommon.IsDuplicateEntry
 func IsCriticalError(err error, kbCodeIgnore int, ignrDup bool, logger Logger) error {
-	ke, ok := err.(*kbcommon.KillbillError)
-	if ok {
-		if ke.Code == kbCodeIgnore {
-			logger.LogError(err, "Duplicate entry error ignored...")
-			return nil
-		}
-	}
-	// Hack
-	if err != nil && ignrDup {
-		//
-		// It seems like we can get either:
-		// Case1: "[500] java.sql.SQLIntegrityConstraintViolationException: (conn=81) Duplicate entry '37144e24-d5b4-419b-944f-4e42fd56b59e-916' for key 'accounts.accounts_external_key'"
-		// Case 2: "[500] org.postgresql.util.PSQLException: ERROR: duplicate key value violates unique constraint "accounts_external_key Detail: Key (external_key, tenant_record_id)=(d585e7be-cad6-4743-95a0-6b5cf21df5c1, 1) already exists."
-
-		checkErrFn := func(err string) bool {
-			return (strings.Contains(err, "Duplicate entry") && strings.Contains(err, "for key")) /* Case 1*/ ||
-				strings.Contains(err, "duplicate key value violates unique constraint") /* Case 2*/
-		}
-		if checkErrFn(err.Error()) {
-			logger.LogError(err, "Duplicate entry (hack) error ignored...")
-			return nil
-		}
+	if errors.Is(err, kbcommon.ErrorCode(kbCodeIgnore)) {
+		logger.LogError(err, "Duplicate entry error ignored...")
+		return nil
+	}
+	if ignrDup && kbcommon.IsDuplicateEntry(err) {
+		logger.LogError(err, "Duplicate entry (hack) error ignored...")
+		return nil
 	}
 
 	// Add debug for tracking potential test flakiness
@@ -141,38 +190,30 @@ func IsCriticalError(err error, kbCodeIgnore int, ignrDup bool, logger Logger) e
 }
 
 func CreateAuthInfo(apiKey, apiSecret, user, pwd string) runtime.ClientAuthInfoWriter {
-	authWriter := runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
-		encoded := base64.StdEncoding.EncodeToString([]byte(user + ":" + pwd))
-		if err := r.SetHeaderParam("Authorization", "Basic "+encoded); err != nil {
-			return err
-		}
-		if apiKey != "" {
-			if err := r.SetHeaderParam("X-KillBill-ApiKey", apiKey); err != nil {
-				return err
-			}
-		}
-		if apiSecret != "" {
-			if err := r.SetHeaderParam("X-KillBill-ApiSecret", apiSecret); err != nil {
-				return err
-			}
-		}
-		return nil
-	})
-	return authWriter
+	return kbauth.WithTenant(kbauth.Basic(user, pwd), apiKey, apiSecret)
 }
 
 func NewKillbillClient(trp *transport.Runtime, apiKey, apiSecret, user, pwd string) *kbclient.KillBill {
-	authWriter := CreateAuthInfo(apiKey, apiSecret, user, pwd)
+	return newKillbillClient(trp, CreateAuthInfo(apiKey, apiSecret, user, pwd), "")
+}
+
+// newKillbillClient returns a client with the default audit headers. Every call is profiled if
+// profilingInfo is not empty.
+func newKillbillClient(trp *transport.Runtime, authWriter runtime.ClientAuthInfoWriter, profilingInfo string) *kbclient.KillBill {
 	client := kbclient.New(trp, strfmt.Default, authWriter, kbclient.KillbillDefaults{})
 
 	createdBy := CreatedBy
 	comment := Comment
 	reason := ""
 
-	client.SetDefaults(kbclient.KillbillDefaults{
+	defaults := kbclient.KillbillDefaults{
 		CreatedBy: &createdBy,
 		Comment:   &comment,
 		Reason:    &reason,
-	})
+	}
+	if profilingInfo != "" {
+		defaults.WithProfilingInfo = &profilingInfo
+	}
+	client.SetDefaults(defaults)
 	return client
 }
@@ -1,6 +1,12 @@
 package killbill
 
-import "time"
+import (
+	"time"
+
+	"github.com/killbill/kbcli/v3/kbauth"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbtransport"
+)
 
 type KillbillConfig interface {
 	GetUrl() string
@@ -11,13 +17,77 @@ type KillbillConfig interface {
 	GetTimeout() time.Duration
 }
 
+// RetryConfig can optionally be implemented by a KillbillConfig to retry failed requests.
+type RetryConfig interface {
+	GetRetryPolicy() *kbtransport.RetryPolicy
+}
+
+// AuthConfig can optionally be implemented by a KillbillConfig to authenticate requests with
+// another provider than basic authentication with the username and password.
+// The tenant api key and secret are added to the provider.
+type AuthConfig interface {
+	GetAuthProvider() kbauth.Provider
+}
+
+// TransportConfig can optionally be implemented by a KillbillConfig to configure TLS, proxy
+// and connections. The url of the returned configuration overrides GetUrl.
+type TransportConfig interface {
+	GetTransportConfig() kbtransport.RuntimeConfig
+}
+
+// ValidationConfig can optionally be implemented by a KillbillConfig to validate requests
+// before sending them. Invalid requests fail with a *kbcommon.ValidationError.
+type ValidationConfig interface {
+	GetValidateRequests() bool
+}
+
+// InterceptorConfig can optionally be implemented by a KillbillConfig to intercept the calls,
+// for ex. with kbtransport.Metrics or kbtransport.Tracer.
+type InterceptorConfig interface {
+	GetInterceptors() []kbtransport.Interceptor
+}
+
+// ReadOnlyConfig can optionally be implemented by a KillbillConfig to reject the mutating
+// operations before they are sent, except the ones in the allow list (operation ids,
+// for ex. generateDryRunInvoice). Rejected operations fail with a *kbtransport.ReadOnlyError.
+type ReadOnlyConfig interface {
+	GetReadOnly() bool
+	GetReadOnlyAllowList() []string
+}
+
+// ProfilingConfig can optionally be implemented by a KillbillConfig to profile every call with the
+// given features, for ex. kbcommon.DefaultProfilingInfo, and receive the profiles of the calls.
+// Calls made with a WithProfilingInfo context are profiled whatever the configuration.
+type ProfilingConfig interface {
+	GetProfilingInfo() string
+	GetProfileHandler() func(*kbcommon.Profile)
+}
+
 type Config struct {
+	// Kill bill url: host:port, or a full url such as https://kb.example.com/killbill
 	Url        string
 	Username   string
 	Password   string
 	ApiKey     string
 	ApiSecret  string
 	TimeoutSec int64
+	// Retry policy. Requests are not retried if nil.
+	Retry *kbtransport.RetryPolicy
+	// TLS, proxy and connection settings. Transport.URL is ignored, Url is used instead.
+	Transport *kbtransport.RuntimeConfig
+	// Validate requests before sending them. See kbtransport.ValidateParams.
+	ValidateRequests bool
+	// Interceptors of the calls, the first one is the outermost. Retried calls are intercepted once.
+	Interceptors []kbtransport.Interceptor
+	// Authentication provider. Basic authentication with Username and Password is used if nil.
+	Auth kbauth.Provider
+	// Reject mutating operations, except the ones in ReadOnlyAllowList. See kbtransport.ReadOnlyTransport.
+	ReadOnly          bool
+	ReadOnlyAllowList []string
+	// Profile every call with these features, for ex. kbcommon.DefaultProfilingInfo. See ProfilingConfig.
+	ProfilingInfo string
+	// Receives the profiles of the calls, if not nil.
+	OnProfile func(*kbcommon.Profile)
 }
 
 func (k *Config) GetUrl() string {
@@ -43,3 +113,44 @@ func (k *Config) GetApiSecret() string {
 func (k *Config) GetTimeout() time.Duration {
 	return time.Duration(k.TimeoutSec) * time.Second
 }
+
+func (k *Config) GetRetryPolicy() *kbtransport.RetryPolicy {
+	return k.Retry
+}
+
+func (k *Config) GetAuthProvider() kbauth.Provider {
+	return k.Auth
+}
+
+func (k *Config) GetTransportConfig() kbtransport.RuntimeConfig {
+	var res kbtransport.RuntimeConfig
+	if k.Transport != nil {
+		res = *k.Transport
+	}
+	res.URL = k.Url
+	return res
+}
+
+func (k *Config) GetValidateRequests() bool {
+	return k.ValidateRequests
+}
+
+func (k *Config) GetInterceptors() []kbtransport.Interceptor {
+	return k.Interceptors
+}
+
+func (k *Config) GetReadOnly() bool {
+	return k.ReadOnly
+}
+
+func (k *Config) GetReadOnlyAllowList() []string {
+	return k.ReadOnlyAllowList
+}
+
+func (k *Config) GetProfilingInfo() string {
+	return k.ProfilingInfo
+}
+
+func (k *Config) GetProfileHandler() func(*kbcommon.Profile) {
+	return k.OnProfile
+}
@@ -2,34 +2,23 @@ package killbill
 
 import (
 	"context"
-	"github.com/go-openapi/runtime"
+
+	"github.com/killbill/kbcli/v3/kbcommon"
 )
 
-const KB_PROFILING_RESP = "X-Killbill-Profiling-Resp"
+const KB_PROFILING_RESP = kbcommon.ProfilingResponseHeader
 
-type profCtxKey struct {}
-type ProfCtxValue struct {
-	profInfo *string
-	profRes string
-}
-// Caller specifies profiling info (request) through a new context
+// Caller specifies profiling info (request) through a new context, for ex. kbcommon.DefaultProfilingInfo.
+// Every call made with the context is profiled, see kbcommon.ProfilesFromContext.
 func WithProfilingInfo(parent context.Context, profInfo string) context.Context {
-	return context.WithValue(parent, profCtxKey{}, &ProfCtxValue{profInfo: &profInfo})
-}
-// Caller retrieves the profiling result through this method
-func GetProfilingRes(pctx context.Context) string {
-	return getProfValue(pctx).profRes
+	return kbcommon.WithProfiling(parent, profInfo)
 }
 
-// Internal for api calls
-func getProfValue(ctx context.Context) *ProfCtxValue {
-	prof, ok := ctx.Value(profCtxKey{}).(*ProfCtxValue)
-	if !ok {
-		return &ProfCtxValue{}
+// Caller retrieves the profiling result of the last call made with the context through this method.
+func GetProfilingRes(pctx context.Context) string {
+	profiles := kbcommon.ProfilesFromContext(pctx)
+	if len(profiles) == 0 {
+		return ""
 	}
-	return prof
-}
-
-func setProfRes(val *ProfCtxValue, resp runtime.ClientResponse) {
-	val.profRes = resp.GetHeader(KB_PROFILING_RESP)
+	return profiles[len(profiles)-1].Raw
 }
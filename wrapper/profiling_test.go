@@ -0,0 +1,66 @@
+package killbill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbcommon"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestProfiling(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+	srv.AddTenant("bob", "lazar")
+	var profiled []string
+	conf := &Config{
+		Url:        srv.Host(),
+		Username:   kbtest.DefaultUsername,
+		Password:   kbtest.DefaultPassword,
+		ApiKey:     "bob",
+		ApiSecret:  "lazar",
+		TimeoutSec: 5,
+		OnProfile: func(p *kbcommon.Profile) {
+			profiled = append(profiled, p.OperationID)
+		},
+	}
+	cli, err := NewRawClient(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	createAccount := func(ctx context.Context) {
+		_, err := cli.TenantClient.Account.CreateAccount(ctx, &account.CreateAccountParams{
+			Body: &kbmodel.Account{Currency: kbmodel.AccountCurrencyUSD},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Calls are profiled with the context.
+	createAccount(context.Background())
+	ctx := WithProfilingInfo(context.Background(), kbcommon.DefaultProfilingInfo)
+	if GetProfilingRes(ctx) != "" {
+		t.Fatal("unexpected profiling data before the call")
+	}
+	createAccount(ctx)
+	if _, err := kbcommon.ParseProfile(GetProfilingRes(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	if len(profiled) != 1 || profiled[0] != "createAccount" {
+		t.Fatalf("unexpected profiled operations %v", profiled)
+	}
+
+	// Every call is profiled with the configuration.
+	conf.ProfilingInfo = kbcommon.ProfilingDAO
+	profiled = nil
+	if cli, err = NewRawClient(conf); err != nil {
+		t.Fatal(err)
+	}
+	createAccount(context.Background())
+	if len(profiled) != 1 {
+		t.Fatalf("unexpected profiled operations %v", profiled)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/killbill/kbcli/v3/kbclient/subscription"
 	"github.com/killbill/kbcli/v3/kbmodel"
 	"strings"
+	"time"
 )
 
 func (cli *RawClient) GetSubscription(ctx context.Context, subId strfmt.UUID) (*kbmodel.Subscription, error) {
@@ -72,11 +73,8 @@ type PlanDescr struct {
 	Bcd    int
 }
 
-func (cli *RawClient) CreateSubscriptions(pctx context.Context, accountId strfmt.UUID, date *strfmt.Date, planDescs []PlanDescr, follow bool) ([]*kbmodel.Bundle, error) {
-
-	prof := getProfValue(pctx)
-
-	ctx, cancel := context.WithTimeout(pctx, cli.Timeout)
+func (cli *RawClient) CreateSubscriptions(ctx context.Context, accountId strfmt.UUID, date *strfmt.Date, planDescs []PlanDescr, follow bool) ([]*kbmodel.Bundle, error) {
+	ctx, cancel := context.WithTimeout(ctx, cli.Timeout)
 	defer cancel()
 
 	body := make([]*kbmodel.BulkSubscriptionsBundle, 0)
@@ -97,16 +95,12 @@ func (cli *RawClient) CreateSubscriptions(pctx context.Context, accountId strfmt
 		Body:                  body,
 		EntitlementDate:       date,
 		BillingDate:           date,
-		WithProfilingInfo:     prof.profInfo,
 		ProcessLocationHeader: follow,
 		SkipResponse:          swag.Bool(!follow),
 	})
 	if err != nil {
 		return nil, err
 	}
-	if prof.profInfo != nil {
-		setProfRes(prof, res.HttpResponse)
-	}
 	return res.Payload, nil
 }
 
@@ -156,14 +150,18 @@ func (cli *RawClient) CancelSubscription(ctx context.Context, subscriptionId str
 	defer cancel()
 
 	var policy *string
+	var requestedDate *strfmt.DateTime
 	if date == nil {
 		policy = swag.String("END_OF_TERM")
+	} else {
+		dt := strfmt.DateTime(time.Time(*date))
+		requestedDate = &dt
 	}
 
 	_, err := cli.TenantClient.Subscription.CancelSubscriptionPlan(ctx, &subscription.CancelSubscriptionPlanParams{
 		EntitlementPolicy:          policy,
 		BillingPolicy:              policy,
-		RequestedDate:              date,
+		RequestedDate:              requestedDate,
 		SubscriptionID:             subscriptionId,
 		UseRequestedDateForBilling: swag.Bool(true),
 	})
@@ -0,0 +1,180 @@
+package killbill
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/killbill/kbcli/v3/kbauth"
+	"github.com/killbill/kbcli/v3/kbclient"
+	"github.com/killbill/kbcli/v3/kbclient/tenant"
+)
+
+// DefaultTenantPoolSize - number of tenant clients cached by default.
+const DefaultTenantPoolSize = 100
+
+// ErrUnknownTenant is returned when the api key of a tenant external key can't be resolved.
+var ErrUnknownTenant = errors.New("kbcli: unknown tenant")
+
+// TenantPoolConfig configures a TenantPool.
+type TenantPoolConfig struct {
+	// Maximum number of cached tenant clients. The least recently used ones are evicted.
+	// Defaults to DefaultTenantPoolSize.
+	Size int
+	// Returns the api secret of a tenant. Required, as Kill Bill never returns api secrets.
+	Secret func(ctx context.Context, apiKey string) (string, error)
+	// Returns the id of the tenant with the given external key, used by ResolveAPIKey for the
+	// external keys not resolved yet. Optional.
+	TenantID func(ctx context.Context, externalKey string) (strfmt.UUID, error)
+}
+
+// TenantPool creates and caches tenant-scoped clients, which share the transport, authentication
+// and configuration of a RawClient:
+//
+//	pool, err := cli.NewTenantPool(killbill.TenantPoolConfig{Secret: lookupSecret})
+//	if err != nil {
+//		return err
+//	}
+//	client, err := pool.Client(ctx, apiKey)
+//	if err != nil {
+//		return err
+//	}
+//	client.Account.GetAccount(ctx, &account.GetAccountParams{AccountID: accountID})
+type TenantPool struct {
+	cli  *RawClient
+	conf TenantPoolConfig
+
+	mu      sync.Mutex
+	lru     *list.List // *tenantClient elements, most recently used first
+	clients map[string]*list.Element
+	apiKeys map[string]string // external key -> api key
+}
+
+type tenantClient struct {
+	apiKey string
+	client *kbclient.KillBill
+}
+
+// NewTenantPool returns a pool of clients for the tenants of the Kill Bill instance of cli, which
+// must be created by NewRawClient.
+func (cli *RawClient) NewTenantPool(conf TenantPoolConfig) (*TenantPool, error) {
+	if cli.auth == nil || cli.clientTrp == nil || cli.Trp == nil {
+		return nil, errors.New("kbcli: tenant pools need a RawClient created by NewRawClient")
+	}
+	if conf.Size <= 0 {
+		conf.Size = DefaultTenantPoolSize
+	}
+	return &TenantPool{
+		cli:     cli,
+		conf:    conf,
+		lru:     list.New(),
+		clients: map[string]*list.Element{},
+		apiKeys: map[string]string{},
+	}, nil
+}
+
+// Client returns the client of the tenant with the given api key, created on first use.
+func (p *TenantPool) Client(ctx context.Context, apiKey string) (*kbclient.KillBill, error) {
+	if client := p.get(apiKey); client != nil {
+		return client, nil
+	}
+	if p.conf.Secret == nil {
+		return nil, errors.New("kbcli: TenantPoolConfig.Secret is required")
+	}
+	apiSecret, err := p.conf.Secret(ctx, apiKey)
+	if err != nil {
+		return nil, fmt.Errorf("kbcli: failed to get the api secret of tenant %s: %w", apiKey, err)
+	}
+
+	client := newKillbillClient(p.cli.Trp, kbauth.WithTenant(p.cli.auth, apiKey, apiSecret), p.cli.profilingInfo)
+	client.SetTransport(p.cli.clientTrp)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// Another goroutine may have created the client meanwhile.
+	if e, ok := p.clients[apiKey]; ok {
+		p.lru.MoveToFront(e)
+		return e.Value.(*tenantClient).client, nil
+	}
+	p.clients[apiKey] = p.lru.PushFront(&tenantClient{apiKey: apiKey, client: client})
+	for p.lru.Len() > p.conf.Size {
+		oldest := p.lru.Back()
+		p.lru.Remove(oldest)
+		delete(p.clients, oldest.Value.(*tenantClient).apiKey)
+	}
+	return client, nil
+}
+
+// ClientByExternalKey returns the client of the tenant with the given external key. See ResolveAPIKey.
+func (p *TenantPool) ClientByExternalKey(ctx context.Context, externalKey string) (*kbclient.KillBill, error) {
+	apiKey, err := p.ResolveAPIKey(ctx, externalKey)
+	if err != nil {
+		return nil, err
+	}
+	return p.Client(ctx, apiKey)
+}
+
+// ResolveAPIKey returns the api key of the tenant with the given external key. Unknown external keys
+// are resolved with TenantPoolConfig.TenantID and tenant.GetTenant, and fail with ErrUnknownTenant
+// if TenantID isn't set. Resolved keys are kept for the lifetime of the pool.
+func (p *TenantPool) ResolveAPIKey(ctx context.Context, externalKey string) (string, error) {
+	p.mu.Lock()
+	apiKey, ok := p.apiKeys[externalKey]
+	p.mu.Unlock()
+	if ok {
+		return apiKey, nil
+	}
+	if p.conf.TenantID == nil {
+		return "", fmt.Errorf("%w: external key %s", ErrUnknownTenant, externalKey)
+	}
+	tenantID, err := p.conf.TenantID(ctx, externalKey)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.cli.Timeout)
+	defer cancel()
+	res, err := p.cli.CrossTenantClient.Tenant.GetTenant(ctx, &tenant.GetTenantParams{TenantID: tenantID})
+	if err != nil {
+		return "", err
+	}
+	if res.Payload.ExternalKey != externalKey || res.Payload.APIKey == nil {
+		return "", fmt.Errorf("%w: tenant %s doesn't have external key %s", ErrUnknownTenant, tenantID, externalKey)
+	}
+	p.mu.Lock()
+	p.apiKeys[externalKey] = *res.Payload.APIKey
+	p.mu.Unlock()
+	return *res.Payload.APIKey, nil
+}
+
+// Len returns the number of cached clients.
+func (p *TenantPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lru.Len()
+}
+
+// Evict removes the client of the given api key, for ex. after its secret was rotated.
+func (p *TenantPool) Evict(apiKey string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.clients[apiKey]; ok {
+		p.lru.Remove(e)
+		delete(p.clients, apiKey)
+	}
+}
+
+func (p *TenantPool) get(apiKey string) *kbclient.KillBill {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e, ok := p.clients[apiKey]
+	if !ok {
+		return nil
+	}
+	p.lru.MoveToFront(e)
+	return e.Value.(*tenantClient).client
+}
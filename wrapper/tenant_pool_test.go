@@ -0,0 +1,151 @@
+package killbill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/killbill/kbcli/v3/kbclient/account"
+	"github.com/killbill/kbcli/v3/kbclient/tenant"
+	"github.com/killbill/kbcli/v3/kbmodel"
+	"github.com/killbill/kbcli/v3/kbtest"
+)
+
+func TestTenantPool(t *testing.T) {
+	srv := kbtest.NewServer()
+	defer srv.Close()
+
+	cli, err := NewRawClient(&Config{
+		Url:        srv.Host(),
+		Username:   kbtest.DefaultUsername,
+		Password:   kbtest.DefaultPassword,
+		TimeoutSec: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// The tenants have external keys distinct from their api keys.
+	secrets := map[string]string{"bob": "lazar", "alice": "wonder", "carol": "singer"}
+	tenantIDs := map[string]strfmt.UUID{}
+	for apiKey, apiSecret := range secrets {
+		resp, err := cli.CrossTenantClient.Tenant.CreateTenant(ctx, &tenant.CreateTenantParams{
+			Body: &kbmodel.Tenant{
+				APIKey:      swag.String(apiKey),
+				APISecret:   swag.String(apiSecret),
+				ExternalKey: apiKey + "-ext",
+			},
+			XKillbillCreatedBy:    "test",
+			ProcessLocationHeader: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tenantIDs[apiKey+"-ext"] = resp.Payload.TenantID
+	}
+
+	var secretCalls int
+	pool, err := cli.NewTenantPool(TenantPoolConfig{
+		Size: 2,
+		Secret: func(_ context.Context, apiKey string) (string, error) {
+			secretCalls++
+			if s, ok := secrets[apiKey]; ok {
+				return s, nil
+			}
+			return "", fmt.Errorf("no secret for %s", apiKey)
+		},
+		TenantID: func(_ context.Context, externalKey string) (strfmt.UUID, error) {
+			if id, ok := tenantIDs[externalKey]; ok {
+				return id, nil
+			}
+			return "", fmt.Errorf("no tenant for %s", externalKey)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each tenant client sees its own accounts.
+	for _, apiKey := range []string{"bob", "alice"} {
+		client, err := pool.Client(ctx, apiKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = client.Account.CreateAccount(ctx, &account.CreateAccountParams{
+			Body: &kbmodel.Account{ExternalKey: apiKey + "-account", Currency: kbmodel.AccountCurrencyUSD},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	client, err := pool.Client(ctx, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Account.GetAccountByKey(ctx, &account.GetAccountByKeyParams{ExternalKey: "alice-account"}); err == nil {
+		t.Fatalf("expecting alice's account not to be visible to bob")
+	}
+	if secretCalls != 2 {
+		t.Fatalf("expecting cached clients, got %d secret lookups", secretCalls)
+	}
+
+	// External keys resolve to the api key of their tenant.
+	for externalKey, apiKey := range map[string]string{"alice-ext": "alice", "carol-ext": "carol", "bob-ext": "bob"} {
+		if got, err := pool.ResolveAPIKey(ctx, externalKey); err != nil || got != apiKey {
+			t.Fatalf("expecting api key %s for %s, got %q, %v", apiKey, externalKey, got, err)
+		}
+	}
+	client, err = pool.ClientByExternalKey(ctx, "alice-ext")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Account.GetAccountByKey(ctx, &account.GetAccountByKeyParams{ExternalKey: "alice-account"}); err != nil {
+		t.Fatalf("expecting the client of alice, got %v", err)
+	}
+
+	// carol evicts bob, the least recently used.
+	secretCalls = 0
+	if _, err := pool.ClientByExternalKey(ctx, "carol-ext"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Client(ctx, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if pool.Len() != 2 || secretCalls != 1 {
+		t.Fatalf("unexpected pool size %d and secret lookups %d", pool.Len(), secretCalls)
+	}
+	if _, err := pool.Client(ctx, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if secretCalls != 2 {
+		t.Fatalf("expecting bob to be evicted, got %d secret lookups", secretCalls)
+	}
+
+	// The api key is the one of a tenant external key, not the external key itself.
+	if _, err := pool.ResolveAPIKey(ctx, "bob"); err == nil {
+		t.Fatalf("expecting unknown tenant error")
+	}
+	if _, err := pool.ResolveAPIKey(ctx, "dave-ext"); err == nil {
+		t.Fatalf("expecting unknown tenant error")
+	}
+	other, err := cli.NewTenantPool(TenantPoolConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.ResolveAPIKey(ctx, "bob-ext"); !errors.Is(err, ErrUnknownTenant) {
+		t.Fatalf("expecting ErrUnknownTenant, got %v", err)
+	}
+	if _, err := pool.Client(ctx, "dave"); err == nil {
+		t.Fatalf("expecting secret lookup error")
+	}
+
+	// Pools need the authentication and transport set by NewRawClient.
+	if _, err := (&RawClient{Trp: cli.Trp, CrossTenantClient: cli.CrossTenantClient}).NewTenantPool(TenantPoolConfig{}); err == nil {
+		t.Fatalf("expecting an error for a RawClient not created by NewRawClient")
+	}
+}